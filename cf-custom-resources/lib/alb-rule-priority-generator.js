@@ -75,15 +75,14 @@ let report = function (
 };
 
 /**
- * Lists all the existing rules for a ALB Listener, finds the max of their
- * priorities, and then returns max + 1.
+ * Finds the max of the priorities of the existing rules for a ALB Listener,
+ * and then returns max + 1.
  *
- * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {object[]} rules the existing rules of the ALB listener.
 
  * @returns {number} The next available ALB listener rule priority.
  */
-const calculateNextRulePriority = async function (listenerArn) {
-  let rules = await getListenerRules(listenerArn);
+const calculateNextRulePriority = function (rules) {
   let nextRulePriority = 1;
   if (rules.length > 0) {
     // Take the max rule priority, and add 1 to it.
@@ -104,15 +103,14 @@ const calculateNextRulePriority = async function (listenerArn) {
 };
 
 /**
- * Lists all the existing rules for a ALB Listener, finds the min of their root rule
- * priorities, and then returns min - 1.
+ * Finds the min of the root rule priorities of the existing rules for a ALB Listener,
+ * and then returns min - 1.
  *
- * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {object[]} rules the existing rules of the ALB listener.
 
  * @returns {number} The next available ALB listener rule priority.
  */
-const calculateNextRootRulePriority = async function (listenerArn) {
-  let rules = await getListenerRules(listenerArn);
+const calculateNextRootRulePriority = function (rules) {
   let nextRulePriority = maxPriorityForRootRule;
   if (rules.length > 0) {
     // We'll start from the max rule priority number for root path so that
@@ -134,6 +132,47 @@ const calculateNextRootRulePriority = async function (listenerArn) {
   return nextRulePriority;
 };
 
+/**
+ * Returns the number of segments of a rule path, e.g. 0 for "/" and 2 for "api/v2".
+ *
+ * @param {string} rulePath the path of a rule.
+
+ * @returns {number} The number of non-empty segments of the path.
+ */
+const pathSpecificity = function (rulePath) {
+  return rulePath.split("/").filter((segment) => segment !== "").length;
+};
+
+/**
+ * Lists all the existing rules for a ALB Listener, and assigns the next available
+ * priority to each rule path so that no two paths share the same priority.
+ * Listener rules are evaluated from the lowest priority, so the paths with the most segments
+ * are assigned the lowest priorities. For example, "api/v2" is evaluated before "api".
+ * Paths with the same number of segments are assigned priorities in the order they are declared.
+ *
+ * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {string[]} rulePaths the paths of the rules that need a priority.
+
+ * @returns {number[]} The priority of each rule path, in the same order as the paths.
+ */
+const calculateRulePriorities = async function (listenerArn, rulePaths) {
+  const rules = await getListenerRules(listenerArn);
+  let nextRulePriority = calculateNextRulePriority(rules);
+  let nextRootRulePriority = calculateNextRootRulePriority(rules);
+  // Array.prototype.sort is stable, so paths with the same specificity keep their declaration order.
+  const order = rulePaths
+    .map((_, i) => i)
+    .sort(
+      (a, b) => pathSpecificity(rulePaths[b]) - pathSpecificity(rulePaths[a])
+    );
+  const priorities = new Array(rulePaths.length);
+  for (const i of order) {
+    priorities[i] =
+      rulePaths[i] === "/" ? nextRootRulePriority-- : nextRulePriority++;
+  }
+  return priorities;
+};
+
 const getListenerRules = async function (listenerArn) {
   let elb = new aws.ELBv2();
   // Grab all the rules for this listener
@@ -160,22 +199,23 @@ exports.nextAvailableRulePriorityHandler = async function (event, context) {
   let responseData = {};
   const physicalResourceId =
     event.PhysicalResourceId || `alb-rule-priority-${event.LogicalResourceId}`;
-  let isRootPath = event.ResourceProperties.RulePath === "/";
+  // RulePath is either the path of a single rule or a list of paths, one per rule.
+  const rulePaths = [].concat(event.ResourceProperties.RulePath);
 
   try {
     switch (event.RequestType) {
       case "Create":
-      case "Update":
-        if (isRootPath) {
-          responseData.Priority = await calculateNextRootRulePriority(
-            event.ResourceProperties.ListenerArn
-          );
-        } else {
-          responseData.Priority = await calculateNextRulePriority(
-            event.ResourceProperties.ListenerArn
-          );
-        }
+      case "Update": {
+        const priorities = await calculateRulePriorities(
+          event.ResourceProperties.ListenerArn,
+          rulePaths
+        );
+        // The priority of the first rule is "Priority", and the following ones are "Priority1", "Priority2"...
+        priorities.forEach((priority, i) => {
+          responseData[i === 0 ? "Priority" : `Priority${i}`] = priority;
+        });
         break;
+      }
       // Do nothing on delete, since this isn't a "real" resource.
       case "Delete":
         break;
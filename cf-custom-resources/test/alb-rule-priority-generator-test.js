@@ -474,4 +474,115 @@ describe("ALB Rule Priority Generator", () => {
         expect(request.isDone()).toBe(true);
       });
   });
+
+  test("Create operation returns a priority for each rule path", () => {
+    // This set of rules has the default, 3 and 49999 rule priorities. Non-root paths
+    // are assigned max + 1, max + 2..., and root paths min - 1, min - 2...
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "default",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: true,
+          Actions: [],
+        },
+        {
+          Priority: "3",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: false,
+          Actions: [],
+        },
+        {
+          Priority: "49999",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: false,
+          Actions: [],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority == 4 &&
+          body.Data.Priority1 == 49998 &&
+          body.Data.Priority2 == 5
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["api", "/", "admin"],
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledOnce(describeRulesFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create operation assigns the lowest priorities to the most specific rule paths", () => {
+    // Listener rules are evaluated from the lowest priority, so "api/v2" must
+    // be evaluated before "api" even though it is declared after it.
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "default",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: true,
+          Actions: [],
+        },
+        {
+          Priority: "3",
+          Conditions: [],
+          RuleArn:
+            "arn:aws:elasticloadbalancing:us-west-2:000000000:listener-rule/app/rule",
+          IsDefault: false,
+          Actions: [],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority == 6 &&
+          body.Data.Priority1 == 4 &&
+          body.Data.Priority2 == 5 &&
+          body.Data.Priority3 == 7
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["api", "api/v2/users", "/api/v2/", "admin"],
+        },
+      })
+      .expectResolve(() => {
+        sinon.assert.calledOnce(describeRulesFake);
+        expect(request.isDone()).toBe(true);
+      });
+  });
 });
@@ -33,6 +33,44 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "aurora.yml",
 		},
+		"aurora with environment lifecycle": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:   "aurora",
+				Engine:        "PostgreSQL",
+				InitialDBName: "main",
+				Envs:          []string{"test"},
+				EnvLifecycle:  true,
+			}),
+			outFileName: "env-aurora.yml",
+		},
+		"aurora serverless v2": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				ClusterName:         "aurora",
+				Engine:              "PostgreSQL",
+				InitialDBName:       "main",
+				Envs:                []string{"test"},
+				CapacityMode:        addon.RDSCapacityModeServerlessV2,
+				MinCapacity:         0.5,
+				MaxCapacity:         8,
+				ReaderReplicas:      1,
+				BackupRetentionDays: 7,
+				DeletionPolicy:      "Snapshot",
+				PerformanceInsights: true,
+			}),
+			outFileName: "aurora-serverless-v2.yml",
+		},
+		"provisioned aurora for a request-driven web service": {
+			addonMarshaler: addon.NewRDSTemplate(addon.RDSProps{
+				WorkloadType:  "Request-Driven Web Service",
+				ClusterName:   "aurora",
+				Engine:        "MySQL",
+				InitialDBName: "main",
+				Envs:          []string{"test"},
+				CapacityMode:  addon.RDSCapacityModeProvisioned,
+				InstanceClass: "db.r6g.large",
+			}),
+			outFileName: "rdws-aurora-provisioned.yml",
+		},
 		"ddb": {
 			addonMarshaler: addon.NewDDBTemplate(&addon.DynamoDBProps{
 				StorageProps: &addon.StorageProps{
@@ -73,6 +111,35 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.NewRedisTemplate(addon.RedisProps{
+				ClusterName:   "redis",
+				EngineVersion: "7.0",
+				NodeType:      "cache.t3.micro",
+			}),
+			outFileName: "redis.yml",
+		},
+		"redis with cluster mode and environment lifecycle": {
+			addonMarshaler: addon.NewRedisTemplate(addon.RedisProps{
+				ClusterName:   "redis",
+				EngineVersion: "6.2",
+				NodeType:      "cache.r6g.large",
+				ClusterMode:   true,
+				KMSEncryption: true,
+				EnvLifecycle:  true,
+			}),
+			outFileName: "env-redis.yml",
+		},
+		"opensearch": {
+			addonMarshaler: addon.NewOpenSearchTemplate(addon.OpenSearchProps{
+				DomainName:    "search",
+				EngineVersion: "OpenSearch_2.3",
+				InstanceType:  "t3.medium.search",
+				ClusterMode:   true,
+				KMSEncryption: true,
+			}),
+			outFileName: "opensearch.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -18,6 +18,9 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	// EnvAddonsDirName is the name used in place of a workload name for the addons shared by the environments.
+	EnvAddonsDirName = "environments"
 )
 
 var (
@@ -58,6 +61,40 @@ func New(wlName string) (*Addons, error) {
 	}, nil
 }
 
+type envWorkspaceReader interface {
+	ReadEnvironmentAddonsDir() ([]string, error)
+	ReadEnvironmentAddon(fileName string) ([]byte, error)
+}
+
+// envAddonsReader reads the addons under the "environments/" directory like the addons of a workload.
+type envAddonsReader struct {
+	ws envWorkspaceReader
+}
+
+// ReadAddonsDir returns the file names under the "environments/addons/" directory.
+func (r envAddonsReader) ReadAddonsDir(_ string) ([]string, error) {
+	return r.ws.ReadEnvironmentAddonsDir()
+}
+
+// ReadAddon returns the contents of a file under the "environments/addons/" directory.
+func (r envAddonsReader) ReadAddon(_, fileName string) ([]byte, error) {
+	return r.ws.ReadEnvironmentAddon(fileName)
+}
+
+// NewEnv creates an Addons object for the resources shared by the workloads of an environment,
+// defined under the "environments/addons/" directory.
+func NewEnv() (*Addons, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &Addons{
+		wlName: EnvAddonsDirName,
+		parser: template.New(),
+		ws:     envAddonsReader{ws: ws},
+	}, nil
+}
+
 // Template merges CloudFormation templates under the "addons/" directory of a workload
 // into a single CloudFormation template and returns it.
 //
@@ -163,6 +200,23 @@ func (a *Addons) validateReservedParameters(params yaml.Node, fname string) erro
 			return fmt.Errorf("reserved parameters 'App', 'Env', and 'Name' cannot be declared in %s under %s addons/", fname, a.wlName)
 		}
 	}
+	if a.wlName != EnvAddonsDirName {
+		return nil
+	}
+	// The environment stack passes its network to the environment addons that declare these parameters.
+	envContent := struct {
+		VpcId                    yaml.Node `yaml:"VpcId"`
+		PrivateSubnets           yaml.Node `yaml:"PrivateSubnets"`
+		EnvironmentSecurityGroup yaml.Node `yaml:"EnvironmentSecurityGroup"`
+	}{}
+	if err := params.Decode(&envContent); err != nil {
+		return fmt.Errorf("decode content of parameters file %s under %s addons/", fname, a.wlName)
+	}
+	for _, field := range []yaml.Node{envContent.VpcId, envContent.PrivateSubnets, envContent.EnvironmentSecurityGroup} {
+		if !field.IsZero() {
+			return fmt.Errorf("reserved parameters 'VpcId', 'PrivateSubnets', and 'EnvironmentSecurityGroup' cannot be declared in %s under %s addons/", fname, a.wlName)
+		}
+	}
 	return nil
 }
 
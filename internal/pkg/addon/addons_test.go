@@ -350,6 +350,22 @@ Parameters:
 			},
 			wantedErr: "reserved parameters 'App', 'Env', and 'Name' cannot be declared in addons.parameters.yml under api addons/",
 		},
+		"returns an error if the network parameters of the environment are redefined in the environment addons": {
+			mockAddons: func(ctrl *gomock.Controller) *Addons {
+				ws := mocks.NewMockenvWorkspaceReader(ctrl)
+				ws.EXPECT().ReadEnvironmentAddonsDir().
+					Return([]string{"addons.parameters.yml", "template.yaml"}, nil)
+				ws.EXPECT().ReadEnvironmentAddon("addons.parameters.yml").Return([]byte(`
+Parameters:
+  VpcId: !Ref VPC
+`), nil)
+				return &Addons{
+					wlName: EnvAddonsDirName,
+					ws:     envAddonsReader{ws: ws},
+				}
+			},
+			wantedErr: "reserved parameters 'VpcId', 'PrivateSubnets', and 'EnvironmentSecurityGroup' cannot be declared in addons.parameters.yml under environments addons/",
+		},
 		"returns the content of Parameters on success": {
 			mockAddons: func(ctrl *gomock.Controller) *Addons {
 				ws := mocks.NewMockworkspaceReader(ctrl)
@@ -398,3 +414,27 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn
 		})
 	}
 }
+
+func TestEnvAddons_Template(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ws := mocks.NewMockenvWorkspaceReader(ctrl)
+	ws.EXPECT().ReadEnvironmentAddonsDir().Return([]string{"first.yaml", "second.yaml", "addons.parameters.yml"}, nil)
+	first, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "first.yaml"))
+	ws.EXPECT().ReadEnvironmentAddon("first.yaml").Return(first, nil)
+	second, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "second.yaml"))
+	ws.EXPECT().ReadEnvironmentAddon("second.yaml").Return(second, nil)
+	wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "merge", "wanted.yaml"))
+	addons := &Addons{
+		wlName: EnvAddonsDirName,
+		ws:     envAddonsReader{ws: ws},
+	}
+
+	// WHEN
+	actual, err := addons.Template()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, string(wanted), actual)
+}
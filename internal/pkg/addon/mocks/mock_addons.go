@@ -62,3 +62,56 @@ func (mr *MockworkspaceReaderMockRecorder) ReadAddonsDir(svcName interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAddonsDir", reflect.TypeOf((*MockworkspaceReader)(nil).ReadAddonsDir), svcName)
 }
+
+// MockenvWorkspaceReader is a mock of envWorkspaceReader interface.
+type MockenvWorkspaceReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvWorkspaceReaderMockRecorder
+}
+
+// MockenvWorkspaceReaderMockRecorder is the mock recorder for MockenvWorkspaceReader.
+type MockenvWorkspaceReaderMockRecorder struct {
+	mock *MockenvWorkspaceReader
+}
+
+// NewMockenvWorkspaceReader creates a new mock instance.
+func NewMockenvWorkspaceReader(ctrl *gomock.Controller) *MockenvWorkspaceReader {
+	mock := &MockenvWorkspaceReader{ctrl: ctrl}
+	mock.recorder = &MockenvWorkspaceReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvWorkspaceReader) EXPECT() *MockenvWorkspaceReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentAddon mocks base method.
+func (m *MockenvWorkspaceReader) ReadEnvironmentAddon(fileName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentAddon", fileName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentAddon indicates an expected call of ReadEnvironmentAddon.
+func (mr *MockenvWorkspaceReaderMockRecorder) ReadEnvironmentAddon(fileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentAddon", reflect.TypeOf((*MockenvWorkspaceReader)(nil).ReadEnvironmentAddon), fileName)
+}
+
+// ReadEnvironmentAddonsDir mocks base method.
+func (m *MockenvWorkspaceReader) ReadEnvironmentAddonsDir() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentAddonsDir")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentAddonsDir indicates an expected call of ReadEnvironmentAddonsDir.
+func (mr *MockenvWorkspaceReaderMockRecorder) ReadEnvironmentAddonsDir() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentAddonsDir", reflect.TypeOf((*MockenvWorkspaceReader)(nil).ReadEnvironmentAddonsDir))
+}
@@ -15,11 +15,13 @@ import (
 )
 
 const (
-	dynamoDbTemplatePath = "addons/ddb/cf.yml"
-	s3TemplatePath       = "addons/s3/cf.yml"
-	rdsTemplatePath      = "addons/aurora/cf.yml"
-	rdsRDWSTemplatePath  = "addons/aurora/rdws/cf.yml"
-	rdsRDWSParamsPath    = "addons/aurora/rdws/addons.parameters.yml"
+	dynamoDbTemplatePath   = "addons/ddb/cf.yml"
+	s3TemplatePath         = "addons/s3/cf.yml"
+	rdsTemplatePath        = "addons/aurora/cf.yml"
+	rdsRDWSTemplatePath    = "addons/aurora/rdws/cf.yml"
+	rdsRDWSParamsPath      = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath      = "addons/redis/cf.yml"
+	openSearchTemplatePath = "addons/opensearch/cf.yml"
 )
 
 const (
@@ -28,7 +30,32 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
-var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
+const (
+	// Capacity modes of an RDS Aurora cluster.
+	RDSCapacityModeServerlessV1 = "serverless-v1"
+	RDSCapacityModeServerlessV2 = "serverless-v2"
+	RDSCapacityModeProvisioned  = "provisioned"
+)
+
+// RDSCapacityModes are the capacity modes of an RDS Aurora cluster.
+var RDSCapacityModes = []string{RDSCapacityModeServerlessV1, RDSCapacityModeServerlessV2, RDSCapacityModeProvisioned}
+
+// RDSDeletionPolicies are the CloudFormation deletion policies that can be applied to an RDS Aurora cluster.
+var RDSDeletionPolicies = []string{"Delete", "Retain", "Snapshot"}
+
+// RedisEngineVersions are the versions of the Redis engine that an ElastiCache cluster can run.
+var RedisEngineVersions = []string{"7.0", "6.2"}
+
+// OpenSearchEngineVersions are the versions of the engine that an OpenSearch domain can run.
+var OpenSearchEngineVersions = []string{"OpenSearch_2.3", "OpenSearch_1.3"}
+
+// Domain names are at most 28 characters long: the prefix, a hyphen and an 8 characters suffix unique to the stack.
+const maxOpenSearchDomainNamePrefixLength = 19
+
+var (
+	regexpMatchAttribute              = regexp.MustCompile(`^(\S+):([sbnSBN])`)
+	regexpOpenSearchDomainNameInvalid = regexp.MustCompile(`[^a-z0-9-]+`)
+)
 
 var storageTemplateFunctions = map[string]interface{}{
 	"logicalIDSafe": template.StripNonAlphaNumFunc,
@@ -106,9 +133,44 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// RedisTemplate contains configuration options which fully describe an ElastiCache Redis cluster.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (r *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisTemplatePath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// OpenSearchTemplate contains configuration options which fully describe an OpenSearch domain.
+// Implements the encoding.BinaryMarshaler interface.
+type OpenSearchTemplate struct {
+	OpenSearchProps
+
+	parser template.Parser
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (o *OpenSearchTemplate) MarshalBinary() ([]byte, error) {
+	content, err := o.parser.Parse(openSearchTemplatePath, *o, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 // StorageProps holds basic input properties for addon.NewDDBTemplate() or addon.NewS3Template().
 type StorageProps struct {
-	Name string
+	Name         string
+	EnvLifecycle bool // True if the resource is deployed with the environments and shared by their workloads.
 }
 
 // S3Props contains S3-specific properties for addon.NewS3Template().
@@ -153,6 +215,40 @@ type RDSProps struct {
 	InitialDBName  string   // The name of the initial database created inside the cluster.
 	ParameterGroup string   // The parameter group to use for the cluster.
 	Envs           []string // The copilot environments found inside the current app.
+	EnvLifecycle   bool     // True if the cluster is deployed with the environments and shared by their workloads.
+
+	CapacityMode        string  // The capacity mode of the cluster. Defaults to Aurora Serverless v1.
+	MinCapacity         float64 // The minimum capacity in ACUs of an Aurora Serverless v2 cluster.
+	MaxCapacity         float64 // The maximum capacity in ACUs of an Aurora Serverless v2 cluster.
+	InstanceClass       string  // The instance class of the DB instances of a provisioned cluster.
+	ReaderReplicas      int     // The number of reader DB instances in addition to the writer instance.
+	BackupRetentionDays int     // The number of days to retain automated backups. Defaults to 1 if zero.
+	DeletionPolicy      string  // The CloudFormation deletion policy of the cluster.
+	PerformanceInsights bool    // True if Performance Insights is enabled on the DB instances.
+}
+
+// IsServerlessV1 returns true if the cluster is an Aurora Serverless v1 cluster.
+func (p RDSProps) IsServerlessV1() bool {
+	return p.CapacityMode == "" || p.CapacityMode == RDSCapacityModeServerlessV1
+}
+
+// IsServerlessV2 returns true if the DB instances of the cluster are Aurora Serverless v2 instances.
+func (p RDSProps) IsServerlessV2() bool {
+	return p.CapacityMode == RDSCapacityModeServerlessV2
+}
+
+// IsProvisioned returns true if the DB instances of the cluster have a fixed instance class.
+func (p RDSProps) IsProvisioned() bool {
+	return p.CapacityMode == RDSCapacityModeProvisioned
+}
+
+// ReaderIndexes returns the indexes, starting from 1, that tell the reader DB instances of the cluster apart.
+func (p RDSProps) ReaderIndexes() []int {
+	indexes := make([]int, p.ReaderReplicas)
+	for i := range indexes {
+		indexes[i] = i + 1
+	}
+	return indexes
 }
 
 // NewRDSTemplate creates a new RDS marshaler which can be used to write a RDS CloudFormation template.
@@ -171,6 +267,68 @@ func NewRDSParams() *RDSParams {
 	}
 }
 
+// RedisProps holds ElastiCache Redis specific properties for addon.NewRedisTemplate().
+type RedisProps struct {
+	ClusterName   string // The name of the cluster.
+	EngineVersion string // The version of the Redis engine.
+	NodeType      string // The compute and memory capacity of the nodes of the cluster.
+	ClusterMode   bool   // True if the data is partitioned across multiple shards.
+	KMSEncryption bool   // True if the data at rest is encrypted with a customer managed KMS key.
+	EnvLifecycle  bool   // True if the cluster is deployed with the environments and shared by their workloads.
+}
+
+// ClusterModeParameterGroup returns the name of the default parameter group that enables cluster mode
+// for the engine version of the cluster.
+func (p RedisProps) ClusterModeParameterGroup() string {
+	if strings.HasPrefix(p.EngineVersion, "6.") {
+		return "default.redis6.x.cluster.on"
+	}
+	return "default.redis7.cluster.on"
+}
+
+// NewRedisTemplate creates a new ElastiCache Redis marshaler which can be used to write a CloudFormation template.
+func NewRedisTemplate(input RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: input,
+
+		parser: template.New(),
+	}
+}
+
+// OpenSearchProps holds OpenSearch specific properties for addon.NewOpenSearchTemplate().
+type OpenSearchProps struct {
+	DomainName    string // The name of the domain.
+	EngineVersion string // The version of the OpenSearch engine.
+	InstanceType  string // The instance type of the data nodes of the domain.
+	ClusterMode   bool   // True if the data nodes are spread across two Availability Zones.
+	KMSEncryption bool   // True if the data at rest is encrypted with a customer managed KMS key.
+	EnvLifecycle  bool   // True if the domain is deployed with the environments and shared by their workloads.
+}
+
+// DomainNamePrefix returns the start of the name of the OpenSearch domain, derived from the name of the storage.
+// Domain names must start with a lowercase letter, and contain only lowercase letters, numbers and hyphens.
+func (p OpenSearchProps) DomainNamePrefix() string {
+	prefix := regexpOpenSearchDomainNameInvalid.ReplaceAllString(strings.ToLower(p.DomainName), "-")
+	prefix = strings.TrimLeft(prefix, "0123456789-")
+	if len(prefix) > maxOpenSearchDomainNamePrefixLength {
+		prefix = prefix[:maxOpenSearchDomainNamePrefixLength]
+	}
+	prefix = strings.TrimRight(prefix, "-")
+	if prefix == "" {
+		return "search"
+	}
+	return prefix
+}
+
+// NewOpenSearchTemplate creates a new OpenSearch marshaler which can be used to write a CloudFormation template.
+func NewOpenSearchTemplate(input OpenSearchProps) *OpenSearchTemplate {
+	return &OpenSearchTemplate{
+		OpenSearchProps: input,
+
+		parser: template.New(),
+	}
+}
+
 // BuildPartitionKey generates the properties required to specify the partition key
 // based on customer inputs.
 func (p *DynamoDBProps) BuildPartitionKey(partitionKey string) error {
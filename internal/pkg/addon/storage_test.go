@@ -184,6 +184,19 @@ func TestRDSTemplate_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRDSProps_CapacityMode(t *testing.T) {
+	require.True(t, RDSProps{}.IsServerlessV1())
+	require.True(t, RDSProps{CapacityMode: RDSCapacityModeServerlessV1}.IsServerlessV1())
+	require.True(t, RDSProps{CapacityMode: RDSCapacityModeServerlessV2}.IsServerlessV2())
+	require.False(t, RDSProps{CapacityMode: RDSCapacityModeServerlessV2}.IsServerlessV1())
+	require.True(t, RDSProps{CapacityMode: RDSCapacityModeProvisioned}.IsProvisioned())
+}
+
+func TestRDSProps_ReaderIndexes(t *testing.T) {
+	require.Empty(t, RDSProps{}.ReaderIndexes())
+	require.Equal(t, []int{1, 2, 3}, RDSProps{ReaderReplicas: 3}.ReaderIndexes())
+}
+
 func TestRDSParams_MarshalBinary(t *testing.T) {
 	testCases := map[string]struct {
 		mockDependencies func(ctrl *gomock.Controller, r *RDSParams)
@@ -229,6 +242,101 @@ func TestRDSParams_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, r *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, r *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *r, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, r *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				r.parser = m
+				m.EXPECT().Parse(redisTemplatePath, *r, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("redis")}, nil)
+			},
+
+			wantedBinary: []byte("redis"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestRedisProps_ClusterModeParameterGroup(t *testing.T) {
+	require.Equal(t, "default.redis6.x.cluster.on", RedisProps{EngineVersion: "6.2"}.ClusterModeParameterGroup())
+	require.Equal(t, "default.redis7.cluster.on", RedisProps{EngineVersion: "7.0"}.ClusterModeParameterGroup())
+}
+
+func TestOpenSearchTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, o *OpenSearchTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, o *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				o.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *o, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, o *OpenSearchTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				o.parser = m
+				m.EXPECT().Parse(openSearchTemplatePath, *o, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("search")}, nil)
+			},
+
+			wantedBinary: []byte("search"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &OpenSearchTemplate{}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestDDBAttributeFromKey(t *testing.T) {
 	testCases := map[string]struct {
 		input     string
@@ -494,3 +602,36 @@ func TestBuildLocalSecondaryIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenSearchProps_DomainNamePrefix(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"keeps a valid name": {
+			in:     "search",
+			wanted: "search",
+		},
+		"lowercases the name and replaces invalid characters": {
+			in:     "My_Search.Domain",
+			wanted: "my-search-domain",
+		},
+		"starts with a letter": {
+			in:     "1-search",
+			wanted: "search",
+		},
+		"truncates long names": {
+			in:     "my-very-long-search-domain",
+			wanted: "my-very-long-search",
+		},
+		"falls back to a default": {
+			in:     "123",
+			wanted: "search",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, OpenSearchProps{DomainName: tc.in}.DomainNamePrefix())
+		})
+	}
+}
@@ -26,6 +26,9 @@ var eventErrorStates = []string{
 	cloudformation.ResourceStatusImportRollbackFailed,
 }
 
+// driftDetectionPollInterval is how long to wait in between polls for the status of a drift detection operation.
+var driftDetectionPollInterval = 3 * time.Second
+
 var waiters = []request.WaiterOption{
 	request.WithWaiterDelay(request.ConstantWaiterDelay(5 * time.Second)), // How long to wait in between poll cfn for updates.
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
@@ -294,6 +297,69 @@ func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	return resources, nil
 }
 
+// StackDrift detects drift on a stack and returns the resources whose actual configuration
+// differs from their expected template configuration, or that have been deleted.
+func (c *CloudFormation) StackDrift(ctx context.Context, stackName string) ([]*StackResourceDrift, error) {
+	out, err := c.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("detect drift for stack %s: %w", stackName, err)
+	}
+	if err := c.waitForDriftDetection(ctx, aws.StringValue(out.StackDriftDetectionId)); err != nil {
+		return nil, fmt.Errorf("wait for drift detection of stack %s: %w", stackName, err)
+	}
+	var drifts []*StackResourceDrift
+	var nextToken *string
+	for {
+		out, err := c.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts for stack %s: %w", stackName, err)
+		}
+		for _, d := range out.StackResourceDrifts {
+			if d == nil {
+				continue
+			}
+			drift := StackResourceDrift(*d)
+			drifts = append(drifts, &drift)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(ctx context.Context, detectionID string) error {
+	for {
+		out, err := c.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe drift detection status %s: %w", detectionID, err)
+		}
+		switch aws.StringValue(out.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return fmt.Errorf("drift detection %s failed: %s", detectionID, aws.StringValue(out.DetectionStatusReason))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(driftDetectionPollInterval):
+		}
+	}
+}
+
 func (c *CloudFormation) events(stackName string, match eventMatcher) ([]StackEvent, error) {
 	var nextToken *string
 	var events []StackEvent
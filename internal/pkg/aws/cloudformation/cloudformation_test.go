@@ -1299,6 +1299,126 @@ func TestStackDescriber_StackResources(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_StackDrift(t *testing.T) {
+	defaultPollInterval := driftDetectionPollInterval
+	driftDetectionPollInterval = 0
+	defer func() { driftDetectionPollInterval = defaultPollInterval }()
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedDrifts []*StackResourceDrift
+		wantedError  error
+	}{
+		"return a wrapped error if drift detection can't be started": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("detect drift for stack phonetool-test-api: some error"),
+		},
+		"return an error if drift detection fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("access denied"),
+				}, nil)
+				return m
+			},
+			wantedError: errors.New("wait for drift detection of stack phonetool-test-api: drift detection 1234 failed: access denied"),
+		},
+		"return a wrapped error if resource drifts can't be described": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe resource drifts for stack phonetool-test-api: some error"),
+		},
+		"waits for drift detection to complete and returns drifted resources from all pages": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String("phonetool-test-api"),
+				}).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				gomock.InOrder(
+					m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+						StackDriftDetectionId: aws.String("1234"),
+					}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					}, nil),
+				)
+				filters := aws.StringSlice([]string{
+					cloudformation.StackResourceDriftStatusModified,
+					cloudformation.StackResourceDriftStatusDeleted,
+				})
+				gomock.InOrder(
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName:                       aws.String("phonetool-test-api"),
+						StackResourceDriftStatusFilters: filters,
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{LogicalResourceId: aws.String("Service")},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						NextToken:                       aws.String("next"),
+						StackName:                       aws.String("phonetool-test-api"),
+						StackResourceDriftStatusFilters: filters,
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{
+							{LogicalResourceId: aws.String("TaskRole")},
+						},
+					}, nil),
+				)
+				return m
+			},
+			wantedDrifts: []*StackResourceDrift{
+				{LogicalResourceId: aws.String("Service")},
+				{LogicalResourceId: aws.String("TaskRole")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			actual, err := c.StackDrift(context.Background(), "phonetool-test-api")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, actual)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_ListStacksWithTags(t *testing.T) {
 	mockAppTag := cloudformation.Tag{
 		Key:   aws.String("copilot-application"),
@@ -46,3 +46,19 @@ func ParseTemplateDescriptions(body string) (descriptionFor map[string]string, e
 	}
 	return descriptionFor, nil
 }
+
+// ParseTemplateParameters parses a YAML CloudFormation template to retrieve the names of the parameters it declares.
+func ParseTemplateParameters(body string) (map[string]bool, error) {
+	type template struct {
+		Parameters map[string]yaml.Node `yaml:"Parameters"`
+	}
+	var tpl template
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal cloudformation template: %w", err)
+	}
+	declared := make(map[string]bool, len(tpl.Parameters))
+	for name := range tpl.Parameters {
+		declared[name] = true
+	}
+	return declared, nil
+}
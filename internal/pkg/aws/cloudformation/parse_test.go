@@ -58,3 +58,29 @@ func TestParseTemplateDescriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTemplateParameters(t *testing.T) {
+	// GIVEN
+	body := `
+Parameters:
+  AppName:
+    Type: String
+  ContainerImage:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Join ['', [/copilot/, !Ref AppName]]
+`
+
+	// WHEN
+	params, err := ParseTemplateParameters(body)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{
+		"AppName":        true,
+		"ContainerImage": true,
+	}, params)
+}
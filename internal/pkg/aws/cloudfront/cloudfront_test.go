@@ -0,0 +1,78 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudfront
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFront_CreateInvalidation(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID  string
+		wantedErr error
+	}{
+		"should wrap the error if the invalidation cannot be created": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateInvalidation(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("create invalidation for distribution E2EXAMPLE: some error"),
+		},
+		"should return the ID of the invalidation": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateInvalidation(&cloudfront.CreateInvalidationInput{
+					DistributionId: aws.String("E2EXAMPLE"),
+					InvalidationBatch: &cloudfront.InvalidationBatch{
+						CallerReference: aws.String("1600000000000000000"),
+						Paths: &cloudfront.Paths{
+							Items:    aws.StringSlice([]string{"/*"}),
+							Quantity: aws.Int64(1),
+						},
+					},
+				}).Return(&cloudfront.CreateInvalidationOutput{
+					Invalidation: &cloudfront.Invalidation{
+						Id: aws.String("I2EXAMPLE"),
+					},
+				}, nil)
+			},
+			wantedID: "I2EXAMPLE",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := CloudFront{
+				client: mockClient,
+				now: func() time.Time {
+					return time.Unix(1600000000, 0)
+				},
+			}
+
+			// WHEN
+			id, err := client.CreateInvalidation("E2EXAMPLE", []string{"/*"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
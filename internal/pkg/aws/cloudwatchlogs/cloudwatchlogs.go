@@ -18,6 +18,9 @@ import (
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	// maxFilterLogStreams is the maximum number of log streams that a FilterLogEvents call can filter.
+	maxFilterLogStreams = 100
 )
 
 var (
@@ -27,6 +30,7 @@ var (
 
 type api interface {
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
+	FilterLogEvents(input *cloudwatchlogs.FilterLogEventsInput) (*cloudwatchlogs.FilterLogEventsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
 }
 
@@ -51,6 +55,7 @@ type LogEventsOpts struct {
 	StartTime           *int64
 	EndTime             *int64
 	StreamLastEventTime map[string]int64
+	FilterPattern       string // If set, only retrieve the events matching the CloudWatch Logs filter pattern.
 }
 
 // New returns a CloudWatchLogs configured against the input session.
@@ -89,7 +94,6 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreams ...string) ([]st
 
 // LogEvents returns an array of Cloudwatch Logs events.
 func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
-	var events []*Event
 	in := initGetLogEventsInput(opts)
 	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreams...)
 	if err != nil {
@@ -99,6 +103,32 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	for k, v := range opts.StreamLastEventTime {
 		streamLastEventTime[k] = v
 	}
+	var events []*Event
+	if opts.FilterPattern != "" {
+		events, err = c.filterLogEvents(opts, logStreams, streamLastEventTime)
+	} else {
+		events, err = c.getLogEvents(in, logStreams, streamLastEventTime)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+	limit := int(aws.Int64Value(in.Limit))
+	if limit != 0 {
+		return &LogEventsOutput{
+			Events:              truncateEvents(limit, events),
+			StreamLastEventTime: streamLastEventTime,
+		}, nil
+	}
+	return &LogEventsOutput{
+		Events:              events,
+		StreamLastEventTime: streamLastEventTime,
+	}, nil
+}
+
+// getLogEvents returns the latest events of each log stream, and records the time of the last event of each stream.
+func (c *CloudWatchLogs) getLogEvents(in *cloudwatchlogs.GetLogEventsInput, logStreams []string, streamLastEventTime map[string]int64) ([]*Event, error) {
+	var events []*Event
 	for _, logStream := range logStreams {
 		// Set override value
 		in.SetLogStreamName(logStream)
@@ -110,7 +140,7 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 		// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
 		resp, err := c.client.GetLogEvents(in)
 		if err != nil {
-			return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
+			return nil, fmt.Errorf("get log events of %s/%s: %w", aws.StringValue(in.LogGroupName), logStream, err)
 		}
 
 		for _, event := range resp.Events {
@@ -126,18 +156,77 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 			streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
 		}
 	}
-	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
-	limit := int(aws.Int64Value(in.Limit))
-	if limit != 0 {
-		return &LogEventsOutput{
-			Events:              truncateEvents(limit, events),
-			StreamLastEventTime: streamLastEventTime,
-		}, nil
+	return events, nil
+}
+
+// filterLogEvents returns all the events of the log streams that match the filter pattern, and records the time
+// of the last event of each stream. The log streams are filtered in batches of up to maxFilterLogStreams.
+// Unlike GetLogEvents, FilterLogEvents returns the oldest events first, so we retrieve all the pages
+// and let the caller keep the latest events up to the limit.
+func (c *CloudWatchLogs) filterLogEvents(opts LogEventsOpts, logStreams []string, streamLastEventTime map[string]int64) ([]*Event, error) {
+	var events []*Event
+	for start := 0; start < len(logStreams); start += maxFilterLogStreams {
+		end := start + maxFilterLogStreams
+		if end > len(logStreams) {
+			end = len(logStreams)
+		}
+		batch := logStreams[start:end]
+		in := &cloudwatchlogs.FilterLogEventsInput{
+			LogGroupName:   aws.String(opts.LogGroup),
+			LogStreamNames: aws.StringSlice(batch),
+			FilterPattern:  aws.String(opts.FilterPattern),
+			StartTime:      filterStartTime(opts.StartTime, batch, streamLastEventTime),
+			EndTime:        opts.EndTime,
+		}
+		for {
+			resp, err := c.client.FilterLogEvents(in)
+			if err != nil {
+				return nil, fmt.Errorf("filter log events of log group %s: %w", opts.LogGroup, err)
+			}
+			for _, event := range resp.Events {
+				logStream, timestamp := aws.StringValue(event.LogStreamName), aws.Int64Value(event.Timestamp)
+				if last := streamLastEventTime[logStream]; last != 0 && timestamp <= last {
+					// The batch starts from the earliest stream, so skip the events that were already retrieved.
+					continue
+				}
+				events = append(events, &Event{
+					LogStreamName: logStream,
+					IngestionTime: aws.Int64Value(event.IngestionTime),
+					Message:       aws.StringValue(event.Message),
+					Timestamp:     timestamp,
+				})
+			}
+			if resp.NextToken == nil {
+				break
+			}
+			in.NextToken = resp.NextToken
+		}
 	}
-	return &LogEventsOutput{
-		Events:              events,
-		StreamLastEventTime: streamLastEventTime,
-	}, nil
+	for _, event := range events {
+		if event.Timestamp > streamLastEventTime[event.LogStreamName] {
+			streamLastEventTime[event.LogStreamName] = event.Timestamp
+		}
+	}
+	return events, nil
+}
+
+// filterStartTime returns the earliest time to filter the events of the log streams from. For each stream, it is
+// the time right after its last retrieved event, or the start time if none of its events were retrieved yet.
+func filterStartTime(startTime *int64, logStreams []string, streamLastEventTime map[string]int64) *int64 {
+	var earliest *int64
+	for _, logStream := range logStreams {
+		streamStartTime := startTime
+		if last := streamLastEventTime[logStream]; last != 0 {
+			streamStartTime = aws.Int64(last + 1)
+		}
+		if streamStartTime == nil {
+			return nil
+		}
+		if earliest == nil || *streamStartTime < *earliest {
+			earliest = streamStartTime
+		}
+	}
+	return earliest
 }
 
 func truncateEvents(limit int, events []*Event) []*Event {
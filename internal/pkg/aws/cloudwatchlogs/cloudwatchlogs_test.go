@@ -24,6 +24,7 @@ func TestLogEvents(t *testing.T) {
 		endTime                  *int64
 		limit                    *int64
 		lastEventTime            map[string]int64
+		filterPattern            string
 		mockcloudwatchlogsClient func(m *mocks.Mockapi)
 
 		wantLogEvents     []*Event
@@ -186,6 +187,173 @@ func TestLogEvents(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		"should filter log events across all pages when a filter pattern is set": {
+			logGroupName:  "mockLogGroup",
+			limit:         aws.Int64(2),
+			filterPattern: "ERROR",
+			lastEventTime: map[string]int64{
+				"mockLogStream": 1,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"mockLogStream"}),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(2),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("ERROR 1"),
+							Timestamp:     aws.Int64(2),
+						},
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("ERROR 2"),
+							Timestamp:     aws.Int64(3),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice([]string{"mockLogStream"}),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(2),
+					NextToken:      aws.String("next"),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+							Message:       aws.String("ERROR 3"),
+							Timestamp:     aws.Int64(4),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "mockLogStream",
+					Message:       "ERROR 2",
+					Timestamp:     3,
+				},
+				{
+					LogStreamName: "mockLogStream",
+					Message:       "ERROR 3",
+					Timestamp:     4,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"mockLogStream": 4,
+			},
+		},
+		"should filter up to 100 log streams per call and skip the events that were already retrieved": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			startTime:     aws.Int64(1),
+			lastEventTime: map[string]int64{
+				"mockLogStream0": 5,
+			},
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				var logStreams []*cloudwatchlogs.LogStream
+				var logStreamNames []string
+				for i := 0; i < 101; i++ {
+					name := fmt.Sprintf("mockLogStream%d", i)
+					logStreams = append(logStreams, &cloudwatchlogs.LogStream{
+						LogStreamName: aws.String(name),
+					})
+					logStreamNames = append(logStreamNames, name)
+				}
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: logStreams,
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice(logStreamNames[:100]),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(1),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream0"),
+							Message:       aws.String("ERROR 1"),
+							Timestamp:     aws.Int64(5),
+						},
+						{
+							LogStreamName: aws.String("mockLogStream1"),
+							Message:       aws.String("ERROR 2"),
+							Timestamp:     aws.Int64(5),
+						},
+						{
+							LogStreamName: aws.String("mockLogStream0"),
+							Message:       aws.String("ERROR 3"),
+							Timestamp:     aws.Int64(6),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(&cloudwatchlogs.FilterLogEventsInput{
+					LogGroupName:   aws.String("mockLogGroup"),
+					LogStreamNames: aws.StringSlice(logStreamNames[100:]),
+					FilterPattern:  aws.String("ERROR"),
+					StartTime:      aws.Int64(1),
+				}).Return(&cloudwatchlogs.FilterLogEventsOutput{
+					Events: []*cloudwatchlogs.FilteredLogEvent{
+						{
+							LogStreamName: aws.String("mockLogStream100"),
+							Message:       aws.String("ERROR 4"),
+							Timestamp:     aws.Int64(7),
+						},
+					},
+				}, nil)
+			},
+
+			wantLogEvents: []*Event{
+				{
+					LogStreamName: "mockLogStream1",
+					Message:       "ERROR 2",
+					Timestamp:     5,
+				},
+				{
+					LogStreamName: "mockLogStream0",
+					Message:       "ERROR 3",
+					Timestamp:     6,
+				},
+				{
+					LogStreamName: "mockLogStream100",
+					Message:       "ERROR 4",
+					Timestamp:     7,
+				},
+			},
+			wantLastEventTime: map[string]int64{
+				"mockLogStream0":   6,
+				"mockLogStream1":   5,
+				"mockLogStream100": 7,
+			},
+		},
+		"returns error if fail to filter log events": {
+			logGroupName:  "mockLogGroup",
+			filterPattern: "ERROR",
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogStreams(gomock.Any()).Return(&cloudwatchlogs.DescribeLogStreamsOutput{
+					LogStreams: []*cloudwatchlogs.LogStream{
+						{
+							LogStreamName: aws.String("mockLogStream"),
+						},
+					},
+				}, nil)
+				m.EXPECT().FilterLogEvents(gomock.Any()).Return(nil, mockError)
+			},
+
+			wantErr: fmt.Errorf("filter log events of log group %s: %w", "mockLogGroup", mockError),
+		},
 		"returns error if fail to describe log streams": {
 			logGroupName: "mockLogGroup",
 			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
@@ -258,6 +426,7 @@ func TestLogEvents(t *testing.T) {
 				LogStreams:          tc.logStream,
 				StartTime:           tc.startTime,
 				StreamLastEventTime: tc.lastEventTime,
+				FilterPattern:       tc.filterPattern,
 			})
 
 			if gotErr != nil {
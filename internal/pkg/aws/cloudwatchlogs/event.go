@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	c "github.com/fatih/color"
@@ -26,14 +27,34 @@ type Event struct {
 }
 
 // JSONString returns the stringified LogEvent struct with json format.
+// If the message is a JSON object, it is embedded as is instead of as an escaped string.
 func (l *Event) JSONString() (string, error) {
-	b, err := json.Marshal(l)
+	b, err := json.Marshal(struct {
+		LogStreamName string      `json:"logStreamName"`
+		IngestionTime int64       `json:"ingestionTime"`
+		Message       interface{} `json:"message"`
+		Timestamp     int64       `json:"timestamp"`
+	}{
+		LogStreamName: l.LogStreamName,
+		IngestionTime: l.IngestionTime,
+		Message:       l.JSONMessage(),
+		Timestamp:     l.Timestamp,
+	})
 	if err != nil {
 		return "", fmt.Errorf("marshal a log event: %w", err)
 	}
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+// JSONMessage returns the message as a json.RawMessage if it is a JSON object, otherwise returns the raw string.
+func (l *Event) JSONMessage() interface{} {
+	msg := strings.TrimSpace(l.Message)
+	if strings.HasPrefix(msg, "{") && json.Valid([]byte(msg)) {
+		return json.RawMessage(msg)
+	}
+	return l.Message
+}
+
 // HumanString returns the stringified LogEvent struct with human readable format.
 func (l *Event) HumanString() string {
 	for _, code := range fatalCodes {
@@ -59,3 +59,37 @@ func TestColorCodeMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_JSONString(t *testing.T) {
+	testCases := map[string]struct {
+		givenMessage string
+		wanted       string
+	}{
+		"should escape messages that are not JSON objects": {
+			givenMessage: `GET / "200"`,
+			wanted:       `{"logStreamName":"copilot/fe/1234","ingestionTime":0,"message":"GET / \"200\"","timestamp":1}` + "\n",
+		},
+		"should escape messages that are JSON values other than objects": {
+			givenMessage: `"hello"`,
+			wanted:       `{"logStreamName":"copilot/fe/1234","ingestionTime":0,"message":"\"hello\"","timestamp":1}` + "\n",
+		},
+		"should pass through messages that are JSON objects": {
+			givenMessage: `{"level": "error", "msg": "timeout"}` + "\n",
+			wanted:       `{"logStreamName":"copilot/fe/1234","ingestionTime":0,"message":{"level":"error","msg":"timeout"},"timestamp":1}` + "\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			event := &Event{
+				LogStreamName: "copilot/fe/1234",
+				Message:       tc.givenMessage,
+				Timestamp:     1,
+			}
+
+			got, err := event.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -7,6 +7,8 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -33,6 +35,7 @@ type api interface {
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
+	ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
@@ -42,6 +45,7 @@ type api interface {
 
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartStreamingSession(ssmSession *ecs.Session, stdin io.Reader, stdout io.Writer) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -71,6 +75,11 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+
+	// Optional. If Stdout is set, the session streams Stdin to the command and writes its output to Stdout
+	// instead of attaching to the terminal.
+	Stdin  io.Reader
+	Stdout io.Writer
 }
 
 // New returns a Service configured against the input session.
@@ -97,6 +106,33 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// TaskDefinitionRevisions returns the ARNs of the active task definitions in the family, from the newest revision to the oldest.
+func (e *ECS) TaskDefinitionRevisions(family string) ([]string, error) {
+	var arns []string
+	in := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(family),
+		Sort:         aws.String(ecs.SortOrderDesc),
+		Status:       aws.String(ecs.TaskDefinitionStatusActive),
+	}
+	for {
+		resp, err := e.client.ListTaskDefinitions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list task definitions of family %s: %w", family, err)
+		}
+		for _, arn := range aws.StringValueSlice(resp.TaskDefinitionArns) {
+			// FamilyPrefix also matches the families whose name starts with the family.
+			if strings.HasSuffix(arn[:strings.LastIndex(arn, ":")], "/"+family) {
+				arns = append(arns, arn)
+			}
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		in.NextToken = resp.NextToken
+	}
+	return arns, nil
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	resp, err := e.client.DescribeServices(&ecs.DescribeServicesInput{
@@ -401,7 +437,12 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		return &ErrExecuteCommand{err: err}
 	}
 	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
+	if in.Stdout != nil {
+		err = e.newSessStarter().StartStreamingSession(execCmdresp.Session, in.Stdin, in.Stdout)
+	} else {
+		err = e.newSessStarter().StartSession(execCmdresp.Session)
+	}
+	if err != nil {
 		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
 	}
 	return err
@@ -4,8 +4,11 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -104,6 +107,77 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_TaskDefinitionRevisions(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedARNs []string
+		wantedErr  error
+	}{
+		"should wrap the error if listing task definitions fails": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list task definitions of family phonetool-test-api: some error"),
+		},
+		"should return the revisions of the family across pages": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-api"),
+					Sort:         aws.String("DESC"),
+					Status:       aws.String("ACTIVE"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api-worker:8",
+					}),
+					NextToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-api"),
+					Sort:         aws.String("DESC"),
+					Status:       aws.String("ACTIVE"),
+					NextToken:    aws.String("mockToken"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{
+						"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+					}),
+				}, nil)
+			},
+			wantedARNs: []string{
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3",
+				"arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			arns, err := service.TaskDefinitionRevisions("phonetool-test-api")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARNs, arns)
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -1163,7 +1237,10 @@ func TestECS_ExecuteCommand(t *testing.T) {
 		SessionId: aws.String("mockSessID"),
 	}
 	mockErr := errors.New("some error")
+	mockStdin, mockStdout := strings.NewReader("mockInput"), &bytes.Buffer{}
 	testCases := map[string]struct {
+		inStdin         io.Reader
+		inStdout        io.Writer
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
 		wantedError     error
@@ -1202,6 +1279,18 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				m.EXPECT().StartSession(mockSess).Return(nil)
 			},
 		},
+		"streams the input and output of the session if stdout is set": {
+			inStdin:  mockStdin,
+			inStdout: mockStdout,
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartStreamingSession(mockSess, mockStdin, mockStdout).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1226,6 +1315,8 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Stdin:     tc.inStdin,
+				Stdout:    tc.inStdout,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	io "io"
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
@@ -109,6 +110,21 @@ func (mr *MockapiMockRecorder) ExecuteCommand(input interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*Mockapi)(nil).ExecuteCommand), input)
 }
 
+// ListTaskDefinitions mocks base method.
+func (m *Mockapi) ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskDefinitions", input)
+	ret0, _ := ret[0].(*ecs.ListTaskDefinitionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskDefinitions indicates an expected call of ListTaskDefinitions.
+func (mr *MockapiMockRecorder) ListTaskDefinitions(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskDefinitions", reflect.TypeOf((*Mockapi)(nil).ListTaskDefinitions), input)
+}
+
 // ListTasks mocks base method.
 func (m *Mockapi) ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error) {
 	m.ctrl.T.Helper()
@@ -219,3 +235,17 @@ func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
 }
+
+// StartStreamingSession mocks base method.
+func (m *MockssmSessionStarter) StartStreamingSession(ssmSession *ecs.Session, stdin io.Reader, stdout io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartStreamingSession", ssmSession, stdin, stdout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartStreamingSession indicates an expected call of StartStreamingSession.
+func (mr *MockssmSessionStarterMockRecorder) StartStreamingSession(ssmSession, stdin, stdout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartStreamingSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartStreamingSession), ssmSession, stdin, stdout)
+}
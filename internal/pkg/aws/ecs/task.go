@@ -274,6 +274,18 @@ func TaskID(taskARN string) (string, error) {
 	return taskID, nil
 }
 
+// ClusterName parses the cluster ARN and returns the cluster name.
+// For example: arn:aws:ecs:us-west-2:123456789:cluster/my-project-test-Cluster-9F7Y0RLP60R7
+// returns my-project-test-Cluster-9F7Y0RLP60R7.
+func ClusterName(clusterARN string) (string, error) {
+	parsedARN, err := arn.Parse(clusterARN)
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	resources := strings.Split(parsedARN.Resource, "/")
+	return resources[len(resources)-1], nil
+}
+
 // TaskDefinitionVersion takes a task definition ARN and returns its version.
 // For example, given "arn:aws:ecs:us-east-1:568623488001:task-definition/some-task-def:6", it returns 6.
 func TaskDefinitionVersion(taskDefARN string) (int, error) {
@@ -336,6 +336,39 @@ func Test_TaskID(t *testing.T) {
 	}
 }
 
+func Test_ClusterName(t *testing.T) {
+	testCases := map[string]struct {
+		clusterARN string
+
+		wantErr  error
+		wantName string
+	}{
+		"bad unparsable cluster ARN": {
+			clusterARN: "mockBadClusterARN",
+			wantErr:    fmt.Errorf("parse ECS cluster ARN: arn: invalid prefix"),
+		},
+		"success": {
+			clusterARN: "arn:aws:ecs:us-west-2:123456789:cluster/my-project-test-Cluster-9F7Y0RLP60R7",
+			wantName:   "my-project-test-Cluster-9F7Y0RLP60R7",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			gotName, gotErr := ClusterName(tc.clusterARN)
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantName, gotName)
+			}
+		})
+	}
+}
+
 func TestTaskDefinition_EnvVars(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers []*ecs.ContainerDefinition
@@ -73,6 +73,34 @@ func (e *ELBV2) ListenerRuleHostHeaders(ruleARN string) ([]string, error) {
 	return hostHeaders, nil
 }
 
+// TargetGroupWeights returns the weights of the target groups that a listener rule forwards traffic to, keyed by target group ARN.
+func (e *ELBV2) TargetGroupWeights(ruleARN string) (map[string]int, error) {
+	resp, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+		RuleArns: aws.StringSlice([]string{ruleARN}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get listener rule for %s: %w", ruleARN, err)
+	}
+	if len(resp.Rules) == 0 {
+		return nil, fmt.Errorf("cannot find listener rule %s", ruleARN)
+	}
+	weights := make(map[string]int)
+	for _, action := range resp.Rules[0].Actions {
+		if aws.StringValue(action.Type) != elbv2.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig == nil {
+			// A forward action without a forward config sends all the traffic to a single target group.
+			weights[aws.StringValue(action.TargetGroupArn)] = 1
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			weights[aws.StringValue(tg.TargetGroupArn)] = int(aws.Int64Value(tg.Weight))
+		}
+	}
+	return weights, nil
+}
+
 // TargetHealth wraps up elbv2.TargetHealthDescription.
 type TargetHealth elbv2.TargetHealthDescription
 
@@ -219,6 +219,111 @@ func TestELBV2_ListenerRuleHostHeaders(t *testing.T) {
 	}
 }
 
+func TestELBV2_TargetGroupWeights(t *testing.T) {
+	mockARN := "mockListenerRuleARN"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wanted      map[string]int
+		wantedError error
+	}{
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{mockARN}),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get listener rule for mockListenerRuleARN: some error"),
+		},
+		"cannot find listener rule": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{mockARN}),
+				}).Return(&elbv2.DescribeRulesOutput{}, nil)
+			},
+			wantedError: fmt.Errorf("cannot find listener rule mockListenerRuleARN"),
+		},
+		"success with weighted target groups": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{mockARN}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type: aws.String(elbv2.ActionTypeEnumForward),
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{
+												TargetGroupArn: aws.String("blue"),
+												Weight:         aws.Int64(80),
+											},
+											{
+												TargetGroupArn: aws.String("green"),
+												Weight:         aws.Int64(20),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: map[string]int{
+				"blue":  80,
+				"green": 20,
+			},
+		},
+		"success with a single target group": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					RuleArns: aws.StringSlice([]string{mockARN}),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Actions: []*elbv2.Action{
+								{
+									Type:           aws.String(elbv2.ActionTypeEnumForward),
+									TargetGroupArn: aws.String("blue"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: map[string]int{
+				"blue": 1,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			got, err := elbv2Client.TargetGroupWeights(mockARN)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestTargetHealth_HealthStatus(t *testing.T) {
 	testCases := map[string]struct {
 		inTargetHealth *TargetHealth
@@ -9,7 +9,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 
@@ -21,7 +23,15 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 )
 
-const notFound = "NotFound"
+const (
+	// EndpointsID is the ID to look up the S3 service endpoint.
+	EndpointsID = s3.EndpointsID
+
+	notFound = "NotFound"
+
+	// maxDeleteObjects is the maximum number of objects that can be deleted in a single request.
+	maxDeleteObjects = 1000
+)
 
 type s3ManagerAPI interface {
 	Upload(input *s3manager.UploadInput, options ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error)
@@ -29,6 +39,8 @@ type s3ManagerAPI interface {
 
 type s3API interface {
 	ListObjectVersions(input *s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
+	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 }
@@ -39,9 +51,32 @@ type NamedBinary interface {
 	Content() []byte
 }
 
+// Object is an object stored in a bucket.
+type Object struct {
+	Key          string
+	LastModified time.Time
+}
+
 // CompressAndUploadFunc is invoked to zip multiple template contents and upload them to an S3 bucket under the specified key.
 type CompressAndUploadFunc func(key string, objects ...NamedBinary) (url string, err error)
 
+// UploadOption sets optional properties of an uploaded object.
+type UploadOption func(in *s3manager.UploadInput)
+
+// WithContentType sets the Content-Type header of the uploaded object.
+func WithContentType(contentType string) UploadOption {
+	return func(in *s3manager.UploadInput) {
+		in.ContentType = aws.String(contentType)
+	}
+}
+
+// WithCacheControl sets the Cache-Control header of the uploaded object.
+func WithCacheControl(cacheControl string) UploadOption {
+	return func(in *s3manager.UploadInput) {
+		in.CacheControl = aws.String(cacheControl)
+	}
+}
+
 // S3 wraps an Amazon Simple Storage Service client.
 type S3 struct {
 	s3Manager s3ManagerAPI
@@ -81,8 +116,85 @@ func (s *S3) ZipAndUpload(bucket, key string, files ...NamedBinary) (string, err
 // Upload uploads a file to an S3 bucket under the specified key.
 // Per s3's recommendation https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html:
 // The bucket owner, in addition to the object owner, is granted full control.
-func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
-	return s.upload(bucket, key, data)
+func (s *S3) Upload(bucket, key string, data io.Reader, opts ...UploadOption) (string, error) {
+	return s.upload(bucket, key, data, opts...)
+}
+
+// ListObjects returns the objects in the bucket whose key starts with the prefix, sorted from the oldest to the newest.
+func (s *S3) ListObjects(bucket, prefix string) ([]Object, error) {
+	var objects []Object
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}
+	for {
+		resp, err := s.s3Client.ListObjectsV2(in)
+		if err != nil {
+			return nil, fmt.Errorf("list objects with prefix %s in bucket %s: %w", prefix, bucket, err)
+		}
+		for _, obj := range resp.Contents {
+			objects = append(objects, Object{
+				Key:          aws.StringValue(obj.Key),
+				LastModified: aws.TimeValue(obj.LastModified),
+			})
+		}
+		if !aws.BoolValue(resp.IsTruncated) {
+			break
+		}
+		in.ContinuationToken = resp.NextContinuationToken
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].LastModified.Before(objects[j].LastModified)
+	})
+	return objects, nil
+}
+
+// Download returns the content of the object stored at key in the bucket.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	resp, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get object %s from bucket %s: %w", key, bucket, err)
+	}
+	defer resp.Body.Close()
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
+// DeleteObjects deletes the latest version of the objects stored at keys in the bucket.
+func (s *S3) DeleteObjects(bucket string, keys []string) error {
+	for start := 0; start < len(keys); start += maxDeleteObjects {
+		end := start + maxDeleteObjects
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+		resp, err := s.s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("delete objects from bucket %s: %w", bucket, err)
+		}
+		if len(resp.Errors) > 0 {
+			failed := resp.Errors[0]
+			return fmt.Errorf("delete object %s from bucket %s: %s", aws.StringValue(failed.Key), bucket, aws.StringValue(failed.Message))
+		}
+	}
+	return nil
 }
 
 // EmptyBucket deletes all objects within the bucket.
@@ -192,13 +304,16 @@ func (s *S3) isBucketExists(bucket string) (bool, error) {
 	return true, nil
 }
 
-func (s *S3) upload(bucket, key string, buf io.Reader) (string, error) {
+func (s *S3) upload(bucket, key string, buf io.Reader, opts ...UploadOption) (string, error) {
 	in := &s3manager.UploadInput{
 		Body:   buf,
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(s3.ObjectCannedACLBucketOwnerFullControl),
 	}
+	for _, opt := range opts {
+		opt(in)
+	}
 	resp, err := s.s3Manager.Upload(in)
 	if err != nil {
 		return "", fmt.Errorf("upload %s to bucket %s: %w", key, bucket, err)
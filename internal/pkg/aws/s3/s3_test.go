@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -95,6 +97,7 @@ func TestS3_ZipAndUpload(t *testing.T) {
 
 func TestS3_Upload(t *testing.T) {
 	testCases := map[string]struct {
+		inOpts              []UploadOption
 		mockS3ManagerClient func(m *mocks.Mocks3ManagerAPI)
 
 		wantedURL string
@@ -124,6 +127,19 @@ func TestS3_Upload(t *testing.T) {
 			},
 			wantedURL: "mockURL",
 		},
+		"should upload with the content type and cache control headers": {
+			inOpts: []UploadOption{WithContentType("text/html; charset=utf-8"), WithCacheControl("no-cache")},
+			mockS3ManagerClient: func(m *mocks.Mocks3ManagerAPI) {
+				m.EXPECT().Upload(gomock.Any()).Do(func(in *s3manager.UploadInput, _ ...func(*s3manager.Uploader)) {
+					require.Equal(t, "text/html; charset=utf-8", aws.StringValue(in.ContentType))
+					require.Equal(t, "no-cache", aws.StringValue(in.CacheControl))
+					require.Equal(t, s3.ObjectCannedACLBucketOwnerFullControl, aws.StringValue(in.ACL))
+				}).Return(&s3manager.UploadOutput{
+					Location: "mockURL",
+				}, nil)
+			},
+			wantedURL: "mockURL",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -139,7 +155,7 @@ func TestS3_Upload(t *testing.T) {
 				s3Manager: mockS3ManagerClient,
 			}
 
-			gotURL, gotErr := service.Upload("mockBucket", "mockFileName", bytes.NewBuffer([]byte("bar")))
+			gotURL, gotErr := service.Upload("mockBucket", "mockFileName", bytes.NewBuffer([]byte("bar")), tc.inOpts...)
 
 			if gotErr != nil {
 				require.EqualError(t, gotErr, tc.wantError.Error())
@@ -375,6 +391,227 @@ func TestS3_EmptyBucket(t *testing.T) {
 	}
 }
 
+func TestS3_ListObjects(t *testing.T) {
+	older, newer := time.Unix(1600000000, 0), time.Unix(1700000000, 0)
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedObjects []Object
+		wantedErr     error
+	}{
+		"should wrap the error if listing objects fails": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list objects with prefix manual/templates/ in bucket mockBucket: some error"),
+		},
+		"should return every page of objects sorted by last modified time": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("manual/templates/"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{
+							Key:          aws.String("manual/templates/new.yml"),
+							LastModified: aws.Time(newer),
+						},
+					},
+					IsTruncated:           aws.Bool(true),
+					NextContinuationToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("manual/templates/"),
+					ContinuationToken: aws.String("mockToken"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{
+							Key:          aws.String("manual/templates/old.yml"),
+							LastModified: aws.Time(older),
+						},
+					},
+					IsTruncated: aws.Bool(false),
+				}, nil)
+			},
+			wantedObjects: []Object{
+				{
+					Key:          "manual/templates/old.yml",
+					LastModified: older,
+				},
+				{
+					Key:          "manual/templates/new.yml",
+					LastModified: newer,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			objects, err := service.ListObjects("mockBucket", "manual/templates/")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedObjects, objects)
+		})
+	}
+}
+
+func TestS3_DeleteObjects(t *testing.T) {
+	keys := make([]string, 1001)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key%d", i)
+	}
+	testCases := map[string]struct {
+		inKeys       []string
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedErr error
+	}{
+		"should not make any request if there are no keys": {
+			mockS3Client: func(m *mocks.Mocks3API) {},
+		},
+		"should wrap the error if deleting objects fails": {
+			inKeys: []string{"index.html"},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("delete objects from bucket mockBucket: some error"),
+		},
+		"should return an error if an object cannot be deleted": {
+			inKeys: []string{"index.html"},
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).Return(&s3.DeleteObjectsOutput{
+					Errors: []*s3.Error{
+						{
+							Key:     aws.String("index.html"),
+							Message: aws.String("Access Denied"),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("delete object index.html from bucket mockBucket: Access Denied"),
+		},
+		"should delete the objects in batches of 1000": {
+			inKeys: keys,
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().DeleteObjects(gomock.Any()).DoAndReturn(func(in *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error) {
+					require.Equal(t, "mockBucket", aws.StringValue(in.Bucket))
+					require.Len(t, in.Delete.Objects, 1000)
+					require.Equal(t, "key0", aws.StringValue(in.Delete.Objects[0].Key))
+					return &s3.DeleteObjectsOutput{}, nil
+				})
+				m.EXPECT().DeleteObjects(&s3.DeleteObjectsInput{
+					Bucket: aws.String("mockBucket"),
+					Delete: &s3.Delete{
+						Objects: []*s3.ObjectIdentifier{
+							{
+								Key: aws.String("key1000"),
+							},
+						},
+						Quiet: aws.Bool(true),
+					},
+				}).Return(&s3.DeleteObjectsOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			err := service.DeleteObjects("mockBucket", tc.inKeys)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"should wrap the error if getting the object fails": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(strings.NewReader("hello")),
+				}, nil)
+			},
+			wantedContent: "hello",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			// WHEN
+			content, err := service.Download("mockBucket", "mockKey")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(content))
+		})
+	}
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
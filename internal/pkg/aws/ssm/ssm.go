@@ -8,28 +8,48 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocument             = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteHostDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+	fmtECSTarget                       = "ecs:%s_%s_%s"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+	TerminateSession(input *ssm.TerminateSessionInput) (*ssm.TerminateSessionOutput, error)
+}
+
+type ssmSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() ssmSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() ssmSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -115,6 +135,119 @@ func (s *SSM) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return (*PutSecretOutput)(output), nil
 }
 
+// Secret holds the metadata of a secret. The value of the secret is never retrieved.
+type Secret struct {
+	Name             string
+	Version          int64
+	LastModifiedDate time.Time
+}
+
+// ListSecrets returns the secrets that have all of the given tags, sorted by name.
+func (s *SSM) ListSecrets(tags map[string]string) ([]Secret, error) {
+	var filters []*ssm.ParameterStringFilter
+	for _, tag := range convertTags(tags) {
+		filters = append(filters, &ssm.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", aws.StringValue(tag.Key))),
+			Values: []*string{tag.Value},
+		})
+	}
+	filters = append(filters, &ssm.ParameterStringFilter{
+		Key:    aws.String("Type"),
+		Values: aws.StringSlice([]string{ssm.ParameterTypeSecureString}),
+	})
+
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: filters,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters: %w", err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, Secret{
+				Name:             aws.StringValue(param.Name),
+				Version:          aws.Int64Value(param.Version),
+				LastModifiedDate: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, nil
+}
+
+// DeleteSecret deletes the secret. It returns nil if the secret does not exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return nil
+	}
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == ssm.ErrCodeParameterNotFound {
+		return nil
+	}
+	return fmt.Errorf("delete parameter %s: %w", name, err)
+}
+
+// PortForwardInput holds the fields needed to forward a local port to a port of a running ECS task.
+type PortForwardInput struct {
+	Cluster    string // Name of the cluster.
+	Task       string // ID of the task.
+	RuntimeID  string // Runtime ID of the container the session is opened in.
+	LocalPort  string
+	RemotePort string
+	RemoteHost string // Optional. Host reachable from the task to forward to, instead of the task itself.
+}
+
+func (in PortForwardInput) startSessionInput() *ssm.StartSessionInput {
+	params := map[string][]*string{
+		"portNumber":      aws.StringSlice([]string{in.RemotePort}),
+		"localPortNumber": aws.StringSlice([]string{in.LocalPort}),
+	}
+	document := portForwardingDocument
+	if in.RemoteHost != "" {
+		document = portForwardingToRemoteHostDocument
+		params["host"] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	return &ssm.StartSessionInput{
+		DocumentName: aws.String(document),
+		Parameters:   params,
+		Target:       aws.String(fmt.Sprintf(fmtECSTarget, in.Cluster, in.Task, in.RuntimeID)),
+	}
+}
+
+// PortForward forwards a local port to a port of a running ECS task until the session ends,
+// and then terminates the session.
+func (s *SSM) PortForward(in PortForwardInput) (err error) {
+	input := in.startSessionInput()
+	resp, err := s.client.StartSession(input)
+	if err != nil {
+		return fmt.Errorf("start session to %s: %w", aws.StringValue(input.Target), err)
+	}
+	sessID := aws.StringValue(resp.SessionId)
+	defer func() {
+		// The session might still be open on the server side if the plugin exits on an interrupt.
+		if _, termErr := s.client.TerminateSession(&ssm.TerminateSessionInput{
+			SessionId: resp.SessionId,
+		}); termErr != nil && err == nil {
+			err = fmt.Errorf("terminate session %s: %w", sessID, termErr)
+		}
+	}()
+	if err := s.newSessStarter().StartPortForwardingSession(resp, input); err != nil {
+		return fmt.Errorf("start port forwarding session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
+
 func convertTags(inTags map[string]string) []*ssm.Tag {
 	// Sort the map so that the unit test won't be flaky.
 	keys := make([]string, 0, len(inTags))
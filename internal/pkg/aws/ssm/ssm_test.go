@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,281 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ListSecrets(t *testing.T) {
+	lastModified := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	wantedFilters := []*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("tag:copilot-application"),
+			Values: aws.StringSlice([]string{"myapp"}),
+		},
+		{
+			Key:    aws.String("tag:copilot-environment"),
+			Values: aws.StringSlice([]string{"test"}),
+		},
+		{
+			Key:    aws.String("Type"),
+			Values: aws.StringSlice([]string{"SecureString"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecrets []Secret
+		wantedError   error
+	}{
+		"returns the secrets of all the pages sorted by name": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: wantedFilters,
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:             aws.String("/copilot/myapp/test/secrets/token"),
+							Version:          aws.Int64(2),
+							LastModifiedDate: aws.Time(lastModified),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: wantedFilters,
+					NextToken:        aws.String("next"),
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:             aws.String("/copilot/myapp/test/secrets/db_password"),
+							Version:          aws.Int64(1),
+							LastModifiedDate: aws.Time(lastModified),
+						},
+					},
+				}, nil)
+			},
+			wantedSecrets: []Secret{
+				{
+					Name:             "/copilot/myapp/test/secrets/db_password",
+					Version:          1,
+					LastModifiedDate: lastModified,
+				},
+				{
+					Name:             "/copilot/myapp/test/secrets/token",
+					Version:          2,
+					LastModifiedDate: lastModified,
+				},
+			},
+		},
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe parameters: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			secrets, err := client.ListSecrets(map[string]string{
+				deploy.AppTagKey: "myapp",
+				deploy.EnvTagKey: "test",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, secrets)
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"deletes the secret": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String("/copilot/myapp/test/secrets/db_password"),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+		"returns nil if the secret does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+		},
+		"wraps other errors": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter /copilot/myapp/test/secrets/db_password: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockClient)
+			client := SSM{
+				client: mockClient,
+			}
+
+			// WHEN
+			err := client.DeleteSecret("/copilot/myapp/test/secrets/db_password")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSSM_PortForward(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	testCases := map[string]struct {
+		in         PortForwardInput
+		setupMocks func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter)
+
+		wantedError error
+	}{
+		"forwards the port to the task and terminates the session": {
+			in: PortForwardInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTaskID",
+				RuntimeID:  "mockRuntimeID",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				in := &ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+					},
+					Target: aws.String("ecs:mockCluster_mockTaskID_mockRuntimeID"),
+				}
+				m.EXPECT().StartSession(in).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(mockSession, in).Return(nil)
+				m.EXPECT().TerminateSession(&ssm.TerminateSessionInput{
+					SessionId: aws.String("mockSessionID"),
+				}).Return(&ssm.TerminateSessionOutput{}, nil)
+			},
+		},
+		"forwards the port to a remote host": {
+			in: PortForwardInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTaskID",
+				RuntimeID:  "mockRuntimeID",
+				LocalPort:  "5432",
+				RemotePort: "5432",
+				RemoteHost: "db.internal",
+			},
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				in := &ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"host":            aws.StringSlice([]string{"db.internal"}),
+						"portNumber":      aws.StringSlice([]string{"5432"}),
+						"localPortNumber": aws.StringSlice([]string{"5432"}),
+					},
+					Target: aws.String("ecs:mockCluster_mockTaskID_mockRuntimeID"),
+				}
+				m.EXPECT().StartSession(in).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(mockSession, in).Return(nil)
+				m.EXPECT().TerminateSession(gomock.Any()).Return(&ssm.TerminateSessionOutput{}, nil)
+			},
+		},
+		"wraps the error from starting the session": {
+			in: PortForwardInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTaskID",
+				RuntimeID:  "mockRuntimeID",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start session to ecs:mockCluster_mockTaskID_mockRuntimeID: some error"),
+		},
+		"terminates the session even if the plugin fails": {
+			in: PortForwardInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTaskID",
+				RuntimeID:  "mockRuntimeID",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				m.EXPECT().TerminateSession(gomock.Any()).Return(&ssm.TerminateSessionOutput{}, nil)
+			},
+			wantedError: errors.New("start port forwarding session mockSessionID using ssm plugin: some error"),
+		},
+		"wraps the error from terminating the session": {
+			in: PortForwardInput{
+				Cluster:    "mockCluster",
+				Task:       "mockTaskID",
+				RuntimeID:  "mockRuntimeID",
+				LocalPort:  "8080",
+				RemotePort: "80",
+			},
+			setupMocks: func(m *mocks.Mockapi, starter *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSession(gomock.Any()).Return(mockSession, nil)
+				starter.EXPECT().StartPortForwardingSession(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().TerminateSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("terminate session mockSessionID: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			mockStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.setupMocks(mockClient, mockStarter)
+			client := SSM{
+				client: mockClient,
+				newSessStarter: func() ssmSessionStarter {
+					return mockStarter
+				},
+			}
+
+			// WHEN
+			err := client.PortForward(tc.in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
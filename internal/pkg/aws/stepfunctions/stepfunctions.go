@@ -6,6 +6,7 @@ package stepfunctions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -15,6 +16,26 @@ import (
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
 	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+}
+
+// Execution is a summary of a state machine execution.
+type Execution struct {
+	ARN       string
+	Name      string
+	Status    string // One of the sfn.ExecutionStatus values, such as "SUCCEEDED".
+	StartDate time.Time
+	StopDate  time.Time // Zero if the execution is still running.
+}
+
+// HistoryEvent is an event in the history of a state machine execution.
+type HistoryEvent struct {
+	ID     int64
+	Type   string // One of the sfn.HistoryEventType values, such as "TaskSubmitted".
+	Output string // JSON output of a task, set for "TaskSubmitted" and "TaskSucceeded" events.
+	Error  string // Error code of a failure, set for failed, timed out and aborted events.
+	Cause  string // Explanation of a failure, set for failed, timed out and aborted events.
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -41,13 +62,83 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 	return aws.StringValue(out.Definition), nil
 }
 
-//Execute starts a state machine execution.
-func (s *StepFunctions) Execute(arn string) error {
-	_, err := s.client.StartExecution(&sfn.StartExecutionInput{
+// Execute starts a state machine execution and returns the ARN of the execution.
+func (s *StepFunctions) Execute(arn string) (string, error) {
+	out, err := s.client.StartExecution(&sfn.StartExecutionInput{
 		StateMachineArn: aws.String(arn),
 	})
 	if err != nil {
-		return fmt.Errorf("execute state machine %s: %w", arn, err)
+		return "", fmt.Errorf("execute state machine %s: %w", arn, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// ListExecutions returns up to maxResults executions of the state machine, most recent first.
+func (s *StepFunctions) ListExecutions(stateMachineARN string, maxResults int) ([]Execution, error) {
+	out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+		MaxResults:      aws.Int64(int64(maxResults)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+	}
+	executions := make([]Execution, len(out.Executions))
+	for i, execution := range out.Executions {
+		executions[i] = Execution{
+			ARN:       aws.StringValue(execution.ExecutionArn),
+			Name:      aws.StringValue(execution.Name),
+			Status:    aws.StringValue(execution.Status),
+			StartDate: aws.TimeValue(execution.StartDate),
+			StopDate:  aws.TimeValue(execution.StopDate),
+		}
+	}
+	return executions, nil
+}
+
+// ExecutionHistory returns the events of the execution in chronological order.
+func (s *StepFunctions) ExecutionHistory(executionARN string) ([]HistoryEvent, error) {
+	var events []HistoryEvent
+	var nextToken *string
+	for {
+		out, err := s.client.GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+			ExecutionArn: aws.String(executionARN),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get history of execution %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			events = append(events, newHistoryEvent(event))
+		}
+		if out.NextToken == nil {
+			return events, nil
+		}
+		nextToken = out.NextToken
+	}
+}
+
+func newHistoryEvent(event *sfn.HistoryEvent) HistoryEvent {
+	e := HistoryEvent{
+		ID:   aws.Int64Value(event.Id),
+		Type: aws.StringValue(event.Type),
+	}
+	switch {
+	case event.TaskSubmittedEventDetails != nil:
+		e.Output = aws.StringValue(event.TaskSubmittedEventDetails.Output)
+	case event.TaskSucceededEventDetails != nil:
+		e.Output = aws.StringValue(event.TaskSucceededEventDetails.Output)
+	case event.TaskSubmitFailedEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.TaskSubmitFailedEventDetails.Error), aws.StringValue(event.TaskSubmitFailedEventDetails.Cause)
+	case event.TaskFailedEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.TaskFailedEventDetails.Error), aws.StringValue(event.TaskFailedEventDetails.Cause)
+	case event.TaskTimedOutEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.TaskTimedOutEventDetails.Error), aws.StringValue(event.TaskTimedOutEventDetails.Cause)
+	case event.ExecutionFailedEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.ExecutionFailedEventDetails.Error), aws.StringValue(event.ExecutionFailedEventDetails.Cause)
+	case event.ExecutionTimedOutEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.ExecutionTimedOutEventDetails.Error), aws.StringValue(event.ExecutionTimedOutEventDetails.Cause)
+	case event.ExecutionAbortedEventDetails != nil:
+		e.Error, e.Cause = aws.StringValue(event.ExecutionAbortedEventDetails.Error), aws.StringValue(event.ExecutionAbortedEventDetails.Cause)
 	}
-	return nil
+	return e
 }
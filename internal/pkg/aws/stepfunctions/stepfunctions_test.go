@@ -76,7 +76,8 @@ func TestStepFunctions_Execute(t *testing.T) {
 
 		mockStepFunctionsClient func(m *mocks.Mockapi)
 
-		wantedError error
+		wantedError        error
+		wantedExecutionARN string
 	}{
 
 		"fail to execute state machine": {
@@ -98,6 +99,7 @@ func TestStepFunctions_Execute(t *testing.T) {
 					StartDate:    func() *time.Time { t := time.Now(); return &t }(),
 				}, nil)
 			},
+			wantedExecutionARN: "forca barca",
 		},
 	}
 
@@ -112,9 +114,194 @@ func TestStepFunctions_Execute(t *testing.T) {
 				client: mockStepFunctionsClient,
 			}
 
-			err := sfn.Execute(tc.inStateMachineARN)
+			out, err := sfn.Execute(tc.inStateMachineARN)
 			if tc.wantedError != nil {
 				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.Equal(t, tc.wantedExecutionARN, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ExecutionHistory(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError  error
+		wantedEvents []HistoryEvent
+	}{
+		"fail to get execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution mockExecution: some error"),
+		},
+		"returns the events of every page": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecution"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Id:   aws.Int64(1),
+							Type: aws.String(sfn.HistoryEventTypeExecutionStarted),
+						},
+						{
+							Id:   aws.Int64(2),
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String(`{"Tasks":[]}`),
+							},
+						},
+					},
+					NextToken: aws.String("mockToken"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("mockExecution"),
+					NextToken:    aws.String("mockToken"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Id:   aws.Int64(3),
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitFailed),
+							TaskSubmitFailedEventDetails: &sfn.TaskSubmitFailedEventDetails{
+								Error: aws.String("ECS.AmazonECSException"),
+								Cause: aws.String("No Container Instances were found in your cluster."),
+							},
+						},
+						{
+							Id:   aws.Int64(4),
+							Type: aws.String(sfn.HistoryEventTypeExecutionFailed),
+							ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
+								Error: aws.String("States.TaskFailed"),
+								Cause: aws.String("Essential container in task exited"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedEvents: []HistoryEvent{
+				{
+					ID:   1,
+					Type: "ExecutionStarted",
+				},
+				{
+					ID:     2,
+					Type:   "TaskSubmitted",
+					Output: `{"Tasks":[]}`,
+				},
+				{
+					ID:    3,
+					Type:  "TaskSubmitFailed",
+					Error: "ECS.AmazonECSException",
+					Cause: "No Container Instances were found in your cluster.",
+				},
+				{
+					ID:    4,
+					Type:  "ExecutionFailed",
+					Error: "States.TaskFailed",
+					Cause: "Essential container in task exited",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ExecutionHistory("mockExecution")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedEvents, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ListExecutions(t *testing.T) {
+	startDate := time.Date(2022, 10, 1, 2, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(5 * time.Minute)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedExecutions []Execution
+	}{
+		"fail to list executions": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine mockStateMachine: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("mockStateMachine"),
+					MaxResults:      aws.Int64(10),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("mockExecution2"),
+							Name:         aws.String("2"),
+							Status:       aws.String(sfn.ExecutionStatusRunning),
+							StartDate:    aws.Time(stopDate),
+						},
+						{
+							ExecutionArn: aws.String("mockExecution1"),
+							Name:         aws.String("1"),
+							Status:       aws.String(sfn.ExecutionStatusSucceeded),
+							StartDate:    aws.Time(startDate),
+							StopDate:     aws.Time(stopDate),
+						},
+					},
+				}, nil)
+			},
+			wantedExecutions: []Execution{
+				{
+					ARN:       "mockExecution2",
+					Name:      "2",
+					Status:    "RUNNING",
+					StartDate: stopDate,
+				},
+				{
+					ARN:       "mockExecution1",
+					Name:      "1",
+					Status:    "SUCCEEDED",
+					StartDate: startDate,
+					StopDate:  stopDate,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ListExecutions("mockStateMachine", 10)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, out)
 			}
 		})
 	}
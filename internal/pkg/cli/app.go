@@ -23,6 +23,7 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppInitCommand())
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
+	cmd.AddCommand(buildAppStatusCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
 	cmd.AddCommand(buildAppUpgradeCmd())
 
@@ -0,0 +1,174 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appStatusNamePrompt     = "Which application's status would you like to show?"
+	appStatusNameHelpPrompt = "Displays the health of every service in every environment of the application."
+
+	appStatusRefreshInterval = 10 * time.Second
+)
+
+type appStatusVars struct {
+	name             string
+	shouldOutputJSON bool
+	watch            bool
+}
+
+type appStatusOpts struct {
+	appStatusVars
+
+	w               io.Writer
+	store           store
+	sel             appSelector
+	statusDescriber appStatusDescriber
+
+	initStatusDescriber func(*appStatusOpts) error
+	// renderWatch renders the output of refresh periodically until the user interrupts the command.
+	renderWatch func(refresh termprogress.RefreshFunc) error
+}
+
+func newAppStatusOpts(vars appStatusVars) (*appStatusOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app status"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &appStatusOpts{
+		appStatusVars: vars,
+		w:             log.OutputWriter,
+		store:         configStore,
+		sel:           selector.NewAppEnvSelector(prompt.New(), configStore),
+		initStatusDescriber: func(o *appStatusOpts) error {
+			o.statusDescriber = describe.NewAppStatusDescriber(&describe.NewAppStatusConfig{
+				App:         o.name,
+				ConfigStore: configStore,
+				DeployStore: deployStore,
+			})
+			return nil
+		},
+		renderWatch: func(refresh termprogress.RefreshFunc) error {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			// Stop rendering once the user interrupts the command instead of when the render context is canceled,
+			// so that the latest status is rendered one last time.
+			return termprogress.Render(context.Background(), termprogress.NewTabbedFileWriter(os.Stdout),
+				termprogress.ListeningRefreshRenderer(ctx, refresh, appStatusRefreshInterval))
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *appStatusOpts) Validate() error {
+	if o.shouldOutputJSON && o.watch {
+		return fmt.Errorf("cannot specify both --%s and --%s", jsonFlag, watchFlag)
+	}
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *appStatusOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appStatusNamePrompt, appStatusNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute displays the status of every service deployed in the application.
+func (o *appStatusOpts) Execute() error {
+	if err := o.initStatusDescriber(o); err != nil {
+		return err
+	}
+	if o.watch {
+		return o.renderWatch(o.humanStatus)
+	}
+	status, err := o.statusDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of application %s: %w", o.name, err)
+	}
+	if !o.shouldOutputJSON {
+		fmt.Fprint(o.w, status.HumanString())
+		return nil
+	}
+	data, err := status.JSONString()
+	if err != nil {
+		return fmt.Errorf("get JSON string: %w", err)
+	}
+	fmt.Fprint(o.w, data)
+	return nil
+}
+
+func (o *appStatusOpts) humanStatus() (string, error) {
+	status, err := o.statusDescriber.Describe()
+	if err != nil {
+		return "", fmt.Errorf("describe status of application %s: %w", o.name, err)
+	}
+	return status.HumanString(), nil
+}
+
+// buildAppStatusCmd builds the command for showing the status of every service in an application.
+func buildAppStatusCmd() *cobra.Command {
+	vars := appStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of every service in an application.",
+		Long: `Shows the status of every service in an application.
+Displays the running and desired number of tasks, the state of the alarms
+and the time of the last deployment of each service in each environment.`,
+		Example: `
+  Shows the status of the services in the application "my-app"
+  /code $ copilot app status -n my-app
+  Refreshes the status every 10 seconds until interrupted
+  /code $ copilot app status --watch`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, watchFlagDescription)
+	return cmd
+}
@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppStatusOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inJSON    bool
+		inWatch   bool
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError string
+	}{
+		"error if both --json and --watch are specified": {
+			inJSON:     true,
+			inWatch:    true,
+			setupMocks: func(m *mocks.Mockstore) {},
+
+			wantedError: "cannot specify both --json and --watch",
+		},
+		"error if the application does not exist": {
+			inAppName: "my-app",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: "get application my-app: some error",
+		},
+		"valid app name": {
+			inAppName: "my-app",
+			inWatch:   true,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					name:             tc.inAppName,
+					shouldOutputJSON: tc.inJSON,
+					watch:            tc.inWatch,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAppStatusOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(m *mocks.MockappSelector)
+
+		wantedAppName string
+		wantedError   string
+	}{
+		"skip prompting if the application is provided": {
+			inAppName:  "my-app",
+			setupMocks: func(m *mocks.MockappSelector) {},
+
+			wantedAppName: "my-app",
+		},
+		"prompt for the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(appStatusNamePrompt, appStatusNameHelpPrompt).Return("my-app", nil)
+			},
+
+			wantedAppName: "my-app",
+		},
+		"error if fail to select the application": {
+			setupMocks: func(m *mocks.MockappSelector) {
+				m.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedError: "select application: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(mockSel)
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					name: tc.inAppName,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.name)
+		})
+	}
+}
+
+func TestAppStatusOpts_Execute(t *testing.T) {
+	mockStatus := &describe.AppStatus{
+		App:          "my-app",
+		Services:     []string{"api"},
+		Environments: []string{"test"},
+		Statuses: []*describe.ServiceEnvStatus{
+			{
+				Service:     "api",
+				Environment: "test",
+				Type:        "Backend Service",
+				Status:      "ACTIVE",
+				Tasks: &describe.TaskCounts{
+					Running: 1,
+					Desired: 1,
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		inWatch    bool
+		setupMocks func(m *mocks.MockappStatusDescriber)
+
+		wantedContent string
+		wantedError   string
+	}{
+		"error if fail to describe the application status": {
+			setupMocks: func(m *mocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: "describe status of application my-app: some error",
+		},
+		"writes the status in human format": {
+			setupMocks: func(m *mocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: mockStatus.HumanString(),
+		},
+		"writes the status in JSON format": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: `{"application":"my-app","services":["api"],"environments":["test"],"statuses":[{"service":"api","environment":"test","type":"Backend Service","status":"ACTIVE","tasks":{"running":1,"desired":1},"alarms":0,"alarmsInAlarm":0,"lastDeploymentAt":"0001-01-01T00:00:00Z"}]}
+`,
+		},
+		"refreshes the status in watch mode": {
+			inWatch: true,
+			setupMocks: func(m *mocks.MockappStatusDescriber) {
+				m.EXPECT().Describe().Return(mockStatus, nil)
+			},
+
+			wantedContent: mockStatus.HumanString(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDescriber := mocks.NewMockappStatusDescriber(ctrl)
+			tc.setupMocks(mockDescriber)
+			b := &bytes.Buffer{}
+			opts := &appStatusOpts{
+				appStatusVars: appStatusVars{
+					name:             "my-app",
+					shouldOutputJSON: tc.inJSON,
+					watch:            tc.inWatch,
+				},
+				w: b,
+				initStatusDescriber: func(o *appStatusOpts) error {
+					o.statusDescriber = mockDescriber
+					return nil
+				},
+				renderWatch: func(refresh termprogress.RefreshFunc) error {
+					content, err := refresh()
+					if err != nil {
+						return err
+					}
+					b.WriteString(content)
+					return nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
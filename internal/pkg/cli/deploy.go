@@ -23,6 +23,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
@@ -77,6 +78,7 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					unmarshal:       manifest.UnmarshalWorkload,
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
 					cmd:             exec.NewCmd(),
+					fs:              &afero.Afero{Fs: afero.NewOsFs()},
 					sessProvider:    sessProvider,
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
@@ -95,6 +97,7 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
 					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
+					fs:              &afero.Afero{Fs: afero.NewOsFs()},
 					sessProvider:    sessProvider,
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
@@ -191,6 +194,7 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -4,9 +4,14 @@
 package deploy
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 
@@ -33,6 +38,11 @@ type appResourcesGetter interface {
 	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
 }
 
+type addonsTemplater interface {
+	Template() (string, error)
+	Parameters() (string, error)
+}
+
 type environmentDeployer interface {
 	UpdateAndRenderEnvironment(out termprogress.FileWriter, env *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error
 }
@@ -47,8 +57,10 @@ type envDeployer struct {
 	uploader   customResourcesUploader // Deprecated: after legacy is removed.
 	templateFS template.Reader
 	s3         uploader
+	addons     addonsTemplater
 	// Dependencies to deploy an environment.
 	envDeployer environmentDeployer
+	tmplGetter  deployedTemplateGetter
 
 	// Cached variables.
 	appRegionalResources *stack.AppRegionalResources
@@ -78,6 +90,10 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get env session: %w", err)
 	}
+	addons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("initiate addons for environments: %w", err)
+	}
 	return &envDeployer{
 		app: in.App,
 		env: in.Env,
@@ -86,22 +102,52 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 		templateFS: template.New(),
 		uploader:   template.New(),
 		s3:         s3.New(envRegionSession),
+		addons:     addons,
 
 		envDeployer: deploycfn.New(envManagerSession),
+		tmplGetter:  cloudformation.New(envManagerSession),
 	}, nil
 }
 
-// UploadArtifacts uploads the deployment artifacts for the environment.
-func (d *envDeployer) UploadArtifacts() (map[string]string, error) {
+// UploadArtifacts uploads the deployment artifacts for the environment such as custom resources and addons.
+func (d *envDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
 	resources, err := d.getAppRegionalResources()
 	if err != nil {
 		return nil, err
 	}
-
+	var urls map[string]string
 	if d.uploadCustomResourceFlag {
-		return d.uploadCustomResources(resources.S3Bucket)
+		urls, err = d.uploadCustomResources(resources.S3Bucket)
+	} else {
+		urls, err = d.legacyUploadCustomResources(resources.S3Bucket)
+	}
+	if err != nil {
+		return nil, err
+	}
+	addonsURL, err := d.uploadAddons(resources.S3Bucket)
+	if err != nil {
+		return nil, err
+	}
+	return &UploadArtifactsOutput{
+		CustomResourceURLs: urls,
+		AddonsURL:          addonsURL,
+	}, nil
+}
+
+func (d *envDeployer) uploadAddons(bucket string) (string, error) {
+	tpl, err := d.addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("retrieve environment addons template: %w", err)
 	}
-	return d.legacyUploadCustomResources(resources.S3Bucket)
+	url, err := d.s3.Upload(bucket, artifactpath.Addons(addon.EnvAddonsDirName, []byte(tpl)), strings.NewReader(tpl))
+	if err != nil {
+		return "", fmt.Errorf("put environment addons artifact to bucket %s: %w", bucket, err)
+	}
+	return url, nil
 }
 
 func (d *envDeployer) legacyUploadCustomResources(bucket string) (map[string]string, error) {
@@ -132,20 +178,59 @@ func (d *envDeployer) uploadCustomResources(bucket string) (map[string]string, e
 type DeployEnvironmentInput struct {
 	RootUserARN         string
 	CustomResourcesURLs map[string]string
+	AddonsURL           string // S3 object URL of the environment addons template. Empty if there are no addons.
 	Manifest            *manifest.Environment
 }
 
+// GenerateCloudFormationTemplate returns the environment stack's template and parameter configuration.
+func (d *envDeployer) GenerateCloudFormationTemplate(in *DeployEnvironmentInput) (*GenerateCloudFormationTemplateOutput, error) {
+	stackInput, err := d.buildDeployInput(in)
+	if err != nil {
+		return nil, err
+	}
+	conf := stack.NewEnvStackConfig(stackInput)
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack template: %w", err)
+	}
+	params, err := conf.SerializedParameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack template parameters: %w", err)
+	}
+	return &GenerateCloudFormationTemplateOutput{
+		Template:   tpl,
+		Parameters: params,
+	}, nil
+}
+
+// DeployDiff returns the stringified diff of the template against the deployed template of the environment.
+func (d *envDeployer) DeployDiff(template string) (string, error) {
+	return deployDiff(d.tmplGetter, stack.NameForEnv(d.app.Name, d.env.Name), template)
+}
+
 // DeployEnvironment deploys an environment using CloudFormation.
 func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
-	resources, err := d.getAppRegionalResources()
+	stackInput, err := d.buildDeployInput(in)
 	if err != nil {
 		return err
 	}
+	return d.envDeployer.UpdateAndRenderEnvironment(os.Stderr, stackInput, cloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+}
+
+func (d *envDeployer) buildDeployInput(in *DeployEnvironmentInput) (*deploy.CreateEnvironmentInput, error) {
+	resources, err := d.getAppRegionalResources()
+	if err != nil {
+		return nil, err
+	}
 	partition, err := partitions.Region(d.env.Region).Partition()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	addons, err := d.buildAddonsInput(in.AddonsURL)
+	if err != nil {
+		return nil, err
 	}
-	deployEnvInput := &deploy.CreateEnvironmentInput{
+	return &deploy.CreateEnvironmentInput{
 		Name: d.env.Name,
 		App: deploy.AppInformation{
 			Name:                d.app.Name,
@@ -157,9 +242,28 @@ func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
 		ArtifactBucketARN:    s3.FormatARN(partition.ID(), resources.S3Bucket),
 		ArtifactBucketKeyARN: resources.KMSKeyARN,
 		Mft:                  in.Manifest,
+		Addons:               addons,
 		Version:              deploy.LatestEnvTemplateVersion,
+	}, nil
+}
+
+func (d *envDeployer) buildAddonsInput(url string) (*deploy.Addons, error) {
+	if url == "" {
+		return nil, nil
+	}
+	tpl, err := d.addons.Template()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment addons template: %w", err)
 	}
-	return d.envDeployer.UpdateAndRenderEnvironment(os.Stderr, deployEnvInput, cloudformation.WithRoleARN(d.env.ExecutionRoleARN))
+	params, err := d.addons.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("parse environment addons parameters: %w", err)
+	}
+	return &deploy.Addons{
+		S3ObjectURL: url,
+		Template:    tpl,
+		ExtraParams: params,
+	}, nil
 }
 
 func (d *envDeployer) getAppRegionalResources() (*stack.AppRegionalResources, error) {
@@ -10,9 +10,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -26,6 +28,7 @@ type uploadArtifactsMock struct {
 	uploader *mocks.MockcustomResourcesUploader
 	appCFN   *mocks.MockappResourcesGetter
 	s3       *mocks.Mockuploader
+	addons   *mocks.MockaddonsTemplater
 }
 
 func TestEnvDeployer_LegacyUploadArtifacts(t *testing.T) {
@@ -36,7 +39,7 @@ func TestEnvDeployer_LegacyUploadArtifacts(t *testing.T) {
 	mockApp := &config.Application{}
 	testCases := map[string]struct {
 		setUpMocks  func(m *uploadArtifactsMock)
-		wantedOut   map[string]string
+		wantedOut   *UploadArtifactsOutput
 		wantedError error
 	}{
 		"fail to get app resource by region": {
@@ -68,9 +71,58 @@ func TestEnvDeployer_LegacyUploadArtifacts(t *testing.T) {
 				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{
 					"mockResource": "mockURL",
 				}, nil)
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
 			},
-			wantedOut: map[string]string{
-				"mockResource": "mockURL",
+			wantedOut: &UploadArtifactsOutput{
+				CustomResourceURLs: map[string]string{
+					"mockResource": "mockURL",
+				},
+			},
+		},
+		"fail to read addons template": {
+			setUpMocks: func(m *uploadArtifactsMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{
+					"mockResource": "mockURL",
+				}, nil)
+				m.addons.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve environment addons template: some error"),
+		},
+		"fail to upload addons template": {
+			setUpMocks: func(m *uploadArtifactsMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{
+					"mockResource": "mockURL",
+				}, nil)
+				m.addons.EXPECT().Template().Return("Resources: {}", nil)
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("put environment addons artifact to bucket mockS3Bucket: some error"),
+		},
+		"success with addons URL returned": {
+			setUpMocks: func(m *uploadArtifactsMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{
+					"mockResource": "mockURL",
+				}, nil)
+				m.addons.EXPECT().Template().Return("Resources: {}", nil)
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (string, error) {
+					require.True(t, strings.HasPrefix(key, "manual/addons/environments/"))
+					return "mockAddonsURL", nil
+				})
+			},
+			wantedOut: &UploadArtifactsOutput{
+				CustomResourceURLs: map[string]string{
+					"mockResource": "mockURL",
+				},
+				AddonsURL: "mockAddonsURL",
 			},
 		},
 	}
@@ -84,6 +136,7 @@ func TestEnvDeployer_LegacyUploadArtifacts(t *testing.T) {
 				uploader: mocks.NewMockcustomResourcesUploader(ctrl),
 				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
 				s3:       mocks.NewMockuploader(ctrl),
+				addons:   mocks.NewMockaddonsTemplater(ctrl),
 			}
 			tc.setUpMocks(m)
 
@@ -96,6 +149,7 @@ func TestEnvDeployer_LegacyUploadArtifacts(t *testing.T) {
 				uploader: m.uploader,
 				appCFN:   m.appCFN,
 				s3:       m.s3,
+				addons:   m.addons,
 			}
 
 			got, gotErr := d.UploadArtifacts()
@@ -117,7 +171,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 	mockApp := &config.Application{}
 	testCases := map[string]struct {
 		setUpMocks  func(m *uploadArtifactsMock)
-		wantedOut   map[string]string
+		wantedOut   *UploadArtifactsOutput
 		wantedError error
 	}{
 		"fail to get app resource by region": {
@@ -149,7 +203,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 				crs, err := customresource.Env(fakeTemplateFS())
 				require.NoError(t, err)
 
-				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.s3.EXPECT().Upload("mockS3Bucket", gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -157,11 +211,14 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 					}
 					return "", errors.New("did not match any custom resource")
 				}).Times(len(crs))
+				m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
 			},
-			wantedOut: map[string]string{
-				"CertificateValidationFunction": "",
-				"CustomDomainFunction":          "",
-				"DNSDelegationFunction":         "",
+			wantedOut: &UploadArtifactsOutput{
+				CustomResourceURLs: map[string]string{
+					"CertificateValidationFunction": "",
+					"CustomDomainFunction":          "",
+					"DNSDelegationFunction":         "",
+				},
 			},
 		},
 	}
@@ -175,6 +232,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 				uploader: mocks.NewMockcustomResourcesUploader(ctrl),
 				appCFN:   mocks.NewMockappResourcesGetter(ctrl),
 				s3:       mocks.NewMockuploader(ctrl),
+				addons:   mocks.NewMockaddonsTemplater(ctrl),
 			}
 			tc.setUpMocks(m)
 
@@ -187,6 +245,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 				uploader:   m.uploader,
 				appCFN:     m.appCFN,
 				s3:         m.s3,
+				addons:     m.addons,
 				templateFS: fakeTemplateFS(),
 
 				uploadCustomResourceFlag: true,
@@ -206,6 +265,7 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 type deployEnvironmentMock struct {
 	appCFN      *mocks.MockappResourcesGetter
 	envDeployer *mocks.MockenvironmentDeployer
+	addons      *mocks.MockaddonsTemplater
 }
 
 func TestEnvDeployer_DeployEnvironment(t *testing.T) {
@@ -219,6 +279,7 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 		Name: mockAppName,
 	}
 	testCases := map[string]struct {
+		inAddonsURL string
 		setUpMocks  func(m *deployEnvironmentMock)
 		wantedError error
 	}{
@@ -238,6 +299,36 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 			},
 			wantedError: errors.New("some error"),
 		},
+		"fail to parse addons parameters": {
+			inAddonsURL: "mockAddonsURL",
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.addons.EXPECT().Template().Return("Resources: {}", nil)
+				m.addons.EXPECT().Parameters().Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("parse environment addons parameters: some error"),
+		},
+		"successful environment deployment with addons": {
+			inAddonsURL: "mockAddonsURL",
+			setUpMocks: func(m *deployEnvironmentMock) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.addons.EXPECT().Template().Return("Resources: {}", nil)
+				m.addons.EXPECT().Parameters().Return("VpcId: !Ref VPC", nil)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ progress.FileWriter, in *deploy.CreateEnvironmentInput, opts ...cloudformation.StackOption) error {
+						require.Equal(t, &deploy.Addons{
+							S3ObjectURL: "mockAddonsURL",
+							Template:    "Resources: {}",
+							ExtraParams: "VpcId: !Ref VPC",
+						}, in.Addons)
+						return nil
+					})
+			},
+		},
 		"successful environment deployment": {
 			setUpMocks: func(m *deployEnvironmentMock) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&stack.AppRegionalResources{
@@ -264,6 +355,7 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 			m := &deployEnvironmentMock{
 				appCFN:      mocks.NewMockappResourcesGetter(ctrl),
 				envDeployer: mocks.NewMockenvironmentDeployer(ctrl),
+				addons:      mocks.NewMockaddonsTemplater(ctrl),
 			}
 			tc.setUpMocks(m)
 			d := envDeployer{
@@ -275,12 +367,14 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				},
 				appCFN:      m.appCFN,
 				envDeployer: m.envDeployer,
+				addons:      m.addons,
 			}
 			mockIn := &DeployEnvironmentInput{
 				RootUserARN: "mockRootUserARN",
 				CustomResourcesURLs: map[string]string{
 					"mockResource": "mockURL",
 				},
+				AddonsURL: tc.inAddonsURL,
 			}
 			gotErr := d.DeployEnvironment(mockIn)
 			if tc.wantedError != nil {
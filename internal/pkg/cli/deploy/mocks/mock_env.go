@@ -92,6 +92,59 @@ func (mr *MockappResourcesGetterMockRecorder) GetAppResourcesByRegion(app, regio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockappResourcesGetter)(nil).GetAppResourcesByRegion), app, region)
 }
 
+// MockaddonsTemplater is a mock of addonsTemplater interface.
+type MockaddonsTemplater struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddonsTemplaterMockRecorder
+}
+
+// MockaddonsTemplaterMockRecorder is the mock recorder for MockaddonsTemplater.
+type MockaddonsTemplaterMockRecorder struct {
+	mock *MockaddonsTemplater
+}
+
+// NewMockaddonsTemplater creates a new mock instance.
+func NewMockaddonsTemplater(ctrl *gomock.Controller) *MockaddonsTemplater {
+	mock := &MockaddonsTemplater{ctrl: ctrl}
+	mock.recorder = &MockaddonsTemplaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddonsTemplater) EXPECT() *MockaddonsTemplaterMockRecorder {
+	return m.recorder
+}
+
+// Parameters mocks base method.
+func (m *MockaddonsTemplater) Parameters() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parameters")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parameters indicates an expected call of Parameters.
+func (mr *MockaddonsTemplaterMockRecorder) Parameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parameters", reflect.TypeOf((*MockaddonsTemplater)(nil).Parameters))
+}
+
+// Template mocks base method.
+func (m *MockaddonsTemplater) Template() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockaddonsTemplaterMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockaddonsTemplater)(nil).Template))
+}
+
 // MockenvironmentDeployer is a mock of environmentDeployer interface.
 type MockenvironmentDeployer struct {
 	ctrl     *gomock.Controller
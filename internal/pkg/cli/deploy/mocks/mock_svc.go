@@ -14,6 +14,7 @@ import (
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	exec "github.com/aws/copilot-cli/internal/pkg/exec"
 	repository "github.com/aws/copilot-cli/internal/pkg/repository"
 	progress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	gomock "github.com/golang/mock/gomock"
@@ -118,18 +119,23 @@ func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
 }
 
 // Upload mocks base method.
-func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
+func (m *Mockuploader) Upload(bucket, key string, data io.Reader, opts ...s3.UploadOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	varargs := []interface{}{bucket, key, data}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Upload", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upload indicates an expected call of Upload.
-func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
+	varargs := append([]interface{}{bucket, key, data}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), varargs...)
 }
 
 // ZipAndUpload mocks base method.
@@ -649,6 +655,214 @@ func (mr *MockconfigDescriberMockRecorder) Manifest() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockconfigDescriber)(nil).Manifest))
 }
 
+// MockdeployedTemplateGetter is a mock of deployedTemplateGetter interface.
+type MockdeployedTemplateGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedTemplateGetterMockRecorder
+}
+
+// MockdeployedTemplateGetterMockRecorder is the mock recorder for MockdeployedTemplateGetter.
+type MockdeployedTemplateGetterMockRecorder struct {
+	mock *MockdeployedTemplateGetter
+}
+
+// NewMockdeployedTemplateGetter creates a new mock instance.
+func NewMockdeployedTemplateGetter(ctrl *gomock.Controller) *MockdeployedTemplateGetter {
+	mock := &MockdeployedTemplateGetter{ctrl: ctrl}
+	mock.recorder = &MockdeployedTemplateGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedTemplateGetter) EXPECT() *MockdeployedTemplateGetterMockRecorder {
+	return m.recorder
+}
+
+// TemplateBody mocks base method.
+func (m *MockdeployedTemplateGetter) TemplateBody(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockdeployedTemplateGetterMockRecorder) TemplateBody(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockdeployedTemplateGetter)(nil).TemplateBody), stackName)
+}
+
+// MockcmdRunner is a mock of cmdRunner interface.
+type MockcmdRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockcmdRunnerMockRecorder
+}
+
+// MockcmdRunnerMockRecorder is the mock recorder for MockcmdRunner.
+type MockcmdRunnerMockRecorder struct {
+	mock *MockcmdRunner
+}
+
+// NewMockcmdRunner creates a new mock instance.
+func NewMockcmdRunner(ctrl *gomock.Controller) *MockcmdRunner {
+	mock := &MockcmdRunner{ctrl: ctrl}
+	mock.recorder = &MockcmdRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcmdRunner) EXPECT() *MockcmdRunnerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockcmdRunner) Run(name string, args []string, options ...exec.CmdOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{name, args}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Run", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockcmdRunnerMockRecorder) Run(name, args interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{name, args}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockcmdRunner)(nil).Run), varargs...)
+}
+
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
+}
+
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
+}
+
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), name)
+}
+
+// MockbucketObjectsManager is a mock of bucketObjectsManager interface.
+type MockbucketObjectsManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockbucketObjectsManagerMockRecorder
+}
+
+// MockbucketObjectsManagerMockRecorder is the mock recorder for MockbucketObjectsManager.
+type MockbucketObjectsManagerMockRecorder struct {
+	mock *MockbucketObjectsManager
+}
+
+// NewMockbucketObjectsManager creates a new mock instance.
+func NewMockbucketObjectsManager(ctrl *gomock.Controller) *MockbucketObjectsManager {
+	mock := &MockbucketObjectsManager{ctrl: ctrl}
+	mock.recorder = &MockbucketObjectsManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbucketObjectsManager) EXPECT() *MockbucketObjectsManagerMockRecorder {
+	return m.recorder
+}
+
+// DeleteObjects mocks base method.
+func (m *MockbucketObjectsManager) DeleteObjects(bucket string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjects", bucket, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjects indicates an expected call of DeleteObjects.
+func (mr *MockbucketObjectsManagerMockRecorder) DeleteObjects(bucket, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjects", reflect.TypeOf((*MockbucketObjectsManager)(nil).DeleteObjects), bucket, keys)
+}
+
+// ListObjects mocks base method.
+func (m *MockbucketObjectsManager) ListObjects(bucket, prefix string) ([]s3.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", bucket, prefix)
+	ret0, _ := ret[0].([]s3.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockbucketObjectsManagerMockRecorder) ListObjects(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockbucketObjectsManager)(nil).ListObjects), bucket, prefix)
+}
+
+// MockcacheInvalidator is a mock of cacheInvalidator interface.
+type MockcacheInvalidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcacheInvalidatorMockRecorder
+}
+
+// MockcacheInvalidatorMockRecorder is the mock recorder for MockcacheInvalidator.
+type MockcacheInvalidatorMockRecorder struct {
+	mock *MockcacheInvalidator
+}
+
+// NewMockcacheInvalidator creates a new mock instance.
+func NewMockcacheInvalidator(ctrl *gomock.Controller) *MockcacheInvalidator {
+	mock := &MockcacheInvalidator{ctrl: ctrl}
+	mock.recorder = &MockcacheInvalidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcacheInvalidator) EXPECT() *MockcacheInvalidatorMockRecorder {
+	return m.recorder
+}
+
+// CreateInvalidation mocks base method.
+func (m *MockcacheInvalidator) CreateInvalidation(distributionID string, paths []string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvalidation", distributionID, paths)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvalidation indicates an expected call of CreateInvalidation.
+func (mr *MockcacheInvalidatorMockRecorder) CreateInvalidation(distributionID, paths interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvalidation", reflect.TypeOf((*MockcacheInvalidator)(nil).CreateInvalidation), distributionID, paths)
+}
+
 // MocktimeoutError is a mock of timeoutError interface.
 type MocktimeoutError struct {
 	ctrl     *gomock.Controller
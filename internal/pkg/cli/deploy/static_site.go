@@ -0,0 +1,268 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+const (
+	fmtUploadSiteFilesStart    = "Uploading %d files of %s to bucket %s"
+	fmtUploadSiteFilesFailed   = "Failed to upload the files of %s to bucket %s.\n"
+	fmtUploadSiteFilesComplete = "Uploaded %d files of %s to bucket %s.\n"
+	fmtPruneSiteFilesStart     = "Deleting %d files removed from %s from bucket %s"
+	fmtPruneSiteFilesFailed    = "Failed to delete the files removed from %s from bucket %s.\n"
+	fmtPruneSiteFilesComplete  = "Deleted %d files removed from %s from bucket %s.\n"
+)
+
+// invalidateAllPaths matches every file served by a distribution.
+const invalidateAllPaths = "/*"
+
+type staticSiteDeployer struct {
+	*svcDeployer
+	staticSiteMft  *manifest.StaticSite
+	cmdRunner      cmdRunner
+	siteFS         afero.Fs
+	stackDescriber stackDescriber
+	siteBucket     bucketObjectsManager
+	cdn            cacheInvalidator
+}
+
+// IsServiceAvailableInRegion checks if service type exist in the given region.
+func (staticSiteDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
+	return partitions.IsAvailableInRegion(s3.EndpointsID, region)
+}
+
+// NewStaticSiteDeployer is the constructor for staticSiteDeployer.
+func NewStaticSiteDeployer(in *WorkloadDeployerInput) (*staticSiteDeployer, error) {
+	svcDeployer, err := newSvcDeployer(in)
+	if err != nil {
+		return nil, err
+	}
+	siteMft, ok := in.Mft.(*manifest.StaticSite)
+	if !ok {
+		return nil, fmt.Errorf("manifest is not of type %s", manifest.StaticSiteType)
+	}
+	return &staticSiteDeployer{
+		svcDeployer:    svcDeployer,
+		staticSiteMft:  siteMft,
+		cmdRunner:      exec.NewCmd(),
+		siteFS:         afero.NewOsFs(),
+		stackDescriber: awscloudformation.New(svcDeployer.envSess),
+		siteBucket:     s3.New(svcDeployer.envSess),
+		cdn:            cloudfront.New(svcDeployer.envSess),
+	}, nil
+}
+
+// UploadArtifacts runs the build command of the static site, if any.
+// The files of the site are uploaded once the stack that creates the site's bucket is deployed.
+func (d *staticSiteDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
+	build := aws.StringValue(d.staticSiteMft.Source.Build)
+	if build == "" {
+		return &UploadArtifactsOutput{}, nil
+	}
+	log.Infof("Building the files of %s with %s.\n", color.HighlightUserInput(d.name), color.HighlightCode(build))
+	name, args := "/bin/sh", []string{"-c", build}
+	if runtime.GOOS == "windows" {
+		name, args = "cmd", []string{"/C", build}
+	}
+	if err := d.cmdRunner.Run(name, args, exec.Dir(d.workspacePath)); err != nil {
+		return nil, fmt.Errorf("run build command %q: %w", build, err)
+	}
+	return &UploadArtifactsOutput{}, nil
+}
+
+// GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
+func (d *staticSiteDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (
+	*GenerateCloudFormationTemplateOutput, error) {
+	return d.generateCloudFormationTemplate(d.stackConfiguration(&in.StackRuntimeConfiguration))
+}
+
+type staticSiteDeployOutput struct {
+	url string
+}
+
+// RecommendedActions returns the recommended actions after deployment.
+func (d *staticSiteDeployOutput) RecommendedActions() []string {
+	return []string{fmt.Sprintf("You can access your site at %s over the internet.", color.HighlightResource(d.url))}
+}
+
+// DeployWorkload deploys the infrastructure of a static site using CloudFormation,
+// then uploads the files of the site to its bucket and invalidates the files cached by the distribution.
+func (d *staticSiteDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender, error) {
+	opts := []awscloudformation.StackOption{
+		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
+	}
+	if in.DisableRollback {
+		opts = append(opts, awscloudformation.WithDisableRollback())
+	}
+	conf := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err := d.deployer.DeployService(os.Stderr, conf, d.resources.S3Bucket, opts...); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyCS) {
+			return nil, fmt.Errorf("deploy service: %w", err)
+		}
+		// The infrastructure is up-to-date but the files of the site might have changed.
+	}
+	descr, err := d.stackDescriber.Describe(conf.StackName())
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", conf.StackName(), err)
+	}
+	outputs := make(map[string]string)
+	for _, out := range descr.Outputs {
+		outputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+	}
+	if err := d.uploadSiteFiles(outputs[stack.StaticSiteOutputBucketName]); err != nil {
+		return nil, err
+	}
+	distributionID := outputs[stack.StaticSiteOutputDistributionID]
+	invalidationID, err := d.cdn.CreateInvalidation(distributionID, []string{invalidateAllPaths})
+	if err != nil {
+		return nil, fmt.Errorf("invalidate the cached files of %s: %w", d.name, err)
+	}
+	log.Infof("Invalidating the files of %s cached by distribution %s with invalidation %s.\n",
+		color.HighlightUserInput(d.name), distributionID, invalidationID)
+	domain := outputs[stack.StaticSiteOutputDistributionDomainName]
+	if alias := aws.StringValue(d.staticSiteMft.HTTP.Alias); alias != "" {
+		domain = alias
+	}
+	return &staticSiteDeployOutput{
+		url: "https://" + domain,
+	}, nil
+}
+
+func (d *staticSiteDeployer) stackConfiguration(in *StackRuntimeConfiguration) *stack.StaticSite {
+	return stack.NewStaticSite(stack.StaticSiteConfig{
+		App:         d.app.Name,
+		Env:         d.env.Name,
+		Manifest:    d.staticSiteMft,
+		RawManifest: d.rawMft,
+		RuntimeConfig: stack.RuntimeConfig{
+			AdditionalTags: in.Tags,
+			AccountID:      d.env.AccountID,
+			Region:         d.env.Region,
+		},
+	})
+}
+
+// uploadSiteFiles uploads every file under the source directory of the site to the bucket,
+// then deletes the files in the bucket that are no longer part of the site.
+func (d *staticSiteDeployer) uploadSiteFiles(bucket string) error {
+	root := filepath.Join(d.workspacePath, aws.StringValue(d.staticSiteMft.Source.Path))
+	var files []string
+	if err := afero.Walk(d.siteFS, root, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, file)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("walk the files of %s: %w", d.name, err)
+	}
+	uploaded := make(map[string]bool)
+	d.spinner.Start(fmt.Sprintf(fmtUploadSiteFilesStart, len(files), color.HighlightUserInput(d.name), bucket))
+	for _, file := range files {
+		key, err := d.uploadSiteFile(bucket, root, file)
+		if err != nil {
+			d.spinner.Stop(log.Serrorf(fmtUploadSiteFilesFailed, color.HighlightUserInput(d.name), bucket))
+			return err
+		}
+		uploaded[key] = true
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtUploadSiteFilesComplete, len(files), color.HighlightUserInput(d.name), bucket))
+	return d.pruneSiteFiles(bucket, uploaded)
+}
+
+// pruneSiteFiles deletes the objects of the bucket that weren't uploaded by the latest deployment.
+func (d *staticSiteDeployer) pruneSiteFiles(bucket string, uploaded map[string]bool) error {
+	objects, err := d.siteBucket.ListObjects(bucket, "")
+	if err != nil {
+		return fmt.Errorf("list the files of %s: %w", d.name, err)
+	}
+	var stale []string
+	for _, obj := range objects {
+		if !uploaded[obj.Key] {
+			stale = append(stale, obj.Key)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	d.spinner.Start(fmt.Sprintf(fmtPruneSiteFilesStart, len(stale), color.HighlightUserInput(d.name), bucket))
+	if err := d.siteBucket.DeleteObjects(bucket, stale); err != nil {
+		d.spinner.Stop(log.Serrorf(fmtPruneSiteFilesFailed, color.HighlightUserInput(d.name), bucket))
+		return fmt.Errorf("delete the files removed from %s: %w", d.name, err)
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtPruneSiteFilesComplete, len(stale), color.HighlightUserInput(d.name), bucket))
+	return nil
+}
+
+// uploadSiteFile uploads the file to the bucket and returns its key.
+func (d *staticSiteDeployer) uploadSiteFile(bucket, root, file string) (string, error) {
+	rel, err := filepath.Rel(root, file)
+	if err != nil {
+		return "", fmt.Errorf("get path of %s relative to %s: %w", file, root, err)
+	}
+	key := filepath.ToSlash(rel)
+	content, err := afero.ReadFile(d.siteFS, file)
+	if err != nil {
+		return "", fmt.Errorf("read file %s: %w", file, err)
+	}
+	opts := []s3.UploadOption{s3.WithContentType(contentType(key, content))}
+	if cacheControl := cacheControlFor(d.staticSiteMft.CacheControl, key); cacheControl != "" {
+		opts = append(opts, s3.WithCacheControl(cacheControl))
+	}
+	if _, err := d.s3Client.Upload(bucket, key, bytes.NewReader(content), opts...); err != nil {
+		return "", fmt.Errorf("upload file %s: %w", key, err)
+	}
+	return key, nil
+}
+
+// contentType returns the MIME type of the file based on its extension,
+// or based on its content if the extension is unknown.
+func contentType(key string, content []byte) string {
+	if t := mime.TypeByExtension(path.Ext(key)); t != "" {
+		return t
+	}
+	return http.DetectContentType(content)
+}
+
+// cacheControlFor returns the value of the first rule whose glob matches the key.
+// Globs without a "/" are matched against the file name, others against the full key.
+func cacheControlFor(rules []manifest.CacheControlRule, key string) string {
+	for _, rule := range rules {
+		glob := aws.StringValue(rule.Glob)
+		name := key
+		if !strings.Contains(glob, "/") {
+			name = path.Base(key)
+		}
+		if matched, _ := path.Match(glob, name); matched {
+			return aws.StringValue(rule.Value)
+		}
+	}
+	return ""
+}
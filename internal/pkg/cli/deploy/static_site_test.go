@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type staticSiteDeployerMocks struct {
+	cmdRunner       *mocks.MockcmdRunner
+	serviceDeployer *mocks.MockserviceDeployer
+	stackDescriber  *mocks.MockstackDescriber
+	uploader        *mocks.Mockuploader
+	siteBucket      *mocks.MockbucketObjectsManager
+	cdn             *mocks.MockcacheInvalidator
+	spinner         *mocks.Mockspinner
+}
+
+func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
+	testCases := map[string]struct {
+		inBuild   *string
+		mock      func(m *staticSiteDeployerMocks)
+		wantedErr error
+	}{
+		"does not run anything without a build command": {
+			mock: func(m *staticSiteDeployerMocks) {},
+		},
+		"runs the build command from the workspace root": {
+			inBuild: aws.String("npm run build"),
+			mock: func(m *staticSiteDeployerMocks) {
+				m.cmdRunner.EXPECT().Run("/bin/sh", []string{"-c", "npm run build"}, gomock.Any()).Return(nil)
+			},
+		},
+		"wraps the error if the build command fails": {
+			inBuild: aws.String("npm run build"),
+			mock: func(m *staticSiteDeployerMocks) {
+				m.cmdRunner.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf(`run build command "npm run build": some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &staticSiteDeployerMocks{
+				cmdRunner: mocks.NewMockcmdRunner(ctrl),
+			}
+			tc.mock(m)
+			mft := manifest.NewStaticSite(manifest.StaticSiteProps{
+				Name: "frontend",
+				Path: "dist",
+			})
+			mft.Source.Build = tc.inBuild
+			deployer := &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name:          "frontend",
+						workspacePath: "/copilot",
+					},
+				},
+				staticSiteMft: mft,
+				cmdRunner:     m.cmdRunner,
+			}
+
+			// WHEN
+			_, err := deployer.UploadArtifacts()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaticSiteDeployer_DeployWorkload(t *testing.T) {
+	mockOutputs := &awscloudformation.StackDescription{
+		Outputs: []*sdkcloudformation.Output{
+			{
+				OutputKey:   aws.String(stack.StaticSiteOutputBucketName),
+				OutputValue: aws.String("mockSiteBucket"),
+			},
+			{
+				OutputKey:   aws.String(stack.StaticSiteOutputDistributionID),
+				OutputValue: aws.String("E2EXAMPLE"),
+			},
+			{
+				OutputKey:   aws.String(stack.StaticSiteOutputDistributionDomainName),
+				OutputValue: aws.String("d111111abcdef8.cloudfront.net"),
+			},
+		},
+	}
+	mockUploadSiteFiles := func(m *staticSiteDeployerMocks) {
+		m.serviceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		m.stackDescriber.EXPECT().Describe(gomock.Any()).Return(mockOutputs, nil)
+		m.spinner.EXPECT().Start(gomock.Any()).AnyTimes()
+		m.uploader.EXPECT().Upload("mockSiteBucket", gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil).Times(2)
+		m.spinner.EXPECT().Stop(gomock.Any()).AnyTimes()
+	}
+	testCases := map[string]struct {
+		inAlias *string
+		mock    func(m *staticSiteDeployerMocks)
+
+		wantedURL string
+		wantedErr error
+	}{
+		"fails to deploy the stack": {
+			mock: func(m *staticSiteDeployerMocks) {
+				m.serviceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), "mockArtifactBucket", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("deploy service: some error"),
+		},
+		"fails to describe the stack": {
+			mock: func(m *staticSiteDeployerMocks) {
+				m.serviceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.stackDescriber.EXPECT().Describe("phonetool-test-frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("describe stack phonetool-test-frontend: some error"),
+		},
+		"fails to upload a file": {
+			mock: func(m *staticSiteDeployerMocks) {
+				m.serviceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.stackDescriber.EXPECT().Describe(gomock.Any()).Return(mockOutputs, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.uploader.EXPECT().Upload("mockSiteBucket", gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: fmt.Errorf("upload file assets/app.css: some error"),
+		},
+		"uploads the files with their content type and cache control even if the stack is up-to-date": {
+			inAlias: aws.String("www.example.com"),
+			mock: func(m *staticSiteDeployerMocks) {
+				m.serviceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&awscloudformation.ErrChangeSetEmpty{})
+				m.stackDescriber.EXPECT().Describe(gomock.Any()).Return(mockOutputs, nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				wanted := map[string]s3manager.UploadInput{
+					"assets/app.css": {
+						ContentType:  aws.String("text/css; charset=utf-8"),
+						CacheControl: aws.String("max-age=31536000"),
+					},
+					"index.html": {
+						ContentType:  aws.String("text/html; charset=utf-8"),
+						CacheControl: aws.String("no-cache"),
+					},
+				}
+				m.uploader.EXPECT().Upload("mockSiteBucket", gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, key string, _ io.Reader, opts ...s3.UploadOption) (string, error) {
+						var in s3manager.UploadInput
+						for _, opt := range opts {
+							opt(&in)
+						}
+						require.Equal(t, wanted[key], in)
+						return "", nil
+					}).Times(2)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.siteBucket.EXPECT().ListObjects("mockSiteBucket", "").Return([]s3.Object{
+					{Key: "assets/app.css"},
+					{Key: "index.html"},
+				}, nil)
+				m.cdn.EXPECT().CreateInvalidation("E2EXAMPLE", []string{"/*"}).Return("I2EXAMPLE", nil)
+			},
+			wantedURL: "https://www.example.com",
+		},
+		"fails to list the files in the bucket": {
+			mock: func(m *staticSiteDeployerMocks) {
+				mockUploadSiteFiles(m)
+				m.siteBucket.EXPECT().ListObjects(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list the files of frontend: some error"),
+		},
+		"fails to delete the files removed from the site": {
+			mock: func(m *staticSiteDeployerMocks) {
+				mockUploadSiteFiles(m)
+				m.siteBucket.EXPECT().ListObjects(gomock.Any(), gomock.Any()).Return([]s3.Object{{Key: "old.html"}}, nil)
+				m.siteBucket.EXPECT().DeleteObjects(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("delete the files removed from frontend: some error"),
+		},
+		"fails to invalidate the cached files": {
+			mock: func(m *staticSiteDeployerMocks) {
+				mockUploadSiteFiles(m)
+				m.siteBucket.EXPECT().ListObjects(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.cdn.EXPECT().CreateInvalidation(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("invalidate the cached files of frontend: some error"),
+		},
+		"deletes the files removed from the site and invalidates the cached files": {
+			mock: func(m *staticSiteDeployerMocks) {
+				mockUploadSiteFiles(m)
+				m.siteBucket.EXPECT().ListObjects("mockSiteBucket", "").Return([]s3.Object{
+					{Key: "assets/app.css"},
+					{Key: "assets/old.css"},
+					{Key: "index.html"},
+					{Key: "old.html"},
+				}, nil)
+				m.siteBucket.EXPECT().DeleteObjects("mockSiteBucket", []string{"assets/old.css", "old.html"}).Return(nil)
+				m.cdn.EXPECT().CreateInvalidation("E2EXAMPLE", []string{"/*"}).Return("I2EXAMPLE", nil)
+			},
+			wantedURL: "https://d111111abcdef8.cloudfront.net",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &staticSiteDeployerMocks{
+				serviceDeployer: mocks.NewMockserviceDeployer(ctrl),
+				stackDescriber:  mocks.NewMockstackDescriber(ctrl),
+				uploader:        mocks.NewMockuploader(ctrl),
+				siteBucket:      mocks.NewMockbucketObjectsManager(ctrl),
+				cdn:             mocks.NewMockcacheInvalidator(ctrl),
+				spinner:         mocks.NewMockspinner(ctrl),
+			}
+			tc.mock(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/copilot/dist/assets/app.css", []byte("body {}"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "/copilot/dist/index.html", []byte("<html></html>"), 0644))
+			mft := manifest.NewStaticSite(manifest.StaticSiteProps{
+				Name: "frontend",
+				Path: "dist",
+			})
+			mft.HTTP.Alias = tc.inAlias
+			mft.CacheControl = []manifest.CacheControlRule{
+				{
+					Glob:  aws.String("assets/*"),
+					Value: aws.String("max-age=31536000"),
+				},
+				{
+					Glob:  aws.String("*"),
+					Value: aws.String("no-cache"),
+				},
+			}
+			deployer := &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name: "frontend",
+						app: &config.Application{
+							Name: "phonetool",
+						},
+						env: &config.Environment{
+							Name: "test",
+						},
+						resources: &stack.AppRegionalResources{
+							S3Bucket: "mockArtifactBucket",
+						},
+						workspacePath: "/copilot",
+						s3Client:      m.uploader,
+						deployer:      m.serviceDeployer,
+						spinner:       m.spinner,
+					},
+				},
+				staticSiteMft:  mft,
+				siteFS:         fs,
+				stackDescriber: m.stackDescriber,
+				siteBucket:     m.siteBucket,
+				cdn:            m.cdn,
+			}
+
+			// WHEN
+			out, err := deployer.DeployWorkload(&DeployWorkloadInput{})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &staticSiteDeployOutput{url: tc.wantedURL}, out)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
 
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -96,7 +97,7 @@ type imageBuilderPusher interface {
 }
 
 type uploader interface {
-	Upload(bucket, key string, data io.Reader) (string, error)
+	Upload(bucket, key string, data io.Reader, opts ...s3.UploadOption) (string, error)
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
 }
 
@@ -151,6 +152,27 @@ type configDescriber interface {
 	Manifest() ([]byte, error)
 }
 
+type deployedTemplateGetter interface {
+	TemplateBody(stackName string) (string, error)
+}
+
+type cmdRunner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+type stackDescriber interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+}
+
+type bucketObjectsManager interface {
+	ListObjects(bucket, prefix string) ([]s3.Object, error)
+	DeleteObjects(bucket string, keys []string) error
+}
+
+type cacheInvalidator interface {
+	CreateInvalidation(distributionID string, paths []string) (string, error)
+}
+
 type workloadDeployer struct {
 	name          string
 	app           *config.Application
@@ -171,6 +193,7 @@ type workloadDeployer struct {
 	spinner            spinner
 	templateFS         template.Reader
 	envConfigDescriber configDescriber
+	tmplGetter         deployedTemplateGetter
 
 	// Cached variables.
 	defaultSess              *session.Session
@@ -268,6 +291,7 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		spinner:            termprogress.NewSpinner(log.DiagnosticWriter),
 		templateFS:         template.New(),
 		envConfigDescriber: envDescriber,
+		tmplGetter:         awscloudformation.New(envSession),
 
 		defaultSess:              defaultSession,
 		defaultSessWithEnvRegion: defaultSessEnvRegion,
@@ -742,6 +766,31 @@ func (d *jobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender
 	return nil, nil
 }
 
+// DeployDiff returns the stringified diff of the template against the deployed template of the workload.
+func (d *workloadDeployer) DeployDiff(template string) (string, error) {
+	return deployDiff(d.tmplGetter, stack.NameForService(d.app.Name, d.env.Name, d.name), template)
+}
+
+func deployDiff(getter deployedTemplateGetter, stackName, template string) (string, error) {
+	tmpl, err := getter.TemplateBody(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if !errors.As(err, &errNotFound) {
+			return "", fmt.Errorf("retrieve the deployed template for stack %s: %w", stackName, err)
+		}
+		tmpl = "" // The stack has never been deployed, so every resource is new.
+	}
+	tree, err := diff.From(tmpl).Parse([]byte(template))
+	if err != nil {
+		return "", fmt.Errorf("parse the diff against the deployed template of stack %s: %w", stackName, err)
+	}
+	buf := strings.Builder{}
+	if err := diff.NewTreeWriter(tree, &buf).Write(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func (d *workloadDeployer) generateCloudFormationTemplate(conf stackSerializer) (
 	*GenerateCloudFormationTemplateOutput, error) {
 	tpl, err := conf.Template()
@@ -1356,17 +1405,30 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 		return err
 	}
 
-	if d.lbMft.RoutingRule.Alias.IsEmpty() {
-		if hasImportedCerts {
-			return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
-				name:    d.name,
-				envName: d.env.Name,
-			}
+	if d.lbMft.RoutingRule.Alias.IsEmpty() && hasImportedCerts {
+		return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
+			name:    d.name,
+			envName: d.env.Name,
 		}
+	}
+	aliases := []manifest.Alias{d.lbMft.RoutingRule.Alias}
+	for _, rule := range d.lbMft.RoutingRule.AdditionalRoutingRules {
+		aliases = append(aliases, rule.Alias)
+	}
+	for _, alias := range aliases {
+		if err := d.validateALBAlias(alias, hasImportedCerts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *lbWebSvcDeployer) validateALBAlias(alias manifest.Alias, hasImportedCerts bool) error {
+	if alias.IsEmpty() {
 		return nil
 	}
 	if hasImportedCerts {
-		aliases, err := d.lbMft.RoutingRule.Alias.ToStringSlice()
+		aliases, err := alias.ToStringSlice()
 		if err != nil {
 			return fmt.Errorf("convert aliases to string slice: %w", err)
 		}
@@ -1380,7 +1442,7 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 			logAppVersionOutdatedError(aws.StringValue(d.lbMft.Name))
 			return err
 		}
-		return validateLBWSAlias(d.lbMft.RoutingRule.Alias, d.app, d.env.Name)
+		return validateLBWSAlias(alias, d.app, d.env.Name)
 	}
 	log.Errorf(ecsALBAliasUsedWithoutDomainFriendlyText)
 	return fmt.Errorf("cannot specify http.alias when application is not associated with a domain and env %s doesn't import one or more certificates", d.env.Name)
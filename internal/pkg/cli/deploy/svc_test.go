@@ -25,6 +25,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -167,7 +168,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.LBWS(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -193,7 +194,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.Backend(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -219,7 +220,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.Worker(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -245,7 +246,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.RDWS(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -271,7 +272,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				// Ensure all custom resources were uploaded.
 				crs, err := customresource.ScheduledJob(fakeTemplateFS())
 				require.NoError(t, err)
-				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (url string, err error) {
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader, _ ...s3.UploadOption) (url string, err error) {
 					for _, cr := range crs {
 						if strings.Contains(key, strings.ToLower(cr.FunctionName())) {
 							return "", nil
@@ -456,6 +457,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 	mockAfterTime := time.Unix(1494505756, 0)
 	tests := map[string]struct {
 		inAliases         manifest.Alias
+		inRules           []manifest.RoutingRuleConfiguration
 		inNLB             manifest.NetworkLoadBalancerConfiguration
 		inApp             *config.Application
 		inEnvironment     *config.Environment
@@ -656,6 +658,29 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
+		"fail to enable https alias of an additional routing rule because of invalid alias": {
+			inRules: []manifest.RoutingRuleConfiguration{
+				{
+					Path: aws.String("/admin"),
+					Alias: manifest.Alias{AdvancedAliases: []manifest.AdvancedAlias{
+						{Alias: aws.String("v1.v2.mockDomain")},
+					}},
+				},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return("v1.0.0", nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
+		},
 		"fail to enable nlb alias because of invalid alias": {
 			inNLB: manifest.NetworkLoadBalancerConfiguration{
 				Port: aws.String("80"),
@@ -903,8 +928,9 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 						},
 						RoutingRule: manifest.RoutingRuleConfigOrBool{
 							RoutingRuleConfiguration: manifest.RoutingRuleConfiguration{
-								Path:  aws.String("/"),
-								Alias: tc.inAliases,
+								Path:                   aws.String("/"),
+								Alias:                  tc.inAliases,
+								AdditionalRoutingRules: tc.inRules,
 							},
 						},
 						NLBConfig: tc.inNLB,
@@ -1479,3 +1505,79 @@ func TestBackendSvcDeployer_stackConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDeployer_DeployDiff(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate   string
+		mockGetter   func(m *mocks.MockdeployedTemplateGetter)
+		wantedDiff   string
+		wantedErrMsg string
+	}{
+		"error if fail to get the deployed template": {
+			mockGetter: func(m *mocks.MockdeployedTemplateGetter) {
+				m.EXPECT().TemplateBody("mockApp-mockEnv-mockSvc").Return("", errors.New("some error"))
+			},
+			wantedErrMsg: "retrieve the deployed template for stack mockApp-mockEnv-mockSvc: some error",
+		},
+		"error if fail to parse the templates": {
+			inTemplate: "Resources: [",
+			mockGetter: func(m *mocks.MockdeployedTemplateGetter) {
+				m.EXPECT().TemplateBody("mockApp-mockEnv-mockSvc").Return("Resources: {}", nil)
+			},
+			wantedErrMsg: "parse the diff against the deployed template of stack mockApp-mockEnv-mockSvc: unmarshal new template: yaml: line 1: did not find expected node content",
+		},
+		"every resource is new if the stack does not exist": {
+			inTemplate: "Resources:\n  Service:\n    Type: AWS::ECS::Service\n",
+			mockGetter: func(m *mocks.MockdeployedTemplateGetter) {
+				m.EXPECT().TemplateBody("mockApp-mockEnv-mockSvc").Return("", &cloudformation.ErrStackNotFound{})
+			},
+			wantedDiff: `+ Resources:
++   Service:
++     Type: AWS::ECS::Service
+`,
+		},
+		"no diff": {
+			inTemplate: "Resources:\n  Service:\n    Type: AWS::ECS::Service\n",
+			mockGetter: func(m *mocks.MockdeployedTemplateGetter) {
+				m.EXPECT().TemplateBody("mockApp-mockEnv-mockSvc").Return("Resources:\n  Service:\n    Type: 'AWS::ECS::Service'\n", nil)
+			},
+		},
+		"write the diff against the deployed template": {
+			inTemplate: "Resources:\n  Service:\n    Properties:\n      DesiredCount: 2\n",
+			mockGetter: func(m *mocks.MockdeployedTemplateGetter) {
+				m.EXPECT().TemplateBody("mockApp-mockEnv-mockSvc").Return("Resources:\n  Service:\n    Properties:\n      DesiredCount: 1\n", nil)
+			},
+			wantedDiff: `~ Resources:
+    ~ Service:
+        ~ Properties:
+            ~ DesiredCount: 1 -> 2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeployedTemplateGetter(ctrl)
+			tc.mockGetter(m)
+			deployer := workloadDeployer{
+				name: "mockSvc",
+				app: &config.Application{
+					Name: "mockApp",
+				},
+				env: &config.Environment{
+					Name: "mockEnv",
+				},
+				tmplGetter: m,
+			}
+
+			got, gotErr := deployer.DeployDiff(tc.inTemplate)
+			if tc.wantedErrMsg != "" {
+				require.EqualError(t, gotErr, tc.wantedErrMsg)
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedDiff, got)
+			}
+		})
+	}
+}
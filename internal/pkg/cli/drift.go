@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// driftExitCode is the exit code of the diff commands when drift is detected,
+	// so that it can be told apart from failures to run the command, which exit with 1.
+	driftExitCode = 2
+
+	ecsServiceResourceType   = "AWS::ECS::Service"
+	desiredCountPropertyPath = "/DesiredCount"
+)
+
+type errDriftDetected struct {
+	name string // Name of the workload or environment.
+}
+
+func (e *errDriftDetected) Error() string {
+	return fmt.Sprintf("%s has drifted from its deployed configuration", e.name)
+}
+
+// ExitCode returns the exit code of the CLI when drift is detected.
+func (e *errDriftDetected) ExitCode() int {
+	return driftExitCode
+}
+
+// driftReport combines the differences between a local manifest and its deployed version
+// with the resources of the deployed stack that were modified outside of CloudFormation.
+type driftReport struct {
+	manifestDiff diff.Tree
+	resources    []*awscloudformation.StackResourceDrift
+}
+
+// newDriftReport compares the normalized local manifest with the normalized deployed one.
+func newDriftReport(deployedMft, localMft []byte, resources []*awscloudformation.StackResourceDrift) (*driftReport, error) {
+	tree, err := diff.From(deployedMft).Parse(localMft)
+	if err != nil {
+		return nil, fmt.Errorf("parse the diff against the deployed manifest: %w", err)
+	}
+	return &driftReport{
+		manifestDiff: tree,
+		resources:    resources,
+	}, nil
+}
+
+// withoutDesiredCount removes the differences in the desired count of the ECS services from the drifted resources.
+// The desired count of an autoscaled service is expected to differ from the one in its template.
+func withoutDesiredCount(resources []*awscloudformation.StackResourceDrift) []*awscloudformation.StackResourceDrift {
+	var out []*awscloudformation.StackResourceDrift
+	for _, res := range resources {
+		if aws.StringValue(res.ResourceType) != ecsServiceResourceType {
+			out = append(out, res)
+			continue
+		}
+		var props []*sdkcloudformation.PropertyDifference
+		for _, prop := range res.PropertyDifferences {
+			if aws.StringValue(prop.PropertyPath) != desiredCountPropertyPath {
+				props = append(props, prop)
+			}
+		}
+		if len(props) == 0 && len(res.PropertyDifferences) != 0 {
+			// The desired count was the only difference.
+			continue
+		}
+		drift := *res
+		drift.PropertyDifferences = props
+		out = append(out, &drift)
+	}
+	return out
+}
+
+func (r *driftReport) hasDrifted() bool {
+	return !r.manifestDiff.IsEmpty() || len(r.resources) != 0
+}
+
+// write writes the manifest differences followed by the drifted resources of the stack.
+func (r *driftReport) write(w io.Writer) error {
+	fmt.Fprintln(w, color.Bold.Sprint("Manifest"))
+	if r.manifestDiff.IsEmpty() {
+		fmt.Fprintln(w, "No differences between the local and the deployed manifest.")
+	} else if err := diff.NewTreeWriter(r.manifestDiff, w).Write(); err != nil {
+		return fmt.Errorf("write manifest differences: %w", err)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, color.Bold.Sprint("Stack resources"))
+	if len(r.resources) == 0 {
+		fmt.Fprintln(w, "No resources drifted from the deployed template.")
+		return nil
+	}
+	for _, res := range r.resources {
+		fmt.Fprintf(w, "%s (%s): %s\n", aws.StringValue(res.LogicalResourceId), aws.StringValue(res.ResourceType), aws.StringValue(res.StackResourceDriftStatus))
+		for _, prop := range res.PropertyDifferences {
+			fmt.Fprintf(w, "  %s %s: expected %s, actual %s\n", aws.StringValue(prop.DifferenceType), aws.StringValue(prop.PropertyPath),
+				aws.StringValue(prop.ExpectedValue), aws.StringValue(prop.ActualValue))
+		}
+	}
+	return nil
+}
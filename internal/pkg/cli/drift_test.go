@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithoutDesiredCount(t *testing.T) {
+	desiredCount := &cloudformation.PropertyDifference{
+		PropertyPath: aws.String("/DesiredCount"),
+	}
+	platformVersion := &cloudformation.PropertyDifference{
+		PropertyPath: aws.String("/PlatformVersion"),
+	}
+	testCases := map[string]struct {
+		in     []*awscloudformation.StackResourceDrift
+		wanted []*awscloudformation.StackResourceDrift
+	}{
+		"drops a service whose only difference is its desired count": {
+			in: []*awscloudformation.StackResourceDrift{
+				{
+					ResourceType:        aws.String("AWS::ECS::Service"),
+					PropertyDifferences: []*cloudformation.PropertyDifference{desiredCount},
+				},
+			},
+		},
+		"keeps the other differences of a service": {
+			in: []*awscloudformation.StackResourceDrift{
+				{
+					ResourceType:        aws.String("AWS::ECS::Service"),
+					PropertyDifferences: []*cloudformation.PropertyDifference{desiredCount, platformVersion},
+				},
+			},
+			wanted: []*awscloudformation.StackResourceDrift{
+				{
+					ResourceType:        aws.String("AWS::ECS::Service"),
+					PropertyDifferences: []*cloudformation.PropertyDifference{platformVersion},
+				},
+			},
+		},
+		"keeps deleted services and other resources": {
+			in: []*awscloudformation.StackResourceDrift{
+				{
+					ResourceType:             aws.String("AWS::ECS::Service"),
+					StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+				},
+				{
+					ResourceType:        aws.String("AWS::ApplicationAutoScaling::ScalableTarget"),
+					PropertyDifferences: []*cloudformation.PropertyDifference{desiredCount},
+				},
+			},
+			wanted: []*awscloudformation.StackResourceDrift{
+				{
+					ResourceType:             aws.String("AWS::ECS::Service"),
+					StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+				},
+				{
+					ResourceType:        aws.String("AWS::ApplicationAutoScaling::ScalableTarget"),
+					PropertyDifferences: []*cloudformation.PropertyDifference{desiredCount},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, withoutDesiredCount(tc.in))
+		})
+	}
+}
@@ -5,6 +5,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -12,18 +14,23 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 type deployEnvVars struct {
-	appName string
-	name    string
+	appName  string
+	name     string
+	showDiff bool
+	varFile  string
 }
 
 type deployEnvOpts struct {
@@ -33,13 +40,17 @@ type deployEnvOpts struct {
 	store store
 
 	// Dependencies to ask.
-	sel wsEnvironmentSelector
+	sel    wsEnvironmentSelector
+	prompt prompter
 
 	// Dependencies to execute.
-	ws             wsEnvironmentReader
-	identity       identityService
-	interpolator   interpolator
-	newEnvDeployer func() (envDeployer, error)
+	ws              wsEnvironmentReader
+	identity        identityService
+	newInterpolator func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+	cmd             execRunner
+	fs              afero.Fs
+	newEnvDeployer  func() (envDeployer, error)
+	diffWriter      io.Writer
 
 	// Cached variables.
 	targetApp *config.Application
@@ -60,15 +71,20 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("new workspace: %w", err)
 	}
+	prompter := prompt.New()
 	opts := &deployEnvOpts{
 		deployEnvVars: vars,
 
-		store: store,
-		sel:   selector.NewLocalEnvironmentSelector(prompt.New(), store, ws),
+		store:  store,
+		sel:    selector.NewLocalEnvironmentSelector(prompter, store, ws),
+		prompt: prompter,
 
-		ws:           ws,
-		identity:     identity.New(defaultSess),
-		interpolator: manifest.NewInterpolator(vars.appName, vars.name),
+		ws:              ws,
+		identity:        identity.New(defaultSess),
+		newInterpolator: newManifestInterpolator,
+		cmd:             exec.NewCmd(),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		diffWriter:      os.Stdout,
 
 		unmarshalManifest: manifest.UnmarshalEnvironment,
 	}
@@ -90,9 +106,9 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 	return opts, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error for any invalid optional flags.
 func (o *deployEnvOpts) Validate() error {
-	return nil
+	return validateVarFile(o.fs, o.varFile)
 }
 
 // Ask prompts for and validates any required flags.
@@ -121,15 +137,30 @@ func (o *deployEnvOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	urls, err := deployer.UploadArtifacts()
+	artifacts, err := deployer.UploadArtifacts()
 	if err != nil {
 		return fmt.Errorf("upload artifacts for environment %s: %w", o.name, err)
 	}
-	if err := deployer.DeployEnvironment(&deploy.DeployEnvironmentInput{
+	deployInput := &deploy.DeployEnvironmentInput{
 		RootUserARN:         caller.RootUserARN,
-		CustomResourcesURLs: urls,
+		CustomResourcesURLs: artifacts.CustomResourceURLs,
+		AddonsURL:           artifacts.AddonsURL,
 		Manifest:            mft,
-	}); err != nil {
+	}
+	if o.showDiff {
+		output, err := deployer.GenerateCloudFormationTemplate(deployInput)
+		if err != nil {
+			return fmt.Errorf("generate the template for environment %q: %w", o.name, err)
+		}
+		contd, err := confirmDiff(deployer, output.Template, o.diffWriter, o.prompt)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			return nil
+		}
+	}
+	if err := deployer.DeployEnvironment(deployInput); err != nil {
 		return fmt.Errorf("deploy environment %s: %w", o.name, err)
 	}
 	return nil
@@ -144,7 +175,16 @@ func (o *deployEnvOpts) environmentManifest() (*manifest.Environment, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest for environment %s: %w", targetEnv.Name, err)
 	}
-	interpolated, err := o.interpolator.Interpolate(string(raw))
+	interpolatorOpts, err := manifestVars{
+		env:     targetEnv,
+		runner:  o.cmd,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return nil, err
+	}
+	interpolated, err := o.newInterpolator(o.appName, targetEnv.Name, interpolatorOpts...).Interpolate(string(raw))
 	if err != nil {
 		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", targetEnv.Name, err)
 	}
@@ -155,6 +195,11 @@ func (o *deployEnvOpts) environmentManifest() (*manifest.Environment, error) {
 	if err := mft.Validate(); err != nil {
 		return nil, fmt.Errorf("validate environment manifest for %s: %w", targetEnv.Name, err)
 	}
+	if buckets := mft.CDNConfig.CDNConfig.StaticAssetsBuckets(); len(buckets) > 0 {
+		log.Warningf("The policy of %s %s is replaced with one that only lets the CloudFront distribution of environment %s read the objects.\n"+
+			"Use a separate bucket for the static assets of each environment.\n",
+			english.PluralWord(len(buckets), "bucket", "buckets"), english.WordSeries(buckets, "and"), targetEnv.Name)
+	}
 	return mft, nil
 }
 
@@ -206,7 +251,9 @@ func buildEnvDeployCmd() *cobra.Command {
 		Long:  "Deploys an environment to an application.",
 		Example: `
 Deploy an environment named "test".
-/code $copilot env deploy --name test`,
+/code $copilot env deploy --name test
+Show the changes to the environment's stack before deploying it.
+/code $copilot env deploy --name test --diff`,
 		Hidden: true,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newEnvDeployOpts(vars)
@@ -218,5 +265,7 @@ Deploy an environment named "test".
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 	return cmd
 }
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -118,12 +119,15 @@ type deployEnvExecuteMocks struct {
 	deployer     *mocks.MockenvDeployer
 	identity     *mocks.MockidentityService
 	interpolator *mocks.Mockinterpolator
+	prompter     *mocks.Mockprompter
 }
 
 func TestDeployEnvOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
+		inShowDiff        bool
 		unmarshalManifest func(in []byte) (*manifest.Environment, error)
 		setUpMocks        func(m *deployEnvExecuteMocks)
+		wantedDiff        string
 		wantedErr         error
 	}{
 		"fail to read manifest": {
@@ -175,8 +179,10 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.identity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "mockRootUserARN",
 				}, nil)
-				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{
-					"mockResource": "mockURL",
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					CustomResourceURLs: map[string]string{
+						"mockResource": "mockURL",
+					},
 				}, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(_ *deploy.DeployEnvironmentInput) error {
 					return errors.New("some error")
@@ -184,6 +190,67 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New("deploy environment mockEnv: some error"),
 		},
+		"fail to generate the template to show diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("mock manifest"), nil)
+				m.interpolator.EXPECT().Interpolate("mock manifest").Return("mock interpolated manifest", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					CustomResourceURLs: map[string]string{
+						"mockResource": "mockURL",
+					},
+				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`generate the template for environment "mockEnv": some error`),
+		},
+		"do not deploy if the user declines after seeing the diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("mock manifest"), nil)
+				m.interpolator.EXPECT().Interpolate("mock manifest").Return("mock interpolated manifest", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					CustomResourceURLs: map[string]string{
+						"mockResource": "mockURL",
+					},
+				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mock template",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mock template").Return("+ Resources:\n", nil)
+				m.prompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(false, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedDiff: "+ Resources:\n",
+		},
+		"deploy after the user confirms the diff": {
+			inShowDiff: true,
+			setUpMocks: func(m *deployEnvExecuteMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("mock manifest"), nil)
+				m.interpolator.EXPECT().Interpolate("mock manifest").Return("mock interpolated manifest", nil)
+				m.identity.EXPECT().Get().Return(identity.Caller{
+					RootUserARN: "mockRootUserARN",
+				}, nil)
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					CustomResourceURLs: map[string]string{
+						"mockResource": "mockURL",
+					},
+				}, nil)
+				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "mock template",
+				}, nil)
+				m.deployer.EXPECT().DeployDiff("mock template").Return("", nil)
+				m.prompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(true, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(nil)
+			},
+			wantedDiff: "No changes.\n",
+		},
 		"success": {
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.ws.EXPECT().ReadEnvironmentManifest("mockEnv").Return([]byte("mock manifest"), nil)
@@ -191,14 +258,18 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.identity.EXPECT().Get().Return(identity.Caller{
 					RootUserARN: "mockRootUserARN",
 				}, nil)
-				m.deployer.EXPECT().UploadArtifacts().Return(map[string]string{
-					"mockResource": "mockURL",
+				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{
+					CustomResourceURLs: map[string]string{
+						"mockResource": "mockURL",
+					},
+					AddonsURL: "mockAddonsURL",
 				}, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.DeployEnvironmentInput) error {
 					require.Equal(t, in.RootUserARN, "mockRootUserARN")
 					require.Equal(t, in.CustomResourcesURLs, map[string]string{
 						"mockResource": "mockURL",
 					})
+					require.Equal(t, in.AddonsURL, "mockAddonsURL")
 					require.Equal(t, in.Manifest, &manifest.Environment{
 						Workload: manifest.Workload{
 							Name: aws.String("mockEnv"),
@@ -218,15 +289,22 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				deployer:     mocks.NewMockenvDeployer(ctrl),
 				identity:     mocks.NewMockidentityService(ctrl),
 				interpolator: mocks.NewMockinterpolator(ctrl),
+				prompter:     mocks.NewMockprompter(ctrl),
 			}
 			tc.setUpMocks(m)
+			diffWriter := &strings.Builder{}
 			opts := deployEnvOpts{
 				deployEnvVars: deployEnvVars{
-					name: "mockEnv",
+					name:     "mockEnv",
+					showDiff: tc.inShowDiff,
+				},
+				prompt:     m.prompter,
+				diffWriter: diffWriter,
+				ws:         m.ws,
+				identity:   m.identity,
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
+					return m.interpolator
 				},
-				ws:           m.ws,
-				identity:     m.identity,
-				interpolator: m.interpolator,
 				newEnvDeployer: func() (envDeployer, error) {
 					return m.deployer, nil
 				},
@@ -251,6 +329,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				require.EqualError(t, err, tc.wantedErr.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diffWriter.String())
 			}
 		})
 	}
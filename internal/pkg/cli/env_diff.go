@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDiffNamePrompt     = "Which environment would you like to compare with its deployed version?"
+	envDiffNameHelpPrompt = "The local manifest of the environment is compared with the manifest of its latest deployment."
+)
+
+type envDiffVars struct {
+	appName string
+	name    string
+	varFile string
+}
+
+type envDiffOpts struct {
+	envDiffVars
+
+	store             store
+	ws                wsEnvironmentReader
+	sel               wsEnvironmentSelector
+	unmarshalManifest func(in []byte) (*manifest.Environment, error)
+	newInterpolator   func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+	cmd               execRunner
+	fs                afero.Fs
+	spinner           progress
+	w                 io.Writer
+
+	// Clients initialized once the environment is known.
+	initClients func(env *config.Environment) error
+	deployedMft deployedManifestGetter
+	drifter     stackDriftDetector
+}
+
+func newEnvDiffOpts(vars envDiffVars) (*envDiffOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env diff"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	opts := &envDiffOpts{
+		envDiffVars:       vars,
+		store:             store,
+		ws:                ws,
+		sel:               selector.NewLocalEnvironmentSelector(prompt.New(), store, ws),
+		unmarshalManifest: manifest.UnmarshalEnvironment,
+		newInterpolator:   newManifestInterpolator,
+		cmd:               exec.NewCmd(),
+		fs:                &afero.Afero{Fs: afero.NewOsFs()},
+		spinner:           termprogress.NewSpinner(log.DiagnosticWriter),
+		w:                 os.Stdout,
+	}
+	opts.initClients = func(env *config.Environment) error {
+		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         env.Name,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return fmt.Errorf("create describer for environment %s: %w", env.Name, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.deployedMft = d
+		opts.drifter = awscloudformation.New(envSess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *envDiffOpts) Validate() error {
+	return validateVarFile(o.fs, o.varFile)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *envDiffOpts) Ask() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.LocalEnvironment(envDiffNamePrompt, envDiffNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute compares the local manifest of the environment with the deployed one, and detects drift on the environment stack.
+// It returns an error that makes the CLI exit with a non-zero code if there is any difference.
+func (o *envDiffOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	if err := o.initClients(env); err != nil {
+		return err
+	}
+	localMft, err := o.localManifest(env)
+	if err != nil {
+		return err
+	}
+	deployedRaw, err := o.deployedMft.Manifest()
+	if err != nil {
+		return fmt.Errorf("get deployed manifest of environment %s: %w", o.name, err)
+	}
+	deployedMft, err := o.normalizedManifest(deployedRaw)
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForEnv(o.appName, o.name)
+	o.spinner.Start(fmt.Sprintf(fmtDetectDriftStart, stackName))
+	drifts, err := o.drifter.StackDrift(context.Background(), stackName)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtDetectDriftFailed+"\n", stackName))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtDetectDriftComplete+"\n", stackName))
+	report, err := newDriftReport(deployedMft, localMft, drifts)
+	if err != nil {
+		return err
+	}
+	if err := report.write(o.w); err != nil {
+		return err
+	}
+	if report.hasDrifted() {
+		return &errDriftDetected{
+			name: fmt.Sprintf("environment %s", o.name),
+		}
+	}
+	return nil
+}
+
+func (o *envDiffOpts) localManifest(env *config.Environment) ([]byte, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for environment %s: %w", o.name, err)
+	}
+	interpolatorOpts, err := manifestVars{
+		env:     env,
+		runner:  o.cmd,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return nil, err
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.name, interpolatorOpts...).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
+	return o.normalizedManifest([]byte(interpolated))
+}
+
+// normalizedManifest serializes an environment manifest so that it can be compared field by field.
+func (o *envDiffOpts) normalizedManifest(in []byte) ([]byte, error) {
+	mft, err := o.unmarshalManifest(in)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal environment manifest for %s: %w", o.name, err)
+	}
+	out, err := manifest.MarshalNormalized(mft)
+	if err != nil {
+		return nil, fmt.Errorf("normalize manifest of environment %s: %w", o.name, err)
+	}
+	return out, nil
+}
+
+// buildEnvDiffCmd builds the command for comparing an environment with its deployed version.
+func buildEnvDiffCmd() *cobra.Command {
+	vars := envDiffVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares an environment with its deployed version and detects drift.",
+		Long: `Compares the local manifest of an environment with the manifest of its last deployment, field by field,
+and detects drift on the resources of its CloudFormation stack.
+Exits with code 2 if the environment has drifted from its deployed configuration.`,
+
+		Example: `
+  Compare the "prod" environment in your workspace with its deployment.
+  /code $ copilot env diff -n prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDiffOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
+	return cmd
+}
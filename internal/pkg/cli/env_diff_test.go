@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type envDiffMocks struct {
+	store        *mocks.Mockstore
+	ws           *mocks.MockwsEnvironmentReader
+	interpolator *mocks.Mockinterpolator
+	deployedMft  *mocks.MockdeployedManifestGetter
+	drifter      *mocks.MockstackDriftDetector
+	spinner      *mocks.Mockprogress
+}
+
+func TestEnvDiffOpts_Execute(t *testing.T) {
+	const localMft = `name: test
+type: Environment
+observability:
+  container_insights: true
+`
+	testCases := map[string]struct {
+		setupMocks func(m *envDiffMocks)
+
+		wantedOutput string
+		wantedError  string
+	}{
+		"returns a wrapped error if the local manifest can't be read": {
+			setupMocks: func(m *envDiffMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			wantedError: "read manifest for environment test: some error",
+		},
+		"returns a wrapped error if the deployed manifest can't be retrieved": {
+			setupMocks: func(m *envDiffMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedError: "get deployed manifest of environment test: some error",
+		},
+		"succeeds if the environment hasn't drifted": {
+			setupMocks: func(m *envDiffMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(`name: test
+type: Environment
+observability:
+    container_insights: true
+`), nil)
+				m.spinner.EXPECT().Start("Detecting drift on stack phonetool-test.")
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test").Return(nil, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `Manifest
+No differences between the local and the deployed manifest.
+
+Stack resources
+No resources drifted from the deployed template.
+`,
+		},
+		"reports the drifted resources of the environment stack": {
+			setupMocks: func(m *envDiffMocks) {
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(localMft), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test").Return([]*awscloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("PublicLoadBalancer"),
+						ResourceType:             aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+						StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusDeleted),
+					},
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `Manifest
+No differences between the local and the deployed manifest.
+
+Stack resources
+PublicLoadBalancer (AWS::ElasticLoadBalancingV2::LoadBalancer): DELETED
+`,
+			wantedError: "environment test has drifted from its deployed configuration",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &envDiffMocks{
+				store:        mocks.NewMockstore(ctrl),
+				ws:           mocks.NewMockwsEnvironmentReader(ctrl),
+				interpolator: mocks.NewMockinterpolator(ctrl),
+				deployedMft:  mocks.NewMockdeployedManifestGetter(ctrl),
+				drifter:      mocks.NewMockstackDriftDetector(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			m.interpolator.EXPECT().Interpolate(gomock.Any()).DoAndReturn(func(s string) (string, error) { return s, nil }).AnyTimes()
+			tc.setupMocks(m)
+			out := &strings.Builder{}
+			opts := &envDiffOpts{
+				envDiffVars: envDiffVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:             m.store,
+				ws:                m.ws,
+				unmarshalManifest: manifest.UnmarshalEnvironment,
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
+					return m.interpolator
+				},
+				fs:      afero.NewMemMapFs(),
+				spinner: m.spinner,
+				w:       out,
+				initClients: func(_ *config.Environment) error {
+					return nil
+				},
+				deployedMft: m.deployedMft,
+				drifter:     m.drifter,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, out.String())
+		})
+	}
+}
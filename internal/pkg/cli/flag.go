@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -29,6 +30,7 @@ const (
 	forceFlag      = "force"
 	noRollbackFlag = "no-rollback"
 	manifestFlag   = "manifest"
+	diffFlag       = "diff"
 
 	// Command specific flags.
 	dockerFileFlag        = "dockerfile"
@@ -39,11 +41,17 @@ const (
 	uploadAssetsFlag      = "upload-assets"
 	limitFlag             = "limit"
 	followFlag            = "follow"
+	logsFlag              = "logs"
+	watchFlag             = "watch"
 	sinceFlag             = "since"
 	startTimeFlag         = "start-time"
 	endTimeFlag           = "end-time"
 	tasksFlag             = "tasks"
 	logGroupFlag          = "log-group"
+	filterFlag            = "filter"
+	outputFieldsFlag      = "output-fields"
+	levelFlag             = "level"
+	svcsFlag              = "svcs"
 	prodEnvFlag           = "prod"
 	deployFlag            = "deploy"
 	resourcesFlag         = "resources"
@@ -56,6 +64,7 @@ const (
 	localFlag             = "local"
 	deleteSecretFlag      = "delete-secret"
 	svcPortFlag           = "port"
+	sourceDirFlag         = "source-dir"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -69,6 +78,20 @@ const (
 	storageRDSEngineFlag         = "engine"
 	storageRDSInitialDBFlag      = "initial-db"
 	storageRDSParameterGroupFlag = "parameter-group"
+	storageLifecycleFlag         = "lifecycle"
+	storageGrantFlag             = "grant"
+	storageEngineVersionFlag     = "engine-version"
+	storageNodeTypeFlag          = "node-type"
+	storageClusterModeFlag       = "cluster-mode"
+	storageKMSEncryptionFlag     = "kms-encryption"
+	storageRDSCapacityModeFlag   = "capacity-mode"
+	storageRDSMinCapacityFlag    = "min-capacity"
+	storageRDSMaxCapacityFlag    = "max-capacity"
+	storageRDSInstanceClassFlag  = "instance-class"
+	storageRDSReadersFlag        = "readers"
+	storageRDSBackupFlag         = "backup-retention"
+	storageRDSDeletionFlag       = "deletion-policy"
+	storageRDSInsightsFlag       = "performance-insights"
 
 	taskGroupNameFlag            = "task-group-name"
 	countFlag                    = "count"
@@ -87,6 +110,7 @@ const (
 	entrypointFlag               = "entrypoint"
 	taskDefaultFlag              = "default"
 	generateCommandFlag          = "generate-cmd"
+	fromManifestFlag             = "from-manifest"
 	osFlag                       = "platform-os"
 	archFlag                     = "platform-arch"
 
@@ -114,14 +138,20 @@ const (
 	timeoutFlag  = "timeout"
 	scheduleFlag = "schedule"
 
-	taskIDFlag    = "task-id"
-	containerFlag = "container"
+	taskIDFlag     = "task-id"
+	containerFlag  = "container"
+	remoteHostFlag = "remote-host"
 
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
 
 	includeStateMachineLogsFlag = "include-state-machine"
+
+	secretsFileFlag = "secrets-file"
+	varFileFlag     = "var-file"
+
+	revisionFlag = "revision"
 )
 
 // Short flag names.
@@ -154,8 +184,19 @@ Cannot be specified with --%s or --%s.`, dockerFileFlag, dockerFileContextFlag)
 Cannot be specified with --%s.`, imageFlag)
 	dockerFileContextFlagDescription = fmt.Sprintf(`Path to the Docker build context.
 Cannot be specified with --%s.`, imageFlag)
+	sourceDirFlagDescription = fmt.Sprintf(`Path to the directory of the files to upload for a %s,
+relative to the root of the workspace.`, manifest.StaticSiteType)
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
+	storageLifecycleFlagDescription = fmt.Sprintf(`Whether the storage is deployed with a workload or with its environments.
+Must be one of: %s.
+Storage deployed with the environments is shared by their workloads.`, strings.Join(template.QuoteSliceFunc(storageLifecycles), ", "))
+	storageRDSCapacityModeFlagDescription = fmt.Sprintf(`Optional. How the capacity of the Aurora cluster is managed.
+Must be one of %s. Defaults to "serverless-v1".`, strings.Join(template.QuoteSliceFunc(addon.RDSCapacityModes), ", "))
+	storageRDSDeletionFlagDescription = fmt.Sprintf(`Optional. What happens to the Aurora cluster when its stack is deleted.
+Must be one of %s. Defaults to "Snapshot".`, strings.Join(template.QuoteSliceFunc(addon.RDSDeletionPolicies), ", "))
+	storageEngineVersionFlagDescription = fmt.Sprintf(`The version of the engine of the Redis cluster or the OpenSearch domain.
+Must be one of %s for Redis, or %s for OpenSearch.`, strings.Join(template.QuoteSliceFunc(addon.RedisEngineVersions), ", "), strings.Join(template.QuoteSliceFunc(addon.OpenSearchEngineVersions), ", "))
 	jobTypeFlagDescription = fmt.Sprintf(`Type of job to create. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(manifest.JobTypes()), ", "))
 	wkldTypeFlagDescription = fmt.Sprintf(`Type of job or svc to create. Must be one of:
@@ -204,12 +245,15 @@ const (
 	yesFlagDescription        = "Skips confirmation prompt."
 	execYesFlagDescription    = "Optional. Whether to update the Session Manager Plugin."
 	jsonFlagDescription       = "Optional. Outputs in JSON format."
+	secretFlagDescription     = "Name of the secret."
 	forceFlagDescription      = "Optional. Force a new service deployment using the existing image."
 	noRollbackFlagDescription = `Optional. Disable automatic stack 
 rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
 	manifestFlagDescription = "Optional. Output the manifest file used for the deployment."
+	diffFlagDescription     = `Optional. Compares the generated CloudFormation template
+to the deployed stack and asks for confirmation before deploying.`
 
 	imageTagFlagDescription     = `Optional. The container image tag.`
 	resourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
@@ -221,8 +265,13 @@ Uploaded asset locations are filled in the template configuration.`
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
-	followFlagDescription = "Optional. Specifies if the logs should be streamed."
-	sinceFlagDescription  = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
+	followFlagDescription       = "Optional. Specifies if the logs should be streamed."
+	jobRunFollowFlagDescription = `Optional. Wait for the job to finish and stream its logs.
+Exits with the exit code of the job's container if it fails.`
+	jobExecutionsLimitFlagDescription = "Optional. The maximum number of executions returned."
+	jobExecutionsLogsFlagDescription  = "Optional. Select an execution and display the logs of its tasks."
+	watchFlagDescription              = "Optional. Refreshes the status periodically until interrupted."
+	sinceFlagDescription              = `Optional. Only return logs newer than a relative duration like 5s, 2m, or 3h.
 Defaults to all logs. Only one of start-time / since may be used.`
 	startTimeFlagDescription = `Optional. Only return logs after a specific date (RFC3339).
 Defaults to all logs. Only one of start-time / since may be used.`
@@ -231,6 +280,18 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
+	filterFlagDescription                  = `Optional. Only return logs matching a CloudWatch Logs filter pattern.
+For example, "ERROR" or '{ $.level = "error" }'.
+Only filters the logs of the last hour unless --since or --start-time is set.`
+	containerLogsFlagDescription = `Optional. Only return logs from a specific container, such as a sidecar.
+Defaults to all the containers.`
+	outputFieldsFlagDescription = `Optional. Fields of JSON log messages to display in columns, like "level,msg,trace_id".
+Nested fields can be selected with dots. Messages that are not JSON are displayed as is.`
+	levelFlagDescription = `Optional. Only return JSON log messages at or above a level.
+Must be one of "trace", "debug", "info", "warn", "error" or "fatal".
+Messages that are not JSON are always returned.`
+	svcsLogsFlagDescription = `Optional. Return the interleaved logs of multiple services in the same environment.
+Only one of name / svcs may be used.`
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "(Deprecated.) Use '--url' instead. Repository URL to trigger your pipeline."
@@ -265,6 +326,22 @@ Must be of the format '<keyName>:<dataType>'.`
 Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageNodeTypeFlagDescription          = `The node type of the Redis cluster, or the instance type of the data nodes of the OpenSearch domain.
+For example: "cache.t3.micro" for Redis, "t3.medium.search" for OpenSearch.`
+	storageClusterModeFlagDescription = `Optional. Partition the data of the Redis cluster across multiple shards,
+or spread the data nodes of the OpenSearch domain across two Availability Zones.`
+	storageKMSEncryptionFlagDescription  = "Optional. Encrypt the data at rest with a customer managed KMS key instead of a key managed by AWS."
+	storageRDSMinCapacityFlagDescription = `Optional. The minimum number of Aurora capacity units of a Serverless v2 cluster.
+Must be between 0.5 and 128, in increments of 0.5.`
+	storageRDSMaxCapacityFlagDescription = `Optional. The maximum number of Aurora capacity units of a Serverless v2 cluster.
+Must be between 0.5 and 128, in increments of 0.5.`
+	storageRDSInstanceClassFlagDescription = `Optional. The instance class of the DB instances of a provisioned cluster.
+For example, "db.r6g.large".`
+	storageRDSReadersFlagDescription  = "Optional. The number of reader DB instances of a Serverless v2 or provisioned cluster."
+	storageRDSBackupFlagDescription   = "Optional. The number of days to retain the automated backups of the cluster, between 1 and 35."
+	storageRDSInsightsFlagDescription = "Optional. Enable Performance Insights on the DB instances of a Serverless v2 or provisioned cluster."
+	storageGrantFlagDescription       = `Optional. Names of the workloads to grant access to the storage
+with an "environment" lifecycle. For example: --grant api,worker`
 
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
@@ -283,6 +360,9 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	fromManifestFlagDescription = `Optional. Name of a service or job in the workspace whose manifest is used as the task definition.
+The environment overrides of the manifest are applied, and the task runs with the environment's networking.
+Must be specified with --env. Other task flags override the values from the manifest.`
 
 	vpcIDFlagDescription              = "Optional. Use an existing VPC ID."
 	publicSubnetsFlagDescription      = "Optional. Use existing public subnet IDs."
@@ -324,6 +404,21 @@ are also accepted.`
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	cpContainerFlagDescription = "Optional. The specific container to copy files into or out of. By default the first essential container will be used."
+
+	portForwardPortFlagDescription = `Local and remote ports to forward, specified as <local>:<remote>.
+A single port is used as both the local and the remote port.`
+	portForwardTaskIDFlagDescription     = "Optional. ID of the task to forward the port to."
+	portForwardRemoteHostFlagDescription = "Optional. Host reachable from the task to forward the port to, instead of the task itself."
 
 	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+	secretDeleteEnvFlagDescription = "Optional. Name of the environment to delete the secret from. Defaults to all environments."
+
+	secretsFileFlagDescription = `Optional. Path to a YAML file with the values of the secrets in the manifest,
+keyed by their SSM parameter or Secrets Manager secret name, or by their environment variable.`
+	varFileFlagDescription = `Optional. Path to a file of KEY=VALUE lines with the variables
+to substitute in the manifest. OS environment variables take precedence.`
+
+	revisionFlagDescription = `Optional. The task definition revision of the service to roll back to.
+Defaults to prompting for one of the previous revisions.`
 )
@@ -29,6 +29,16 @@ func hasUncommitedGitChanges(r execRunner) (bool, error) {
 	return strings.TrimSpace(stdout.String()) != "", nil
 }
 
+// gitCommitSHA returns the commit ID checked out in the workspace.
+func gitCommitSHA(r execRunner) (string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	if err := r.Run("git", []string{"rev-parse", "HEAD"}, exec.Stdout(&stdout), exec.Stderr(&stderr)); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
 // imageTagFromGit returns the image tag to apply in case the user is in a git repository.
 // If the user provided their own tag, then just use that.
 // If there is a clean git commit with no local changes, then return the git commit id.
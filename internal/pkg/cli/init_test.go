@@ -127,6 +127,10 @@ func TestInitOpts_Run(t *testing.T) {
 						Value: manifest.WorkerServiceType,
 						Hint:  "Events to SQS to ECS on Fargate",
 					},
+					{
+						Value: manifest.StaticSiteType,
+						Hint:  "S3 and CloudFront",
+					},
 					{
 						Value: manifest.ScheduledJobType,
 						Hint:  "Scheduled event to State Machine to Fargate",
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"context"
 	"encoding"
 	"io"
 
@@ -278,6 +279,11 @@ type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsWlReader interface {
+	wlLister
+	manifestReader
+}
+
 type wsJobDirReader interface {
 	wsJobReader
 	workspacePathGetter
@@ -314,12 +320,13 @@ type wsAppManager interface {
 
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	OverwriteWorkloadManifest(data []byte, name string) (string, error)
 	manifestReader
 	wlLister
 }
 
 type uploader interface {
-	Upload(bucket, key string, data io.Reader) (string, error)
+	Upload(bucket, key string, data io.Reader, opts ...s3.UploadOption) (string, error)
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
 }
 
@@ -331,6 +338,10 @@ type bucketEmptier interface {
 	EmptyBucket(bucket string) error
 }
 
+type stackDescriber interface {
+	Describe(name string) (*awscloudformation.StackDescription, error)
+}
+
 // Interfaces for deploying resources through CloudFormation. Facilitates mocking.
 type environmentDeployer interface {
 	CreateAndRenderEnvironment(out termprogress.FileWriter, env *deploy.CreateEnvironmentInput) error
@@ -422,6 +433,14 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type deployedManifestGetter interface {
+	Manifest() ([]byte, error)
+}
+
+type stackDriftDetector interface {
+	StackDrift(ctx context.Context, stackName string) ([]*awscloudformation.StackResourceDrift, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
@@ -433,6 +452,10 @@ type versionCompatibilityChecker interface {
 	AvailableFeatures() ([]string, error)
 }
 
+type appStatusDescriber interface {
+	Describe() (*describe.AppStatus, error)
+}
+
 type versionGetter interface {
 	Version() (string, error)
 }
@@ -580,6 +603,15 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type ssmPortForwarder interface {
+	PortForward(in ssm.PortForwardInput) error
+}
+
+type ecsFileCopier interface {
+	Download(src, dst string) error
+	Upload(src, dst string) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -634,18 +666,58 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretLister interface {
+	ListSecrets(tags map[string]string) ([]ssm.Secret, error)
+}
+
+type ssmSecretDeleter interface {
+	DeleteSecret(name string) error
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
 
+type taskDefRevisionDescriber interface {
+	TaskDefinitionRevisions(family string) ([]string, error)
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+}
+
+type templateDownloader interface {
+	ListObjects(bucket, prefix string) ([]s3.Object, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+type serviceRollbacker interface {
+	RollbackService(out termprogress.FileWriter, in *cloudformation.RollbackServiceInput) error
+}
+
+type localContainerRunner interface {
+	CheckDockerEngineRunning() error
+	Build(args *dockerengine.BuildArguments) error
+	CreateNetwork(name string) error
+	RemoveNetwork(name string) error
+	Run(in *dockerengine.RunOptions) error
+	ContainerState(name string) (*dockerengine.ContainerState, error)
+	Logs(name string, w io.Writer) error
+	RemoveContainer(name string) error
+}
+
 type interpolator interface {
 	Interpolate(s string) (string, error)
 }
 
+type templateDiffer interface {
+	DeployDiff(template string) (string, error)
+}
+
 type workloadDeployer interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
+	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
+		*clideploy.GenerateCloudFormationTemplateOutput, error)
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 	IsServiceAvailableInRegion(region string) (bool, error)
+	templateDiffer
 }
 
 type workloadTemplateGenerator interface {
@@ -654,11 +726,18 @@ type workloadTemplateGenerator interface {
 		*clideploy.GenerateCloudFormationTemplateOutput, error)
 }
 
+type jobExecutionLister interface {
+	JobExecutions(app, env, job string, limit int) ([]*ecs.JobExecution, error)
+}
+
 type runner interface {
 	Run() error
+	RunAndFollow() error
 }
 
 type envDeployer interface {
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
-	UploadArtifacts() (map[string]string, error)
+	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
+	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (*clideploy.GenerateCloudFormationTemplateOutput, error)
+	templateDiffer
 }
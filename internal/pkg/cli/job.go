@@ -26,6 +26,7 @@ Jobs are tasks that are triggered by events.`,
 	cmd.AddCommand(buildJobDeleteCmd())
 	cmd.AddCommand(buildJobLogsCmd())
 	cmd.AddCommand(buildJobRunCmd())
+	cmd.AddCommand(buildJobExecutionsCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -5,6 +5,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -13,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
 
 	"github.com/spf13/cobra"
 
@@ -34,12 +37,15 @@ type deployJobOpts struct {
 	store                store
 	ws                   wsWlDirReader
 	unmarshal            func(in []byte) (manifest.WorkloadManifest, error)
-	newInterpolator      func(app, env string) interpolator
+	newInterpolator      func(app, env string, opts ...manifest.InterpolatorOption) interpolator
 	cmd                  execRunner
+	fs                   afero.Fs
 	sessProvider         *sessions.Provider
 	newJobDeployer       func() (workloadDeployer, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	sel                  wsSelector
+	prompt               prompter
+	diffWriter           io.Writer
 
 	// cached variables
 	targetApp       *config.Application
@@ -68,9 +74,12 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		ws:              ws,
 		unmarshal:       manifest.UnmarshalWorkload,
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
+		prompt:          prompter,
 		sessProvider:    sessProvider,
 		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		diffWriter:      os.Stdout,
 	}
 	opts.newJobDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -121,7 +130,7 @@ func (o *deployJobOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return validateVarFile(o.fs, o.varFile)
 }
 
 // Ask prompts the user for any required fields that are not provided.
@@ -142,11 +151,20 @@ func (o *deployJobOpts) Execute() error {
 			return err
 		}
 	}
+	interpolatorOpts, err := manifestVars{
+		env:     o.targetEnv,
+		runner:  o.cmd,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return err
+	}
 	mft, err := workloadManifest(&workloadManifestInput{
 		name:         o.name,
 		appName:      o.appName,
 		envName:      o.envName,
-		interpolator: o.newInterpolator(o.appName, o.envName),
+		interpolator: o.newInterpolator(o.appName, o.envName, interpolatorOpts...),
 		ws:           o.ws,
 		unmarshal:    o.unmarshal,
 	})
@@ -174,15 +192,25 @@ func (o *deployJobOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
 	}
+	stackConfig := deploy.StackRuntimeConfiguration{
+		ImageDigest:        uploadOut.ImageDigest,
+		EnvFileARN:         uploadOut.EnvFileARN,
+		AddonsURL:          uploadOut.AddonsURL,
+		RootUserARN:        o.rootUserARN,
+		Tags:               tags.Merge(o.targetApp.Tags, o.resourceTags),
+		CustomResourceURLs: uploadOut.CustomResourceURLs,
+	}
+	if o.showDiff {
+		contd, err := confirmWorkloadDiff(deployer, stackConfig, o.diffWriter, o.prompt)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			return nil
+		}
+	}
 	if _, err = deployer.DeployWorkload(&deploy.DeployWorkloadInput{
-		StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
-			ImageDigest:        uploadOut.ImageDigest,
-			EnvFileARN:         uploadOut.EnvFileARN,
-			AddonsURL:          uploadOut.AddonsURL,
-			RootUserARN:        o.rootUserARN,
-			Tags:               tags.Merge(o.targetApp.Tags, o.resourceTags),
-			CustomResourceURLs: uploadOut.CustomResourceURLs,
-		},
+		StackRuntimeConfiguration: stackConfig,
 		Options: deploy.Options{
 			DisableRollback: o.disableRollback,
 		},
@@ -302,7 +330,9 @@ func buildJobDeployCmd() *cobra.Command {
   Deploys a job named "report-gen" to a "test" environment.
   /code $ copilot job deploy --name report-gen --env test
   Deploys a job with additional resource tags.
-  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot job deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes to the job's stack before deploying it.
+  /code $ copilot job deploy --name report-gen --env test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobDeployOpts(vars)
 			if err != nil {
@@ -317,6 +347,8 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 
 	return cmd
 }
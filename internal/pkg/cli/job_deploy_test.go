@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -195,8 +196,10 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 	)
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		mock func(m *deployMocks)
+		inShowDiff bool
+		mock       func(m *deployMocks)
 
+		wantedDiff  string
 		wantedError error
 	}{
 		"error out if fail to read workload manifest": {
@@ -272,6 +275,50 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy job upload to environment prod-iad: some error"),
 		},
+		"do not deploy if the user declines after seeing the diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("template").Return("~ Schedule: rate(1 day) -> rate(1 hour)\n", nil)
+				m.mockPrompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(false, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+			wantedDiff: "~ Schedule: rate(1 day) -> rate(1 hour)\n",
+		},
+		"deploy after the user confirms the diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockJobName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("template").Return("~ Schedule: rate(1 day) -> rate(1 hour)\n", nil)
+				m.mockPrompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(true, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+			wantedDiff: "~ Schedule: rate(1 day) -> rate(1 hour)\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -285,22 +332,27 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
 				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompter:             mocks.NewMockprompter(ctrl),
 			}
 			tc.mock(m)
+			diffWriter := &strings.Builder{}
 
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockJobName,
-					envName: mockEnvName,
+					appName:  mockAppName,
+					name:     mockJobName,
+					envName:  mockEnvName,
+					showDiff: tc.inShowDiff,
 
 					clientConfigured: true,
 				},
-				ws: m.mockWsReader,
+				prompt:     m.mockPrompter,
+				diffWriter: diffWriter,
+				ws:         m.mockWsReader,
 				newJobDeployer: func() (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
-				newInterpolator: func(app, env string) interpolator {
+				newInterpolator: func(app, env string, _ ...manifest.InterpolatorOption) interpolator {
 					return m.mockInterpolator
 				},
 				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
@@ -318,6 +370,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 			// THEN
 			if tc.wantedError == nil {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diffWriter.String())
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -0,0 +1,391 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	jobExecutionsNamePrompt     = "Which job's executions would you like to list?"
+	jobExecutionsNameHelpPrompt = "The most recent executions of a deployed job will be listed."
+
+	fmtJobExecutionLogsPrompt     = "Which execution of job %s would you like to show the logs of?"
+	jobExecutionLogsHelpPrompt    = "The logs of the tasks started by every attempt of the execution will be shown."
+	jobExecutionLogsFinalMessage  = "Execution:"
+	jobExecutionsDefaultLimit     = 10
+	jobExecutionsMaxLimit         = 1000 // Maximum number of results returned by a single ListExecutions call.
+	jobExecutionsMissingValueMark = "-"
+	jobExecutionsMinEnvVersion    = "v1.13.0" // Least environment template version that allows listing the executions of jobs.
+
+	// Display settings of the executions table.
+	jobExecutionsMinCellWidth     = 10
+	jobExecutionsTabWidth         = 4
+	jobExecutionsCellPaddingWidth = 2
+	jobExecutionsPaddingChar      = ' '
+)
+
+type jobExecutionsVars struct {
+	appName          string
+	envName          string
+	name             string
+	limit            int
+	shouldOutputJSON bool
+	showLogs         bool
+}
+
+type jobExecutionsOpts struct {
+	jobExecutionsVars
+
+	store  store
+	sel    deploySelector
+	prompt prompter
+	w      io.Writer
+	now    func() time.Time
+
+	executions   jobExecutionLister
+	logsSvc      logEventsWriter
+	envDescriber versionCompatibilityChecker
+	initClients  func() error // Overridden in tests.
+}
+
+func newJobExecutionsOpts(vars jobExecutionsVars) (*jobExecutionsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job executions"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &jobExecutionsOpts{
+		jobExecutionsVars: vars,
+		store:             configStore,
+		sel:               selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:            prompter,
+		w:                 os.Stdout,
+		now:               time.Now,
+	}
+	opts.initClients = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.executions = ecs.New(sess)
+		opts.envDescriber, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			ConfigStore: opts.store,
+		})
+		if err != nil {
+			return fmt.Errorf("new environment compatibility checker: %v", err)
+		}
+		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
+			Sess: sess,
+			App:  opts.appName,
+			Env:  opts.envName,
+			Svc:  opts.name,
+		})
+		return err
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by flags are invalid.
+func (o *jobExecutionsOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	if o.limit < 1 || o.limit > jobExecutionsMaxLimit {
+		return fmt.Errorf("--%s %d is out-of-bounds, value must be between 1 and %d", limitFlag, o.limit, jobExecutionsMaxLimit)
+	}
+	if o.shouldOutputJSON && o.showLogs {
+		return fmt.Errorf("only one of --%s or --%s may be used", jsonFlag, logsFlag)
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobExecutionsOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(jobAppNamePrompt, svcAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	deployedJob, err := o.sel.DeployedJob(jobExecutionsNamePrompt, jobExecutionsNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// Execute lists the most recent executions of the job, and optionally writes the logs of a selected execution.
+func (o *jobExecutionsOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	if err := o.validateEnvCompatible(); err != nil {
+		return err
+	}
+	executions, err := o.executions.JobExecutions(o.appName, o.envName, o.name, o.limit)
+	if err != nil {
+		return fmt.Errorf("list executions of job %s in environment %s: %w", o.name, o.envName, err)
+	}
+	if o.shouldOutputJSON {
+		data, err := jobExecutionsJSONOutput(executions)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	if len(executions) == 0 {
+		log.Infof("No executions found for job %s in environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	fmt.Fprint(o.w, o.humanOutput(executions))
+	if !o.showLogs {
+		return nil
+	}
+	execution, err := o.selectExecution(executions)
+	if err != nil {
+		return err
+	}
+	return o.writeLogs(execution)
+}
+
+func (o *jobExecutionsOpts) validateEnvCompatible() error {
+	version, err := o.envDescriber.Version()
+	if err != nil {
+		return fmt.Errorf("retrieve version of environment stack %q in application %q: %v", o.envName, o.appName, err)
+	}
+	if semver.Compare(version, jobExecutionsMinEnvVersion) < 0 {
+		logEnvUpgradeRequired(o.appName, o.envName, jobExecutionsMinEnvVersion)
+		return fmt.Errorf("environment template version %q does not support listing the executions of jobs", version)
+	}
+	return nil
+}
+
+// RecommendActions suggests viewing the logs of an execution if they were not displayed.
+func (o *jobExecutionsOpts) RecommendActions() error {
+	if o.showLogs || o.shouldOutputJSON {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to display the logs of one of the executions.",
+			color.HighlightCode(fmt.Sprintf("copilot job executions -n %s -e %s --%s", o.name, o.envName, logsFlag))),
+	})
+	return nil
+}
+
+func (o *jobExecutionsOpts) humanOutput(executions []*ecs.JobExecution) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, jobExecutionsMinCellWidth, jobExecutionsTabWidth, jobExecutionsCellPaddingWidth, jobExecutionsPaddingChar, 0)
+	headers := []string{"Name", "Started", "Duration", "Status", "Retries", "Cause"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlineHeaders(headers), "\t"))
+	for _, execution := range executions {
+		duration, cause := jobExecutionsMissingValueMark, jobExecutionsMissingValueMark
+		if !execution.StoppedAt.IsZero() {
+			duration = execution.Duration().Round(time.Second).String()
+		}
+		if execution.Cause != "" {
+			cause = execution.Cause
+		}
+		fmt.Fprintf(writer, "%s\n", strings.Join([]string{
+			execution.Name,
+			humanize.RelTime(execution.StartedAt, o.now(), "ago", "from now"),
+			duration,
+			execution.Status,
+			strconv.Itoa(execution.Retries()),
+			cause,
+		}, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (o *jobExecutionsOpts) selectExecution(executions []*ecs.JobExecution) (*ecs.JobExecution, error) {
+	if len(executions) == 1 {
+		return executions[0], nil
+	}
+	options := make([]prompt.Option, len(executions))
+	for i, execution := range executions {
+		options[i] = prompt.Option{
+			Value: execution.Name,
+			Hint:  fmt.Sprintf("%s, started %s", strings.ToLower(execution.Status), humanize.RelTime(execution.StartedAt, o.now(), "ago", "from now")),
+		}
+	}
+	selected, err := o.prompt.SelectOption(fmt.Sprintf(fmtJobExecutionLogsPrompt, color.HighlightUserInput(o.name)),
+		jobExecutionLogsHelpPrompt, options, prompt.WithFinalMessage(jobExecutionLogsFinalMessage))
+	if err != nil {
+		return nil, fmt.Errorf("select execution: %w", err)
+	}
+	for _, execution := range executions {
+		if execution.Name == selected {
+			return execution, nil
+		}
+	}
+	return nil, fmt.Errorf("execution %s not found", selected)
+}
+
+// writeLogs writes the logs of the tasks of the execution, and follows them if the execution is still running.
+func (o *jobExecutionsOpts) writeLogs(execution *ecs.JobExecution) error {
+	if len(execution.TaskARNs) == 0 {
+		log.Infof("Execution %s of job %s did not start any task.\n", color.HighlightResource(execution.Name), color.HighlightUserInput(o.name))
+		return nil
+	}
+	taskIDs, err := taskIDsFromARNs(execution.TaskARNs)
+	if err != nil {
+		return err
+	}
+	in := logging.WriteLogEventsOpts{
+		StartTime: aws.Int64(execution.StartedAt.UnixMilli()),
+		TaskIDs:   taskIDs,
+		OnEvents:  logging.WriteHumanLogs,
+	}
+	if execution.StoppedAt.IsZero() {
+		in.Follow = true
+	} else {
+		in.EndTime = aws.Int64(execution.StoppedAt.UnixMilli())
+	}
+	if err := o.logsSvc.WriteLogEvents(in); err != nil {
+		return fmt.Errorf("write log events for execution %s of job %s: %w", execution.Name, o.name, err)
+	}
+	return nil
+}
+
+func taskIDsFromARNs(taskARNs []string) ([]string, error) {
+	ids := make([]string, len(taskARNs))
+	for i, taskARN := range taskARNs {
+		id, err := awsecs.TaskID(taskARN)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func jobExecutionsJSONOutput(executions []*ecs.JobExecution) (string, error) {
+	type executionJSON struct {
+		Name      string     `json:"name"`
+		ARN       string     `json:"arn"`
+		Status    string     `json:"status"`
+		StartedAt time.Time  `json:"startedAt"`
+		StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+		Retries   int        `json:"retries"`
+		Cause     string     `json:"cause,omitempty"`
+		TaskIDs   []string   `json:"taskIds"`
+	}
+	type serializedExecutions struct {
+		Executions []executionJSON `json:"executions"`
+	}
+	out := serializedExecutions{
+		Executions: make([]executionJSON, len(executions)),
+	}
+	for i, execution := range executions {
+		taskIDs, err := taskIDsFromARNs(execution.TaskARNs)
+		if err != nil {
+			return "", err
+		}
+		out.Executions[i] = executionJSON{
+			Name:      execution.Name,
+			ARN:       execution.ARN,
+			Status:    execution.Status,
+			StartedAt: execution.StartedAt,
+			Retries:   execution.Retries(),
+			Cause:     execution.Cause,
+			TaskIDs:   taskIDs,
+		}
+		if !execution.StoppedAt.IsZero() {
+			out.Executions[i].StoppedAt = aws.Time(execution.StoppedAt)
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("marshal executions: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildJobExecutionsCmd builds the command for listing the executions of a job.
+func buildJobExecutionsCmd() *cobra.Command {
+	vars := jobExecutionsVars{}
+	cmd := &cobra.Command{
+		Use:   "executions",
+		Short: "Lists the most recent executions of a deployed job.",
+		Long: `Lists the most recent executions of a deployed job, with their start time, duration, status, retries and failure cause.
+Use --logs to select one of the executions and display the logs of its tasks.`,
+		Example: `
+  Lists the last 10 executions of the job "report-gen" in the "prod" environment.
+  /code $ copilot job executions -n report-gen -e prod
+  Lists the last 50 executions in JSON format.
+  /code $ copilot job executions -n report-gen -e prod --limit 50 --json
+  Selects one of the executions and displays the logs of its tasks.
+  /code $ copilot job executions -n report-gen -e prod --logs`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobExecutionsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, jobExecutionsDefaultLimit, jobExecutionsLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.showLogs, logsFlag, false, jobExecutionsLogsFlagDescription)
+	return cmd
+}
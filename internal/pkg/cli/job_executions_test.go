@@ -0,0 +1,336 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobExecutions_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inLimit    int
+		inJSON     bool
+		inShowLogs bool
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"valid flags": {
+			inLimit: 10,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{}, nil)
+				m.EXPECT().GetJob("my-app", "report-gen").Return(&config.Workload{}, nil)
+			},
+		},
+		"returns an error if the job does not exist": {
+			inLimit: 10,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "prod").Return(&config.Environment{}, nil)
+				m.EXPECT().GetJob("my-app", "report-gen").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns an error if the limit is out of bounds": {
+			inLimit: 1001,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication(gomock.Any()).Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Return(&config.Environment{}, nil)
+				m.EXPECT().GetJob(gomock.Any(), gomock.Any()).Return(&config.Workload{}, nil)
+			},
+			wantedError: errors.New("--limit 1001 is out-of-bounds, value must be between 1 and 1000"),
+		},
+		"returns an error if both json and logs are set": {
+			inLimit:    10,
+			inJSON:     true,
+			inShowLogs: true,
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication(gomock.Any()).Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Return(&config.Environment{}, nil)
+				m.EXPECT().GetJob(gomock.Any(), gomock.Any()).Return(&config.Workload{}, nil)
+			},
+			wantedError: errors.New("only one of --json or --logs may be used"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.setupMocks(mockStore)
+			opts := &jobExecutionsOpts{
+				jobExecutionsVars: jobExecutionsVars{
+					appName:          "my-app",
+					envName:          "prod",
+					name:             "report-gen",
+					limit:            tc.inLimit,
+					shouldOutputJSON: tc.inJSON,
+					showLogs:         tc.inShowLogs,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestJobExecutions_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp   string
+		mockSel func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"prompt for app, job and env": {
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().DeployedJob(jobExecutionsNamePrompt, jobExecutionsNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "prod",
+						Name: "report-gen",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "prod",
+			wantedJob: "report-gen",
+		},
+		"returns error if fail to select deployed job": {
+			inApp: "my-app",
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobExecutionsNamePrompt, jobExecutionsNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed jobs for application my-app: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSel(mockSel)
+			opts := &jobExecutionsOpts{
+				jobExecutionsVars: jobExecutionsVars{
+					appName: tc.inApp,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedJob, opts.name)
+		})
+	}
+}
+
+type jobExecutionsMocks struct {
+	executions   *mocks.MockjobExecutionLister
+	logs         *mocks.MocklogEventsWriter
+	prompt       *mocks.Mockprompter
+	envDescriber *mocks.MockversionCompatibilityChecker
+}
+
+func TestJobExecutions_Execute(t *testing.T) {
+	now := time.Date(2022, 10, 2, 2, 0, 0, 0, time.UTC)
+	failed := &ecs.JobExecution{
+		Name:      "1b2c3d4",
+		ARN:       "arn:aws:states:us-west-2:123456789012:execution:my-app-prod-report-gen:1b2c3d4",
+		Status:    "FAILED",
+		StartedAt: now.Add(-24 * time.Hour),
+		StoppedAt: now.Add(-24*time.Hour + 90*time.Second),
+		Attempts:  3,
+		Cause:     "Essential container in task exited",
+		TaskARNs: []string{
+			"arn:aws:ecs:us-west-2:123456789012:task/my-app-prod/4f8243e83f8a4bdaa7587fa1eaff2ea3",
+			"arn:aws:ecs:us-west-2:123456789012:task/my-app-prod/709c7eae05f947f6861b150372ddc443",
+		},
+	}
+	running := &ecs.JobExecution{
+		Name:      "5e6f7a8",
+		ARN:       "arn:aws:states:us-west-2:123456789012:execution:my-app-prod-report-gen:5e6f7a8",
+		Status:    "RUNNING",
+		StartedAt: now.Add(-time.Minute),
+		Attempts:  1,
+		TaskARNs: []string{
+			"arn:aws:ecs:us-west-2:123456789012:task/my-app-prod/1de57fd63c6a4920ac416d02add891b9",
+		},
+	}
+	testCases := map[string]struct {
+		inJSON          bool
+		inShowLogs      bool
+		inEnvVersion    string
+		inEnvVersionErr error
+		setupMocks      func(m jobExecutionsMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"returns an error if the environment template version is below v1.13.0": {
+			inEnvVersion: "v1.12.0",
+			setupMocks:   func(m jobExecutionsMocks) {},
+			wantedError:  errors.New(`environment template version "v1.12.0" does not support listing the executions of jobs`),
+		},
+		"returns the error from retrieving the environment template version": {
+			inEnvVersionErr: errors.New("some error"),
+			setupMocks:      func(m jobExecutionsMocks) {},
+			wantedError:     errors.New(`retrieve version of environment stack "prod" in application "my-app": some error`),
+		},
+		"returns the error from listing the executions": {
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of job report-gen in environment prod: some error"),
+		},
+		"writes the executions in a table": {
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{running, failed}, nil)
+			},
+			wantedContent: `Name      Started       Duration  Status    Retries   Cause
+----      -------       --------  ------    -------   -----
+5e6f7a8   1 minute ago  -         RUNNING   0         -
+1b2c3d4   1 day ago     1m30s     FAILED    2         Essential container in task exited
+`,
+		},
+		"writes the executions in json": {
+			inJSON: true,
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{running, failed}, nil)
+			},
+			wantedContent: `{"executions":[{"name":"5e6f7a8","arn":"arn:aws:states:us-west-2:123456789012:execution:my-app-prod-report-gen:5e6f7a8","status":"RUNNING","startedAt":"2022-10-02T01:59:00Z","retries":0,"taskIds":["1de57fd63c6a4920ac416d02add891b9"]},{"name":"1b2c3d4","arn":"arn:aws:states:us-west-2:123456789012:execution:my-app-prod-report-gen:1b2c3d4","status":"FAILED","startedAt":"2022-10-01T02:00:00Z","stoppedAt":"2022-10-01T02:01:30Z","retries":2,"cause":"Essential container in task exited","taskIds":["4f8243e83f8a4bdaa7587fa1eaff2ea3","709c7eae05f947f6861b150372ddc443"]}]}
+`,
+		},
+		"writes the logs of the tasks of the selected execution": {
+			inShowLogs: true,
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{running, failed}, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Len(2), gomock.Any()).Return("1b2c3d4", nil)
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(in logging.WriteLogEventsOpts) error {
+					require.False(t, in.Follow)
+					require.Equal(t, aws.Int64(failed.StartedAt.UnixMilli()), in.StartTime)
+					require.Equal(t, aws.Int64(failed.StoppedAt.UnixMilli()), in.EndTime)
+					require.Equal(t, []string{"4f8243e83f8a4bdaa7587fa1eaff2ea3", "709c7eae05f947f6861b150372ddc443"}, in.TaskIDs)
+					return nil
+				})
+			},
+		},
+		"follows the logs of a running execution": {
+			inShowLogs: true,
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{running}, nil)
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(in logging.WriteLogEventsOpts) error {
+					require.True(t, in.Follow)
+					require.Nil(t, in.EndTime)
+					require.Equal(t, []string{"1de57fd63c6a4920ac416d02add891b9"}, in.TaskIDs)
+					return nil
+				})
+			},
+		},
+		"returns the error from selecting an execution": {
+			inShowLogs: true,
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{running, failed}, nil)
+				m.prompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select execution: some error"),
+		},
+		"returns the error from writing the logs": {
+			inShowLogs: true,
+			setupMocks: func(m jobExecutionsMocks) {
+				m.executions.EXPECT().JobExecutions("my-app", "prod", "report-gen", 10).Return([]*ecs.JobExecution{failed}, nil)
+				m.logs.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("write log events for execution 1b2c3d4 of job report-gen: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobExecutionsMocks{
+				executions: mocks.NewMockjobExecutionLister(ctrl),
+				logs:       mocks.NewMocklogEventsWriter(ctrl),
+				prompt:     mocks.NewMockprompter(ctrl),
+
+				envDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+			}
+			if tc.inEnvVersion == "" {
+				tc.inEnvVersion = "v1.13.0"
+			}
+			m.envDescriber.EXPECT().Version().Return(tc.inEnvVersion, tc.inEnvVersionErr)
+			tc.setupMocks(m)
+			b := &strings.Builder{}
+			opts := &jobExecutionsOpts{
+				jobExecutionsVars: jobExecutionsVars{
+					appName:          "my-app",
+					envName:          "prod",
+					name:             "report-gen",
+					limit:            10,
+					shouldOutputJSON: tc.inJSON,
+					showLogs:         tc.inShowLogs,
+				},
+				prompt: m.prompt,
+				w:      b,
+				now: func() time.Time {
+					return now
+				},
+				initClients: func() error {
+					return nil
+				},
+				executions:   m.executions,
+				logsSvc:      m.logs,
+				envDescriber: m.envDescriber,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedContent != "" {
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -22,13 +23,15 @@ import (
 )
 
 const (
-	jobAppNamePrompt = "Which application does your job belong to?"
+	jobAppNamePrompt     = "Which application does your job belong to?"
+	jobLogNamePrompt     = "Which job's logs would you like to show?"
+	jobLogNameHelpPrompt = "The logs of a deployed job will be shown."
 )
 
 type jobLogsVars struct {
 	wkldLogsVars
 
-	includeStateMachineLogs bool // Whether to include the logs from the state machine log streams
+	includeStateMachineLogs bool // Whether to include the logs from the state machine log streams.
 }
 
 type jobLogsOpts struct {
@@ -72,6 +75,9 @@ func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
 			App:  opts.appName,
 			Env:  opts.envName,
 			Svc:  opts.name,
+
+			WkldType:                manifest.ScheduledJobType,
+			IncludeStateMachineLogs: opts.includeStateMachineLogs,
 		})
 		if err != nil {
 			return err
@@ -135,7 +141,7 @@ func (o *jobLogsOpts) Validate() error {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
 
-	return nil
+	return o.validateStructuredLogFlags()
 }
 
 // Ask asks for fields that are required but not passed in.
@@ -143,7 +149,7 @@ func (o *jobLogsOpts) Ask() error {
 	if err := o.askApp(); err != nil {
 		return err
 	}
-	return nil
+	return o.askJobEnvName()
 }
 
 func (o *jobLogsOpts) askApp() error {
@@ -158,8 +164,36 @@ func (o *jobLogsOpts) askApp() error {
 	return nil
 }
 
+func (o *jobLogsOpts) askJobEnvName() error {
+	deployedJob, err := o.sel.DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
 // Execute outputs logs of the job.
 func (o *jobLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
+		Follow:    o.follow,
+		Limit:     limit,
+		EndTime:   o.endTime,
+		StartTime: o.startTime,
+		TaskIDs:   o.taskIDs,
+		OnEvents:  o.eventsWriter(),
+	})
+	if err != nil {
+		return fmt.Errorf("write log events for job %s: %w", o.name, err)
+	}
 	return nil
 }
 
@@ -167,9 +201,8 @@ func (o *jobLogsOpts) Execute() error {
 func buildJobLogsCmd() *cobra.Command {
 	vars := jobLogsVars{}
 	cmd := &cobra.Command{
-		Use:    "logs",
-		Short:  "Displays logs of a deployed job.",
-		Hidden: true,
+		Use:   "logs",
+		Short: "Displays logs of a deployed job.",
 		Example: `
   Displays logs of the job "my-job" in environment "test".
   /code $ copilot job logs -n my-job -e test
@@ -181,8 +214,10 @@ Displays logs from specific task IDs.
   /code $ copilot job logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot job logs --follow
-  Displays container logs and state machine execution logs from the last execution.
-  /code $ copilot job logs --include-state-machine`,
+  Displays container logs and state machine execution logs.
+  /code $ copilot job logs --include-state-machine
+  Displays the level and message of JSON logs at or above the "error" level.
+  /code $ copilot job logs --output-fields level,msg --level error`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobLogOpts(vars)
 			if err != nil {
@@ -202,5 +237,7 @@ Displays logs from specific task IDs.
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().BoolVar(&vars.includeStateMachineLogs, includeStateMachineLogsFlag, false, includeStateMachineLogsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.outputFields, outputFieldsFlag, nil, outputFieldsFlagDescription)
+	cmd.Flags().StringVar(&vars.level, levelFlag, "", levelFlagDescription)
 	return cmd
 }
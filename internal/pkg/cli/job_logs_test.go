@@ -11,6 +11,8 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,9 @@ func TestJobLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputLevel     string
+		inputFields    []string
+		inputJSON      bool
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -127,6 +132,21 @@ func TestJobLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
 		},
+		"returns error if level is invalid": {
+			inputLevel: "verbose",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf(`invalid --level verbose: must be one of "trace", "debug", "info", "warn", "error", "fatal"`),
+		},
+		"returns error if both output fields and json flags are set": {
+			inputFields: []string{"level", "msg"},
+			inputJSON:   true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --output-fields or --json may be used"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,14 +160,17 @@ func TestJobLogs_Validate(t *testing.T) {
 			jobLogs := &jobLogsOpts{
 				jobLogsVars: jobLogsVars{
 					wkldLogsVars: wkldLogsVars{
-						follow:         tc.inputFollow,
-						limit:          tc.inputLimit,
-						envName:        tc.inputEnvName,
-						humanStartTime: tc.inputStartTime,
-						humanEndTime:   tc.inputEndTime,
-						since:          tc.inputSince,
-						name:           tc.inputSvc,
-						appName:        tc.inputApp,
+						follow:           tc.inputFollow,
+						limit:            tc.inputLimit,
+						envName:          tc.inputEnvName,
+						humanStartTime:   tc.inputStartTime,
+						humanEndTime:     tc.inputEndTime,
+						since:            tc.inputSince,
+						name:             tc.inputSvc,
+						appName:          tc.inputApp,
+						level:            tc.inputLevel,
+						outputFields:     tc.inputFields,
+						shouldOutputJSON: tc.inputJSON,
 					},
 				},
 				wkldLogOpts: wkldLogOpts{
@@ -167,3 +190,135 @@ func TestJobLogs_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestJobLogs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		mockSel func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedJob   string
+		wantedError error
+	}{
+		"prompt for app, job and env": {
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "resizer",
+					}, nil)
+			},
+			wantedApp: "my-app",
+			wantedEnv: "test",
+			wantedJob: "resizer",
+		},
+		"returns error if fail to select deployed job": {
+			inputApp: "my-app",
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("select deployed jobs for application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSel(mockSel)
+
+			jobLogs := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						appName: tc.inputApp,
+						envName: tc.inputEnv,
+						name:    tc.inputJob,
+					},
+				},
+				wkldLogOpts: wkldLogOpts{
+					sel: mockSel,
+				},
+			}
+
+			// WHEN
+			err := jobLogs.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, jobLogs.appName)
+				require.Equal(t, tc.wantedEnv, jobLogs.envName)
+				require.Equal(t, tc.wantedJob, jobLogs.name)
+			}
+		})
+	}
+}
+
+func TestJobLogs_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
+
+		wantedError error
+	}{
+		"success": {
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.True(t, param.Follow)
+					require.NotNil(t, param.OnEvents)
+				}).Return(nil)
+				return m
+			},
+		},
+		"returns error if fail to get event logs": {
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedError: fmt.Errorf("write log events for job resizer: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			jobLogs := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						name:   "resizer",
+						follow: true,
+						level:  "error",
+					},
+				},
+				wkldLogOpts: wkldLogOpts{
+					initLogsSvc: func() error { return nil },
+					logsSvc:     tc.mocklogsSvc(ctrl),
+				},
+			}
+
+			// WHEN
+			err := jobLogs.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
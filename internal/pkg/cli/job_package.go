@@ -35,6 +35,7 @@ type packageJobVars struct {
 	tag          string
 	outputDir    string
 	uploadAssets bool
+	varFile      string
 }
 
 type packageJobOpts struct {
@@ -83,6 +84,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				tag:          imageTagFromGit(o.runner, o.tag),
 				outputDir:    o.outputDir,
 				uploadAssets: o.uploadAssets,
+				varFile:      o.varFile,
 			},
 			runner:           o.runner,
 			initAddonsClient: initPackageAddonsClient,
@@ -200,5 +202,6 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 	return cmd
 }
@@ -15,7 +15,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -24,10 +27,16 @@ import (
 	"golang.org/x/mod/semver"
 )
 
+const (
+	jobRunMinEnvVersion       = "v1.12.0" // Least environment template version that allows running jobs.
+	jobRunFollowMinEnvVersion = "v1.13.0" // Least environment template version that allows following the execution of jobs.
+)
+
 type jobRunVars struct {
 	appName string
 	envName string
 	jobName string
+	follow  bool
 }
 
 type jobRunOpts struct {
@@ -76,6 +85,12 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 
 			CFN:          cloudformation.New(sess),
 			StateMachine: stepfunctions.New(sess),
+
+			Execution: stepfunctions.New(sess),
+			NewLogWriter: func(tasks []*task.Task) jobrunner.LogWriter {
+				return logging.NewJobTaskClient(sess, opts.appName, opts.envName, opts.jobName, tasks)
+			},
+			ExitCodes: ecs.New(sess),
 		}), nil
 	}
 	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
@@ -121,10 +136,18 @@ func (o *jobRunOpts) Execute() error {
 	if err != nil {
 		return err
 	}
-	if err := runner.Run(); err != nil {
+	if !o.follow {
+		if err := runner.Run(); err != nil {
+			return fmt.Errorf("execute job %q: %w", o.jobName, err)
+		}
+		log.Successf("Invoked job %q successfully\n", o.jobName)
+		return nil
+	}
+	log.Infof("Running job %q and streaming its logs.\n", o.jobName)
+	if err := runner.RunAndFollow(); err != nil {
 		return fmt.Errorf("execute job %q: %w", o.jobName, err)
 	}
-	log.Successf("Invoked job %q successfully\n", o.jobName)
+	log.Successf("Job %q finished successfully\n", o.jobName)
 	return nil
 }
 
@@ -202,16 +225,25 @@ func (o *jobRunOpts) validateEnvCompatible() error {
 	if err != nil {
 		return fmt.Errorf("retrieve version of environment stack %q in application %q: %v", o.envName, o.appName, err)
 	}
-	if semver.Compare(version, "v1.12.0") < 0 {
-		log.Errorf(`The %q environment template must be at least on v1.12.0.
-Please run %s to upgrade the template to the latest version.
-`,
-			o.envName, color.HighlightCode(fmt.Sprintf("copilot env deploy --app %s --name %s", o.appName, o.envName)))
+	if semver.Compare(version, jobRunMinEnvVersion) < 0 {
+		logEnvUpgradeRequired(o.appName, o.envName, jobRunMinEnvVersion)
 		return fmt.Errorf("environment template version %q does not support running jobs", version)
 	}
+	if o.follow && semver.Compare(version, jobRunFollowMinEnvVersion) < 0 {
+		logEnvUpgradeRequired(o.appName, o.envName, jobRunFollowMinEnvVersion)
+		return fmt.Errorf("environment template version %q does not support following jobs", version)
+	}
 	return nil
 }
 
+// logEnvUpgradeRequired logs that the environment template must be upgraded to at least minVersion.
+func logEnvUpgradeRequired(app, env, minVersion string) {
+	log.Errorf(`The %q environment template must be at least on %s.
+Please run %s to upgrade the template to the latest version.
+`,
+		env, minVersion, color.HighlightCode(fmt.Sprintf("copilot env deploy --app %s --name %s", app, env)))
+}
+
 func buildJobRunCmd() *cobra.Command {
 	vars := jobRunVars{}
 
@@ -221,7 +253,9 @@ func buildJobRunCmd() *cobra.Command {
 		Long:  "Invoke a job in an environment.",
 		Example: `
   Run a job named "report-gen" in an application named "report" within a "test" environment
-  /code $ copilot job run -a report -n report-gen -e test`,
+  /code $ copilot job run -a report -n report-gen -e test
+  Run a "migrate" job, stream its logs and wait for it to finish
+  /code $ copilot job run -n migrate -e test --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobRunOpts(vars)
 			if err != nil {
@@ -233,5 +267,6 @@ func buildJobRunCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, jobRunFollowFlagDescription)
 	return cmd
 }
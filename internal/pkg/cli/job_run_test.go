@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -162,6 +163,7 @@ func TestJobRun_Execute(t *testing.T) {
 		appName        string
 		envName        string
 		jobName        string
+		follow         bool
 		mockjobRunner  func(ctrl *gomock.Controller) runner
 		mockEnvChecker func(ctrl *gomock.Controller) versionCompatibilityChecker
 		wantedError    error
@@ -193,6 +195,35 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`execute job "mockJob": some error`),
 		},
+		"waits for the job to finish if following": {
+			jobName: "mockJob",
+			follow:  true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().RunAndFollow().Return(nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.13.0", nil)
+				return m
+			},
+		},
+		"should return the exit code of the job if it fails while following": {
+			jobName: "mockJob",
+			follow:  true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				m := mocks.NewMockrunner(ctrl)
+				m.EXPECT().RunAndFollow().Return(&ecs.ErrExitCode{})
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.13.0", nil)
+				return m
+			},
+			wantedError: fmt.Errorf(`execute job "mockJob": %w`, &ecs.ErrExitCode{}),
+		},
 		"should return a wrapped error when environment version cannot be retrieved": {
 			appName: "finance",
 			envName: "test",
@@ -221,6 +252,21 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: errors.New(`environment template version "v1.11.0" does not support running jobs`),
 		},
+		"should return an error when following a job and environment template version is below v1.13.0": {
+			appName: "finance",
+			envName: "test",
+			jobName: "report",
+			follow:  true,
+			mockjobRunner: func(ctrl *gomock.Controller) runner {
+				return nil
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.12.0", nil)
+				return m
+			},
+			wantedError: errors.New(`environment template version "v1.12.0" does not support following jobs`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -233,6 +279,7 @@ func TestJobRun_Execute(t *testing.T) {
 					appName: tc.appName,
 					envName: tc.envName,
 					jobName: tc.jobName,
+					follow:  tc.follow,
 				},
 				newRunner: func() (runner, error) {
 					return tc.mockjobRunner(ctrl), nil
@@ -247,6 +294,8 @@ func TestJobRun_Execute(t *testing.T) {
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
+				var errExitCode *ecs.ErrExitCode
+				require.Equal(t, errors.As(tc.wantedError, &errExitCode), errors.As(err, &errExitCode))
 			} else {
 				require.NoError(t, err)
 			}
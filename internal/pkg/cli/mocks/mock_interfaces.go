@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	encoding "encoding"
 	io "io"
 	reflect "reflect"
@@ -2656,6 +2657,59 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
+// MockwsWlReader is a mock of wsWlReader interface.
+type MockwsWlReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlReaderMockRecorder
+}
+
+// MockwsWlReaderMockRecorder is the mock recorder for MockwsWlReader.
+type MockwsWlReaderMockRecorder struct {
+	mock *MockwsWlReader
+}
+
+// NewMockwsWlReader creates a new mock instance.
+func NewMockwsWlReader(ctrl *gomock.Controller) *MockwsWlReader {
+	mock := &MockwsWlReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWlReader) EXPECT() *MockwsWlReaderMockRecorder {
+	return m.recorder
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsWlReader) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsWlReaderMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsWlReader)(nil).ListWorkloads))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWlReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWlReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWlReader)(nil).ReadWorkloadManifest), name)
+}
+
 // MockwsJobDirReader is a mock of wsJobDirReader interface.
 type MockwsJobDirReader struct {
 	ctrl     *gomock.Controller
@@ -3131,6 +3185,21 @@ func (mr *MockwsAddonManagerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsAddonManager)(nil).ListWorkloads))
 }
 
+// OverwriteWorkloadManifest mocks base method.
+func (m *MockwsAddonManager) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OverwriteWorkloadManifest", data, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OverwriteWorkloadManifest indicates an expected call of OverwriteWorkloadManifest.
+func (mr *MockwsAddonManagerMockRecorder) OverwriteWorkloadManifest(data, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OverwriteWorkloadManifest", reflect.TypeOf((*MockwsAddonManager)(nil).OverwriteWorkloadManifest), data, name)
+}
+
 // ReadWorkloadManifest mocks base method.
 func (m *MockwsAddonManager) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
 	m.ctrl.T.Helper()
@@ -3185,18 +3254,23 @@ func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
 }
 
 // Upload mocks base method.
-func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
+func (m *Mockuploader) Upload(bucket, key string, data io.Reader, opts ...s3.UploadOption) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	varargs := []interface{}{bucket, key, data}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Upload", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Upload indicates an expected call of Upload.
-func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
+	varargs := append([]interface{}{bucket, key, data}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), varargs...)
 }
 
 // ZipAndUpload mocks base method.
@@ -3294,6 +3368,44 @@ func (mr *MockbucketEmptierMockRecorder) EmptyBucket(bucket interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyBucket", reflect.TypeOf((*MockbucketEmptier)(nil).EmptyBucket), bucket)
 }
 
+// MockstackDescriber is a mock of stackDescriber interface.
+type MockstackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDescriberMockRecorder
+}
+
+// MockstackDescriberMockRecorder is the mock recorder for MockstackDescriber.
+type MockstackDescriberMockRecorder struct {
+	mock *MockstackDescriber
+}
+
+// NewMockstackDescriber creates a new mock instance.
+func NewMockstackDescriber(ctrl *gomock.Controller) *MockstackDescriber {
+	mock := &MockstackDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDescriber) EXPECT() *MockstackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackDescriber)(nil).Describe), name)
+}
+
 // MockenvironmentDeployer is a mock of environmentDeployer interface.
 type MockenvironmentDeployer struct {
 	ctrl     *gomock.Controller
@@ -4466,6 +4578,82 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
+// MockdeployedManifestGetter is a mock of deployedManifestGetter interface.
+type MockdeployedManifestGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedManifestGetterMockRecorder
+}
+
+// MockdeployedManifestGetterMockRecorder is the mock recorder for MockdeployedManifestGetter.
+type MockdeployedManifestGetterMockRecorder struct {
+	mock *MockdeployedManifestGetter
+}
+
+// NewMockdeployedManifestGetter creates a new mock instance.
+func NewMockdeployedManifestGetter(ctrl *gomock.Controller) *MockdeployedManifestGetter {
+	mock := &MockdeployedManifestGetter{ctrl: ctrl}
+	mock.recorder = &MockdeployedManifestGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedManifestGetter) EXPECT() *MockdeployedManifestGetterMockRecorder {
+	return m.recorder
+}
+
+// Manifest mocks base method.
+func (m *MockdeployedManifestGetter) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockdeployedManifestGetterMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockdeployedManifestGetter)(nil).Manifest))
+}
+
+// MockstackDriftDetector is a mock of stackDriftDetector interface.
+type MockstackDriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDetectorMockRecorder
+}
+
+// MockstackDriftDetectorMockRecorder is the mock recorder for MockstackDriftDetector.
+type MockstackDriftDetectorMockRecorder struct {
+	mock *MockstackDriftDetector
+}
+
+// NewMockstackDriftDetector creates a new mock instance.
+func NewMockstackDriftDetector(ctrl *gomock.Controller) *MockstackDriftDetector {
+	mock := &MockstackDriftDetector{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDetector) EXPECT() *MockstackDriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// StackDrift mocks base method.
+func (m *MockstackDriftDetector) StackDrift(ctx context.Context, stackName string) ([]*cloudformation.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackDrift", ctx, stackName)
+	ret0, _ := ret[0].([]*cloudformation.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackDrift indicates an expected call of StackDrift.
+func (mr *MockstackDriftDetectorMockRecorder) StackDrift(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackDrift", reflect.TypeOf((*MockstackDriftDetector)(nil).StackDrift), ctx, stackName)
+}
+
 // MockenvDescriber is a mock of envDescriber interface.
 type MockenvDescriber struct {
 	ctrl     *gomock.Controller
@@ -4587,6 +4775,44 @@ func (mr *MockversionCompatibilityCheckerMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).Version))
 }
 
+// MockappStatusDescriber is a mock of appStatusDescriber interface.
+type MockappStatusDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappStatusDescriberMockRecorder
+}
+
+// MockappStatusDescriberMockRecorder is the mock recorder for MockappStatusDescriber.
+type MockappStatusDescriberMockRecorder struct {
+	mock *MockappStatusDescriber
+}
+
+// NewMockappStatusDescriber creates a new mock instance.
+func NewMockappStatusDescriber(ctrl *gomock.Controller) *MockappStatusDescriber {
+	mock := &MockappStatusDescriber{ctrl: ctrl}
+	mock.recorder = &MockappStatusDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappStatusDescriber) EXPECT() *MockappStatusDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockappStatusDescriber) Describe() (*describe.AppStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.AppStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockappStatusDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockappStatusDescriber)(nil).Describe))
+}
+
 // MockversionGetter is a mock of versionGetter interface.
 type MockversionGetter struct {
 	ctrl     *gomock.Controller
@@ -6170,6 +6396,94 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
+// MockssmPortForwarder is a mock of ssmPortForwarder interface.
+type MockssmPortForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPortForwarderMockRecorder
+}
+
+// MockssmPortForwarderMockRecorder is the mock recorder for MockssmPortForwarder.
+type MockssmPortForwarderMockRecorder struct {
+	mock *MockssmPortForwarder
+}
+
+// NewMockssmPortForwarder creates a new mock instance.
+func NewMockssmPortForwarder(ctrl *gomock.Controller) *MockssmPortForwarder {
+	mock := &MockssmPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockssmPortForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPortForwarder) EXPECT() *MockssmPortForwarderMockRecorder {
+	return m.recorder
+}
+
+// PortForward mocks base method.
+func (m *MockssmPortForwarder) PortForward(in ssm.PortForwardInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForward", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PortForward indicates an expected call of PortForward.
+func (mr *MockssmPortForwarderMockRecorder) PortForward(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockssmPortForwarder)(nil).PortForward), in)
+}
+
+// MockecsFileCopier is a mock of ecsFileCopier interface.
+type MockecsFileCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsFileCopierMockRecorder
+}
+
+// MockecsFileCopierMockRecorder is the mock recorder for MockecsFileCopier.
+type MockecsFileCopierMockRecorder struct {
+	mock *MockecsFileCopier
+}
+
+// NewMockecsFileCopier creates a new mock instance.
+func NewMockecsFileCopier(ctrl *gomock.Controller) *MockecsFileCopier {
+	mock := &MockecsFileCopier{ctrl: ctrl}
+	mock.recorder = &MockecsFileCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsFileCopier) EXPECT() *MockecsFileCopierMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockecsFileCopier) Download(src, dst string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", src, dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockecsFileCopierMockRecorder) Download(src, dst interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockecsFileCopier)(nil).Download), src, dst)
+}
+
+// Upload mocks base method.
+func (m *MockecsFileCopier) Upload(src, dst string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", src, dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockecsFileCopierMockRecorder) Upload(src, dst interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockecsFileCopier)(nil).Upload), src, dst)
+}
+
 // MockssmPluginManager is a mock of ssmPluginManager interface.
 type MockssmPluginManager struct {
 	ctrl     *gomock.Controller
@@ -6713,6 +7027,81 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretLister is a mock of secretLister interface.
+type MocksecretLister struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretListerMockRecorder
+}
+
+// MocksecretListerMockRecorder is the mock recorder for MocksecretLister.
+type MocksecretListerMockRecorder struct {
+	mock *MocksecretLister
+}
+
+// NewMocksecretLister creates a new mock instance.
+func NewMocksecretLister(ctrl *gomock.Controller) *MocksecretLister {
+	mock := &MocksecretLister{ctrl: ctrl}
+	mock.recorder = &MocksecretListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretLister) EXPECT() *MocksecretListerMockRecorder {
+	return m.recorder
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretLister) ListSecrets(tags map[string]string) ([]ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretListerMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretLister)(nil).ListSecrets), tags)
+}
+
+// MockssmSecretDeleter is a mock of ssmSecretDeleter interface.
+type MockssmSecretDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSecretDeleterMockRecorder
+}
+
+// MockssmSecretDeleterMockRecorder is the mock recorder for MockssmSecretDeleter.
+type MockssmSecretDeleterMockRecorder struct {
+	mock *MockssmSecretDeleter
+}
+
+// NewMockssmSecretDeleter creates a new mock instance.
+func NewMockssmSecretDeleter(ctrl *gomock.Controller) *MockssmSecretDeleter {
+	mock := &MockssmSecretDeleter{ctrl: ctrl}
+	mock.recorder = &MockssmSecretDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmSecretDeleter) EXPECT() *MockssmSecretDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MockssmSecretDeleter) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockssmSecretDeleterMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockssmSecretDeleter)(nil).DeleteSecret), name)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -6750,6 +7139,285 @@ func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
+// MocktaskDefRevisionDescriber is a mock of taskDefRevisionDescriber interface.
+type MocktaskDefRevisionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefRevisionDescriberMockRecorder
+}
+
+// MocktaskDefRevisionDescriberMockRecorder is the mock recorder for MocktaskDefRevisionDescriber.
+type MocktaskDefRevisionDescriberMockRecorder struct {
+	mock *MocktaskDefRevisionDescriber
+}
+
+// NewMocktaskDefRevisionDescriber creates a new mock instance.
+func NewMocktaskDefRevisionDescriber(ctrl *gomock.Controller) *MocktaskDefRevisionDescriber {
+	mock := &MocktaskDefRevisionDescriber{ctrl: ctrl}
+	mock.recorder = &MocktaskDefRevisionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefRevisionDescriber) EXPECT() *MocktaskDefRevisionDescriberMockRecorder {
+	return m.recorder
+}
+
+// TaskDefinition mocks base method.
+func (m *MocktaskDefRevisionDescriber) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktaskDefRevisionDescriberMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefRevisionDescriber)(nil).TaskDefinition), taskDefName)
+}
+
+// TaskDefinitionRevisions mocks base method.
+func (m *MocktaskDefRevisionDescriber) TaskDefinitionRevisions(family string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinitionRevisions", family)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinitionRevisions indicates an expected call of TaskDefinitionRevisions.
+func (mr *MocktaskDefRevisionDescriberMockRecorder) TaskDefinitionRevisions(family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinitionRevisions", reflect.TypeOf((*MocktaskDefRevisionDescriber)(nil).TaskDefinitionRevisions), family)
+}
+
+// MocktemplateDownloader is a mock of templateDownloader interface.
+type MocktemplateDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateDownloaderMockRecorder
+}
+
+// MocktemplateDownloaderMockRecorder is the mock recorder for MocktemplateDownloader.
+type MocktemplateDownloaderMockRecorder struct {
+	mock *MocktemplateDownloader
+}
+
+// NewMocktemplateDownloader creates a new mock instance.
+func NewMocktemplateDownloader(ctrl *gomock.Controller) *MocktemplateDownloader {
+	mock := &MocktemplateDownloader{ctrl: ctrl}
+	mock.recorder = &MocktemplateDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateDownloader) EXPECT() *MocktemplateDownloaderMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MocktemplateDownloader) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MocktemplateDownloaderMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MocktemplateDownloader)(nil).Download), bucket, key)
+}
+
+// ListObjects mocks base method.
+func (m *MocktemplateDownloader) ListObjects(bucket, prefix string) ([]s3.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", bucket, prefix)
+	ret0, _ := ret[0].([]s3.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MocktemplateDownloaderMockRecorder) ListObjects(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MocktemplateDownloader)(nil).ListObjects), bucket, prefix)
+}
+
+// MockserviceRollbacker is a mock of serviceRollbacker interface.
+type MockserviceRollbacker struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceRollbackerMockRecorder
+}
+
+// MockserviceRollbackerMockRecorder is the mock recorder for MockserviceRollbacker.
+type MockserviceRollbackerMockRecorder struct {
+	mock *MockserviceRollbacker
+}
+
+// NewMockserviceRollbacker creates a new mock instance.
+func NewMockserviceRollbacker(ctrl *gomock.Controller) *MockserviceRollbacker {
+	mock := &MockserviceRollbacker{ctrl: ctrl}
+	mock.recorder = &MockserviceRollbackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceRollbacker) EXPECT() *MockserviceRollbackerMockRecorder {
+	return m.recorder
+}
+
+// RollbackService mocks base method.
+func (m *MockserviceRollbacker) RollbackService(out progress.FileWriter, in *cloudformation0.RollbackServiceInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackService", out, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RollbackService indicates an expected call of RollbackService.
+func (mr *MockserviceRollbackerMockRecorder) RollbackService(out, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackService", reflect.TypeOf((*MockserviceRollbacker)(nil).RollbackService), out, in)
+}
+
+// MocklocalContainerRunner is a mock of localContainerRunner interface.
+type MocklocalContainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerRunnerMockRecorder
+}
+
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner.
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance.
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MocklocalContainerRunner) Build(args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MocklocalContainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), args)
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MocklocalContainerRunner) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MocklocalContainerRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MocklocalContainerRunner)(nil).CheckDockerEngineRunning))
+}
+
+// ContainerState mocks base method.
+func (m *MocklocalContainerRunner) ContainerState(name string) (*dockerengine.ContainerState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainerState", name)
+	ret0, _ := ret[0].(*dockerengine.ContainerState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainerState indicates an expected call of ContainerState.
+func (mr *MocklocalContainerRunnerMockRecorder) ContainerState(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainerState", reflect.TypeOf((*MocklocalContainerRunner)(nil).ContainerState), name)
+}
+
+// CreateNetwork mocks base method.
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// Logs mocks base method.
+func (m *MocklocalContainerRunner) Logs(name string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logs", name, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logs indicates an expected call of Logs.
+func (mr *MocklocalContainerRunnerMockRecorder) Logs(name, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logs", reflect.TypeOf((*MocklocalContainerRunner)(nil).Logs), name, w)
+}
+
+// RemoveContainer mocks base method.
+func (m *MocklocalContainerRunner) RemoveContainer(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContainer", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContainer indicates an expected call of RemoveContainer.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveContainer(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContainer", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveContainer), name)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MocklocalContainerRunner) RemoveNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveNetwork), name)
+}
+
+// Run mocks base method.
+func (m *MocklocalContainerRunner) Run(in *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocklocalContainerRunnerMockRecorder) Run(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklocalContainerRunner)(nil).Run), in)
+}
+
 // Mockinterpolator is a mock of interpolator interface.
 type Mockinterpolator struct {
 	ctrl     *gomock.Controller
@@ -6788,6 +7456,44 @@ func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
 }
 
+// MocktemplateDiffer is a mock of templateDiffer interface.
+type MocktemplateDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateDifferMockRecorder
+}
+
+// MocktemplateDifferMockRecorder is the mock recorder for MocktemplateDiffer.
+type MocktemplateDifferMockRecorder struct {
+	mock *MocktemplateDiffer
+}
+
+// NewMocktemplateDiffer creates a new mock instance.
+func NewMocktemplateDiffer(ctrl *gomock.Controller) *MocktemplateDiffer {
+	mock := &MocktemplateDiffer{ctrl: ctrl}
+	mock.recorder = &MocktemplateDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
+	return m.recorder
+}
+
+// DeployDiff mocks base method.
+func (m *MocktemplateDiffer) DeployDiff(template string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", template)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MocktemplateDifferMockRecorder) DeployDiff(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), template)
+}
+
 // MockworkloadDeployer is a mock of workloadDeployer interface.
 type MockworkloadDeployer struct {
 	ctrl     *gomock.Controller
@@ -6811,6 +7517,21 @@ func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 	return m.recorder
 }
 
+// DeployDiff mocks base method.
+func (m *MockworkloadDeployer) DeployDiff(template string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", template)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), template)
+}
+
 // DeployWorkload mocks base method.
 func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
 	m.ctrl.T.Helper()
@@ -6826,6 +7547,21 @@ func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
 // IsServiceAvailableInRegion mocks base method.
 func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -6909,6 +7645,44 @@ func (mr *MockworkloadTemplateGeneratorMockRecorder) UploadArtifacts() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadTemplateGenerator)(nil).UploadArtifacts))
 }
 
+// MockjobExecutionLister is a mock of jobExecutionLister interface.
+type MockjobExecutionLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobExecutionListerMockRecorder
+}
+
+// MockjobExecutionListerMockRecorder is the mock recorder for MockjobExecutionLister.
+type MockjobExecutionListerMockRecorder struct {
+	mock *MockjobExecutionLister
+}
+
+// NewMockjobExecutionLister creates a new mock instance.
+func NewMockjobExecutionLister(ctrl *gomock.Controller) *MockjobExecutionLister {
+	mock := &MockjobExecutionLister{ctrl: ctrl}
+	mock.recorder = &MockjobExecutionListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobExecutionLister) EXPECT() *MockjobExecutionListerMockRecorder {
+	return m.recorder
+}
+
+// JobExecutions mocks base method.
+func (m *MockjobExecutionLister) JobExecutions(app, env, job string, limit int) ([]*ecs0.JobExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutions", app, env, job, limit)
+	ret0, _ := ret[0].([]*ecs0.JobExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutions indicates an expected call of JobExecutions.
+func (mr *MockjobExecutionListerMockRecorder) JobExecutions(app, env, job, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutions", reflect.TypeOf((*MockjobExecutionLister)(nil).JobExecutions), app, env, job, limit)
+}
+
 // Mockrunner is a mock of runner interface.
 type Mockrunner struct {
 	ctrl     *gomock.Controller
@@ -6946,6 +7720,20 @@ func (mr *MockrunnerMockRecorder) Run() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run))
 }
 
+// RunAndFollow mocks base method.
+func (m *Mockrunner) RunAndFollow() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunAndFollow")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunAndFollow indicates an expected call of RunAndFollow.
+func (mr *MockrunnerMockRecorder) RunAndFollow() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAndFollow", reflect.TypeOf((*Mockrunner)(nil).RunAndFollow))
+}
+
 // MockenvDeployer is a mock of envDeployer interface.
 type MockenvDeployer struct {
 	ctrl     *gomock.Controller
@@ -6969,6 +7757,21 @@ func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 	return m.recorder
 }
 
+// DeployDiff mocks base method.
+func (m *MockenvDeployer) DeployDiff(template string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", template)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockenvDeployerMockRecorder) DeployDiff(template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), template)
+}
+
 // DeployEnvironment mocks base method.
 func (m *MockenvDeployer) DeployEnvironment(in *deploy.DeployEnvironmentInput) error {
 	m.ctrl.T.Helper()
@@ -6983,11 +7786,26 @@ func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
 }
 
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockenvDeployer) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
+}
+
 // UploadArtifacts mocks base method.
-func (m *MockenvDeployer) UploadArtifacts() (map[string]string, error) {
+func (m *MockenvDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(map[string]string)
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildRunCmd is the top level command for running workloads outside of AWS.
+func BuildRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "run",
+		Short: `Commands for running workloads outside of AWS.
+Runs the containers of a service or job on your machine.`,
+	}
+
+	cmd.AddCommand(buildRunLocalCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,786 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	fcolor "github.com/fatih/color"
+	"github.com/google/shlex"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	runLocalWkldNamePrompt      = "Which workload would you like to run locally?"
+	runLocalEnvNamePrompt       = "Which environment's configuration would you like to run with?"
+	runLocalEnvNameHelpPrompt   = "The environment overrides in the manifest are applied before the containers are started."
+	runLocalDefaultPollInterval = time.Second
+
+	localImageURIFmt      = "copilot/%s/%s"    // Image repository for the built image of a workload: copilot/{app}/{workload}.
+	localNetworkNameFmt   = "copilot-%s-%s"    // Network shared by the containers of a workload: copilot-{app}-{workload}.
+	localContainerNameFmt = "copilot-%s-%s-%s" // Container names: copilot-{app}-{workload}-{container}.
+)
+
+// Container dependency conditions.
+const (
+	localDependsOnStart    = "START"
+	localDependsOnComplete = "COMPLETE"
+	localDependsOnSuccess  = "SUCCESS"
+	localDependsOnHealthy  = "HEALTHY"
+)
+
+var (
+	errRunLocalInterrupted = errors.New("interrupted")
+
+	localLogColors = []*fcolor.Color{color.Cyan, color.Magenta, color.Yellow, color.DullGreen, color.Blue}
+)
+
+type errContainerExited struct {
+	name     string
+	exitCode int
+}
+
+func (e *errContainerExited) Error() string {
+	return fmt.Sprintf("container %s exited with code %d", e.name, e.exitCode)
+}
+
+// ExitCode returns the exit code of the container so that the CLI exits with the same code.
+func (e *errContainerExited) ExitCode() int {
+	return e.exitCode
+}
+
+type runLocalVars struct {
+	appName     string
+	envName     string
+	wkldName    string
+	secretsFile string
+}
+
+type runLocalOpts struct {
+	runLocalVars
+
+	ws              wsWlDirReader
+	sel             wsSelector
+	fs              afero.Fs
+	docker          localContainerRunner
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+	logWriter       io.Writer
+
+	// Overridden in unit tests.
+	interrupt    chan os.Signal
+	pollInterval time.Duration
+}
+
+// localContainer holds the configuration to run a container of the workload locally.
+type localContainer struct {
+	dockerengine.RunOptions
+	name      string                       // Name of the container in the manifest.
+	build     *dockerengine.BuildArguments // Arguments to build the image of the container, if any.
+	secrets   map[string]manifest.Secret
+	dependsOn manifest.DependsOn
+}
+
+func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("run local"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &runLocalOpts{
+		runLocalVars:    vars,
+		ws:              ws,
+		sel:             selector.NewLocalWorkloadSelector(prompt.New(), store, ws),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		docker:          dockerengine.New(exec.NewCmd()),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		logWriter:       os.Stdout,
+		interrupt:       make(chan os.Signal, 1),
+		pollInterval:    runLocalDefaultPollInterval,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *runLocalOpts) Validate() error {
+	if o.secretsFile == "" {
+		return nil
+	}
+	if _, err := o.fs.Stat(o.secretsFile); err != nil {
+		return fmt.Errorf("check if secrets file %s exists: %w", o.secretsFile, err)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *runLocalOpts) Ask() error {
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if err := o.validateOrAskWkldName(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnvName()
+}
+
+// Execute builds the images of the workload and runs its containers on the local Docker engine
+// until the main container exits or the command is interrupted.
+func (o *runLocalOpts) Execute() error {
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.wkldName,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return fmt.Errorf("get workspace path: %w", err)
+	}
+	containers, err := localContainers(&localContainersInput{
+		app:    o.appName,
+		env:    o.envName,
+		name:   o.wkldName,
+		wsPath: wsPath,
+		mft:    mft,
+	})
+	if err != nil {
+		return err
+	}
+	if err := o.resolveSecrets(containers); err != nil {
+		return err
+	}
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return fmt.Errorf("check if docker engine is running: %w", err)
+	}
+	for _, c := range containers {
+		if c.build == nil {
+			continue
+		}
+		log.Infof("Building the image of container %s.\n", color.HighlightUserInput(c.name))
+		if err := o.docker.Build(c.build); err != nil {
+			return fmt.Errorf("build image of container %s: %w", c.name, err)
+		}
+	}
+
+	signal.Notify(o.interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(o.interrupt)
+	err = o.run(containers)
+	if errors.Is(err, errRunLocalInterrupted) {
+		return nil
+	}
+	return err
+}
+
+func (o *runLocalOpts) validateOrAskWkldName() error {
+	if o.wkldName != "" {
+		names, err := o.ws.ListWorkloads()
+		if err != nil {
+			return fmt.Errorf("list workloads in the workspace: %w", err)
+		}
+		if !contains(o.wkldName, names) {
+			return fmt.Errorf("workload %q does not exist in the workspace", o.wkldName)
+		}
+		return nil
+	}
+	name, err := o.sel.Workload(runLocalWkldNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select workload: %w", err)
+	}
+	o.wkldName = name
+	return nil
+}
+
+func (o *runLocalOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		// Environment overrides are applied from the local manifest, so the environment doesn't need to exist.
+		return nil
+	}
+	name, err := o.sel.Environment(runLocalEnvNamePrompt, runLocalEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// resolveSecrets sets the values of the secrets referenced in the manifest from the secrets file.
+// A secret is looked up by the name of its SSM parameter or Secrets Manager secret, then by its environment variable.
+func (o *runLocalOpts) resolveSecrets(containers []*localContainer) error {
+	var missing []string
+	values := make(map[string]string)
+	if o.secretsFile != "" {
+		content, err := afero.ReadFile(o.fs, o.secretsFile)
+		if err != nil {
+			return fmt.Errorf("read secrets file %s: %w", o.secretsFile, err)
+		}
+		if err := yaml.Unmarshal(content, &values); err != nil {
+			return fmt.Errorf("unmarshal secrets file %s: %w", o.secretsFile, err)
+		}
+	}
+	for _, c := range containers {
+		for _, envVar := range sortedKeys(c.secrets) {
+			secret := c.secrets[envVar]
+			value, ok := values[secret.Value()]
+			if !ok {
+				value, ok = values[envVar]
+			}
+			if !ok {
+				missing = append(missing, envVar)
+				continue
+			}
+			if c.Secrets == nil {
+				c.Secrets = make(map[string]string)
+			}
+			c.Secrets[envVar] = value
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if o.secretsFile == "" {
+		return fmt.Errorf("provide the values of %s %s with --%s", english.PluralWord(len(missing), "secret", "secrets"), english.WordSeries(missing, "and"), secretsFileFlag)
+	}
+	return fmt.Errorf("%s %s not found in %s", english.PluralWord(len(missing), "secret", "secrets"), english.WordSeries(missing, "and"), o.secretsFile)
+}
+
+// run starts the containers on a shared network in the order of their dependencies, then waits until
+// the main container exits. The containers and the network are removed before returning.
+func (o *runLocalOpts) run(containers []*localContainer) error {
+	ordered, err := startOrder(containers)
+	if err != nil {
+		return err
+	}
+	network := fmt.Sprintf(localNetworkNameFmt, o.appName, o.wkldName)
+	if err := o.docker.CreateNetwork(network); err != nil {
+		return err
+	}
+	var started []*localContainer
+	var logs sync.WaitGroup
+	defer func() {
+		o.cleanUp(started, network)
+		logs.Wait()
+	}()
+
+	byName := make(map[string]*localContainer, len(containers))
+	for _, c := range containers {
+		byName[c.name] = c
+	}
+	var mu sync.Mutex
+	for i, c := range ordered {
+		if err := o.waitForDependencies(c, byName); err != nil {
+			return err
+		}
+		c.Network = network
+		if err := o.docker.Run(&c.RunOptions); err != nil {
+			return err
+		}
+		started = append(started, c)
+		log.Successf("Started container %s.\n", color.HighlightUserInput(c.name))
+
+		w := &prefixWriter{
+			mu:     &mu,
+			w:      o.logWriter,
+			prefix: localLogColors[i%len(localLogColors)].Sprintf("[%s] ", c.name),
+		}
+		logs.Add(1)
+		go func(name string) {
+			defer logs.Done()
+			// The logs stop streaming once the container is removed, which isn't an error worth surfacing.
+			_ = o.docker.Logs(name, w)
+			w.flush()
+		}(c.ContainerName)
+	}
+	log.Infof("Running %s. Press %s to stop.\n", color.HighlightUserInput(o.wkldName), color.HighlightCode("Ctrl+C"))
+	return o.waitForExit(byName[o.wkldName])
+}
+
+func (o *runLocalOpts) waitForDependencies(c *localContainer, byName map[string]*localContainer) error {
+	for _, dep := range sortedKeys(c.dependsOn) {
+		depContainer, ok := byName[dep]
+		if !ok {
+			// Containers that only exist on ECS, such as the FireLens log router, are not run locally.
+			continue
+		}
+		condition := strings.ToUpper(c.dependsOn[dep])
+		log.Infof("Waiting for container %s to %s before starting %s.\n", color.HighlightUserInput(dep), conditionDescription(condition), color.HighlightUserInput(c.name))
+		if err := o.waitForCondition(depContainer, condition); err != nil {
+			return fmt.Errorf("wait for dependency %s of container %s: %w", dep, c.name, err)
+		}
+	}
+	return nil
+}
+
+func (o *runLocalOpts) waitForCondition(c *localContainer, condition string) error {
+	for {
+		state, err := o.docker.ContainerState(c.ContainerName)
+		if err != nil {
+			return err
+		}
+		switch condition {
+		case localDependsOnStart:
+			return nil
+		case localDependsOnComplete:
+			if state.IsStopped() {
+				return nil
+			}
+		case localDependsOnSuccess:
+			if state.IsStopped() {
+				if state.ExitCode != 0 {
+					return &errContainerExited{name: c.name, exitCode: state.ExitCode}
+				}
+				return nil
+			}
+		case localDependsOnHealthy:
+			switch {
+			case state.HealthStatus() == dockerengine.ContainerHealthHealthy:
+				return nil
+			case state.HealthStatus() == dockerengine.ContainerHealthUnhealthy:
+				return fmt.Errorf("container %s is unhealthy", c.name)
+			case state.IsStopped():
+				return &errContainerExited{name: c.name, exitCode: state.ExitCode}
+			case state.HealthStatus() == "":
+				return fmt.Errorf("container %s does not have a health check", c.name)
+			}
+		}
+		select {
+		case <-o.interrupt:
+			return errRunLocalInterrupted
+		case <-time.After(o.pollInterval):
+		}
+	}
+}
+
+func (o *runLocalOpts) waitForExit(c *localContainer) error {
+	for {
+		state, err := o.docker.ContainerState(c.ContainerName)
+		if err != nil {
+			return err
+		}
+		if state.IsStopped() {
+			if state.ExitCode != 0 {
+				return &errContainerExited{name: c.name, exitCode: state.ExitCode}
+			}
+			log.Successf("Container %s exited with code 0.\n", color.HighlightUserInput(c.name))
+			return nil
+		}
+		select {
+		case <-o.interrupt:
+			return errRunLocalInterrupted
+		case <-time.After(o.pollInterval):
+		}
+	}
+}
+
+// cleanUp removes the containers in the reverse order of their start, followed by the network.
+func (o *runLocalOpts) cleanUp(containers []*localContainer, network string) {
+	log.Infoln("Stopping the containers.")
+	for i := len(containers) - 1; i >= 0; i-- {
+		if err := o.docker.RemoveContainer(containers[i].ContainerName); err != nil {
+			log.Errorf("Failed to remove container %s: %v\n", containers[i].name, err)
+		}
+	}
+	if err := o.docker.RemoveNetwork(network); err != nil {
+		log.Errorf("Failed to remove network %s: %v\n", network, err)
+	}
+}
+
+func conditionDescription(condition string) string {
+	switch condition {
+	case localDependsOnHealthy:
+		return "become healthy"
+	case localDependsOnComplete:
+		return "complete"
+	case localDependsOnSuccess:
+		return "exit successfully"
+	}
+	return "start"
+}
+
+// startOrder sorts the containers so that each container comes after the containers it depends on.
+func startOrder(containers []*localContainer) ([]*localContainer, error) {
+	names := make([]string, len(containers))
+	for i, c := range containers {
+		names[i] = c.name
+	}
+	deps := graph.New(names...)
+	for _, c := range containers {
+		for dep := range c.dependsOn {
+			if !contains(dep, names) {
+				continue
+			}
+			deps.Add(graph.Edge[string]{
+				From: dep,
+				To:   c.name,
+			})
+		}
+	}
+	order, err := graph.TopologicalOrder(deps)
+	if err != nil {
+		return nil, fmt.Errorf("determine the order to start the containers: %w", err)
+	}
+	ordered := make([]*localContainer, len(containers))
+	copy(ordered, containers)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ri, _ := order.Rank(ordered[i].name)
+		rj, _ := order.Rank(ordered[j].name)
+		return ri < rj
+	})
+	return ordered, nil
+}
+
+type localContainersInput struct {
+	app    string
+	env    string
+	name   string
+	wsPath string
+	mft    manifest.WorkloadManifest
+}
+
+// localWorkloadConfig holds the fields of a workload manifest that are needed to run its containers.
+type localWorkloadConfig struct {
+	image       manifest.Image
+	port        *uint16
+	healthCheck manifest.ContainerHealthCheck
+	entryPoint  []string
+	command     []string
+	variables   map[string]string
+	envFile     *string
+	secrets     map[string]manifest.Secret
+	sidecars    map[string]*manifest.SidecarConfig
+}
+
+// localContainers returns the main container of the workload, followed by its sidecars sorted by name.
+func localContainers(in *localContainersInput) ([]*localContainer, error) {
+	conf, err := newLocalWorkloadConfig(in.mft)
+	if err != nil {
+		return nil, err
+	}
+	commonVars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           in.app,
+		"COPILOT_ENVIRONMENT_NAME":           in.env,
+		"COPILOT_SERVICE_NAME":               in.name,
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf("%s.%s.local", in.env, in.app),
+	}
+	main := &localContainer{
+		name: in.name,
+		RunOptions: dockerengine.RunOptions{
+			ImageURI:      conf.image.GetLocation(),
+			ContainerName: fmt.Sprintf(localContainerNameFmt, in.app, in.name, in.name),
+			NetworkAlias:  in.name,
+			EnvVars:       mergeVariables(commonVars, conf.variables),
+			EntryPoint:    conf.entryPoint,
+			Command:       conf.command,
+			HealthCheck:   localHealthCheck(conf.healthCheck),
+		},
+		secrets:   conf.secrets,
+		dependsOn: conf.image.DependsOn,
+	}
+	if conf.port != nil {
+		main.Ports = []string{strconv.Itoa(int(aws.Uint16Value(conf.port)))}
+	}
+	if envFile := aws.StringValue(conf.envFile); envFile != "" {
+		main.EnvFile = filepath.Join(in.wsPath, envFile)
+	}
+	required, err := manifest.DockerfileBuildRequired(in.mft)
+	if err != nil {
+		return nil, err
+	}
+	if required {
+		build, err := localBuildArgs(in)
+		if err != nil {
+			return nil, err
+		}
+		main.build = build
+		main.ImageURI = build.URI
+	}
+
+	containers := []*localContainer{main}
+	for _, name := range sortedSidecarNames(conf.sidecars) {
+		sidecar := conf.sidecars[name]
+		entryPoint, err := sidecar.EntryPoint.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert entrypoint of sidecar %s to a list: %w", name, err)
+		}
+		command, err := sidecar.Command.ToStringSlice()
+		if err != nil {
+			return nil, fmt.Errorf("convert command of sidecar %s to a list: %w", name, err)
+		}
+		c := &localContainer{
+			name: name,
+			RunOptions: dockerengine.RunOptions{
+				ImageURI:      aws.StringValue(sidecar.Image),
+				ContainerName: fmt.Sprintf(localContainerNameFmt, in.app, in.name, name),
+				NetworkAlias:  name,
+				EnvVars:       mergeVariables(commonVars, sidecar.Variables),
+				EntryPoint:    entryPoint,
+				Command:       command,
+				HealthCheck:   localHealthCheck(sidecar.HealthCheck),
+			},
+			secrets:   sidecar.Secrets,
+			dependsOn: sidecar.DependsOn,
+		}
+		if sidecar.Port != nil {
+			c.Ports = []string{aws.StringValue(sidecar.Port)}
+		}
+		containers = append(containers, c)
+	}
+	return containers, nil
+}
+
+func newLocalWorkloadConfig(mft manifest.WorkloadManifest) (*localWorkloadConfig, error) {
+	var conf localWorkloadConfig
+	var override manifest.ImageOverride
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		conf = localWorkloadConfig{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			variables:   t.TaskConfig.Variables,
+			envFile:     t.TaskConfig.EnvFile,
+			secrets:     t.TaskConfig.Secrets,
+			sidecars:    t.Sidecars,
+		}
+		override = t.ImageOverride
+	case *manifest.BackendService:
+		conf = localWorkloadConfig{
+			image:       t.ImageConfig.Image,
+			port:        t.ImageConfig.Port,
+			healthCheck: t.ImageConfig.HealthCheck,
+			variables:   t.TaskConfig.Variables,
+			envFile:     t.TaskConfig.EnvFile,
+			secrets:     t.TaskConfig.Secrets,
+			sidecars:    t.Sidecars,
+		}
+		override = t.ImageOverride
+	case *manifest.WorkerService:
+		conf = localWorkloadConfig{
+			image:       t.ImageConfig.Image,
+			healthCheck: t.ImageConfig.HealthCheck,
+			variables:   t.TaskConfig.Variables,
+			envFile:     t.TaskConfig.EnvFile,
+			secrets:     t.TaskConfig.Secrets,
+			sidecars:    t.Sidecars,
+		}
+		override = t.ImageOverride
+	case *manifest.ScheduledJob:
+		conf = localWorkloadConfig{
+			image:       t.ImageConfig.Image,
+			healthCheck: t.ImageConfig.HealthCheck,
+			variables:   t.TaskConfig.Variables,
+			envFile:     t.TaskConfig.EnvFile,
+			secrets:     t.TaskConfig.Secrets,
+			sidecars:    t.Sidecars,
+		}
+		override = t.ImageOverride
+	case *manifest.RequestDrivenWebService:
+		conf = localWorkloadConfig{
+			image:     t.ImageConfig.Image,
+			port:      t.ImageConfig.Port,
+			variables: t.Variables,
+		}
+		if t.StartCommand != nil {
+			command, err := shlex.Split(aws.StringValue(t.StartCommand))
+			if err != nil {
+				return nil, fmt.Errorf("convert command into tokens using shell-style rules: %w", err)
+			}
+			conf.command = command
+		}
+		return &conf, nil
+	default:
+		return nil, fmt.Errorf("unknown manifest type %T to run locally", t)
+	}
+	entryPoint, err := override.EntryPoint.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert entrypoint to a list: %w", err)
+	}
+	command, err := override.Command.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert command to a list: %w", err)
+	}
+	conf.entryPoint, conf.command = entryPoint, command
+	return &conf, nil
+}
+
+func localBuildArgs(in *localContainersInput) (*dockerengine.BuildArguments, error) {
+	type dfArgs interface {
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+		ContainerPlatform() string
+	}
+	mf, ok := in.mft.(dfArgs)
+	if !ok {
+		return nil, fmt.Errorf("%s does not have required methods BuildArgs() and ContainerPlatform()", in.name)
+	}
+	args := mf.BuildArgs(in.wsPath)
+	return &dockerengine.BuildArguments{
+		URI:        fmt.Sprintf(localImageURIFmt, in.app, in.name),
+		Dockerfile: aws.StringValue(args.Dockerfile),
+		Context:    aws.StringValue(args.Context),
+		Args:       args.Args,
+		CacheFrom:  args.CacheFrom,
+		Target:     aws.StringValue(args.Target),
+		Platform:   mf.ContainerPlatform(),
+	}, nil
+}
+
+func localHealthCheck(hc manifest.ContainerHealthCheck) *dockerengine.HealthCheck {
+	if hc.IsEmpty() {
+		return nil
+	}
+	out := &dockerengine.HealthCheck{
+		Command: hc.Command,
+		Retries: aws.IntValue(hc.Retries),
+	}
+	if hc.Interval != nil {
+		out.Interval = *hc.Interval
+	}
+	if hc.Timeout != nil {
+		out.Timeout = *hc.Timeout
+	}
+	if hc.StartPeriod != nil {
+		out.StartPeriod = *hc.StartPeriod
+	}
+	return out
+}
+
+// mergeVariables returns the variables defined in the manifest on top of the ones injected by Copilot.
+func mergeVariables(common, variables map[string]string) map[string]string {
+	out := make(map[string]string, len(common)+len(variables))
+	for k, v := range common {
+		out[k] = v
+	}
+	for k, v := range variables {
+		out[k] = v
+	}
+	return out
+}
+
+func sortedSidecarNames(sidecars map[string]*manifest.SidecarConfig) []string {
+	names := make([]string, 0, len(sidecars))
+	for name := range sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// prefixWriter writes each complete line with a prefix. Writers that share the same mutex don't interleave their lines.
+type prefixWriter struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	prefix string
+	buf    []byte
+}
+
+// Write buffers p and writes the complete lines to the underlying writer.
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		if err := p.writeLine(p.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		p.buf = p.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+func (p *prefixWriter) flush() {
+	if len(p.buf) == 0 {
+		return
+	}
+	_ = p.writeLine(append(p.buf, '\n'))
+	p.buf = nil
+}
+
+func (p *prefixWriter) writeLine(line []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err := fmt.Fprintf(p.w, "%s%s", p.prefix, line)
+	return err
+}
+
+// buildRunLocalCmd builds the command for running a workload locally.
+func buildRunLocalCmd() *cobra.Command {
+	vars := runLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Run the containers of a workload on the local Docker engine.",
+		Long: `Run the containers of a workload on the local Docker engine.
+The images are built from the workload's manifest, and the main container and its sidecars
+are started on a shared network until the main container exits or the command is interrupted.`,
+		Example: `
+  Run the "frontend" service with the configuration of the "test" environment.
+  /code $ copilot run local --name frontend --env test
+  Run the "api" service with the values of its secrets from a local file.
+  /code $ copilot run local -n api -e test --secrets-file secrets.yml`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.secretsFile, secretsFileFlag, "", secretsFileFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
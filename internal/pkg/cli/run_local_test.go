@@ -0,0 +1,395 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSecretsFile string
+		setupFs       func(fs afero.Fs)
+
+		wantedErr string
+	}{
+		"no secrets file": {},
+		"error if the secrets file does not exist": {
+			inSecretsFile: "secrets.yml",
+			setupFs:       func(fs afero.Fs) {},
+			wantedErr:     "check if secrets file secrets.yml exists: open secrets.yml: file does not exist",
+		},
+		"secrets file exists": {
+			inSecretsFile: "secrets.yml",
+			setupFs: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "secrets.yml", []byte("DB_PASSWORD: hunter2"), 0644)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.setupFs != nil {
+				tc.setupFs(fs)
+			}
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					secretsFile: tc.inSecretsFile,
+				},
+				fs: fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRunLocalOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inWkldName string
+		inEnvName  string
+		setupMocks func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector)
+
+		wantedWkldName string
+		wantedEnvName  string
+		wantedErr      error
+	}{
+		"error if not in a workspace": {
+			setupMocks: func(_ *mocks.MockwsWlDirReader, _ *mocks.MockwsSelector) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"error if the workload does not exist in the workspace": {
+			inAppName:  "demo",
+			inWkldName: "api",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, _ *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New(`workload "api" does not exist in the workspace`),
+		},
+		"does not prompt if the workload and environment are provided": {
+			inAppName:  "demo",
+			inWkldName: "api",
+			inEnvName:  "test",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, _ *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api"}, nil)
+			},
+			wantedWkldName: "api",
+			wantedEnvName:  "test",
+		},
+		"prompts for the workload and environment": {
+			inAppName: "demo",
+			setupMocks: func(_ *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector) {
+				sel.EXPECT().Workload(runLocalWkldNamePrompt, "").Return("api", nil)
+				sel.EXPECT().Environment(runLocalEnvNamePrompt, runLocalEnvNameHelpPrompt, "demo").Return("test", nil)
+			},
+			wantedWkldName: "api",
+			wantedEnvName:  "test",
+		},
+		"error if failed to select an environment": {
+			inAppName:  "demo",
+			inWkldName: "api",
+			setupMocks: func(ws *mocks.MockwsWlDirReader, sel *mocks.MockwsSelector) {
+				ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "demo").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			sel := mocks.NewMockwsSelector(ctrl)
+			tc.setupMocks(ws, sel)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  tc.inAppName,
+					wkldName: tc.inWkldName,
+					envName:  tc.inEnvName,
+				},
+				ws:  ws,
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWkldName, opts.wkldName)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+		})
+	}
+}
+
+type runLocalExecuteMocks struct {
+	ws        *mocks.MockwsWlDirReader
+	docker    *mocks.MocklocalContainerRunner
+	interrupt chan os.Signal
+}
+
+func TestRunLocalOpts_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+  depends_on:
+    nginx: healthy
+command: ["./start.sh", "--verbose"]
+variables:
+  LOG_LEVEL: info
+env_file: api.env
+secrets:
+  DB_PASSWORD: /copilot/demo/test/secrets/db_password
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx:latest
+    port: 80
+    healthcheck:
+      command: ["CMD-SHELL", "curl -f http://localhost || exit 1"]
+      retries: 3
+environments:
+  test:
+    variables:
+      LOG_LEVEL: debug
+`
+	const secrets = `/copilot/demo/test/secrets/db_password: hunter2`
+	commonVars := map[string]string{
+		"COPILOT_APPLICATION_NAME":           "demo",
+		"COPILOT_ENVIRONMENT_NAME":           "test",
+		"COPILOT_SERVICE_NAME":               "api",
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.demo.local",
+	}
+	wantedSidecar := &dockerengine.RunOptions{
+		ImageURI:      "public.ecr.aws/nginx/nginx:latest",
+		ContainerName: "copilot-demo-api-nginx",
+		Network:       "copilot-demo-api",
+		NetworkAlias:  "nginx",
+		EnvVars:       commonVars,
+		Ports:         []string{"80"},
+		HealthCheck: &dockerengine.HealthCheck{
+			Command: []string{"CMD-SHELL", "curl -f http://localhost || exit 1"},
+			Retries: 3,
+		},
+	}
+	wantedMain := &dockerengine.RunOptions{
+		ImageURI:      "copilot/demo/api",
+		ContainerName: "copilot-demo-api-api",
+		Network:       "copilot-demo-api",
+		NetworkAlias:  "api",
+		EnvVars: map[string]string{
+			"COPILOT_APPLICATION_NAME":           "demo",
+			"COPILOT_ENVIRONMENT_NAME":           "test",
+			"COPILOT_SERVICE_NAME":               "api",
+			"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.demo.local",
+			"LOG_LEVEL":                          "debug",
+		},
+		Secrets: map[string]string{
+			"DB_PASSWORD": "hunter2",
+		},
+		EnvFile: "/ws/api.env",
+		Command: []string{"./start.sh", "--verbose"},
+		Ports:   []string{"8080"},
+	}
+	readManifest := func(m runLocalExecuteMocks) {
+		m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil)
+		m.ws.EXPECT().Path().Return("/ws", nil)
+	}
+	startContainers := func(m runLocalExecuteMocks) {
+		m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+		m.docker.EXPECT().Build(&dockerengine.BuildArguments{
+			URI:        "copilot/demo/api",
+			Dockerfile: "/ws/api/Dockerfile",
+			Context:    "/ws/api",
+		}).Return(nil)
+		m.docker.EXPECT().CreateNetwork("copilot-demo-api").Return(nil)
+		m.docker.EXPECT().Run(wantedSidecar).Return(nil)
+		m.docker.EXPECT().Logs("copilot-demo-api-nginx", gomock.Any()).DoAndReturn(func(_ string, w io.Writer) error {
+			_, err := w.Write([]byte("nginx started\n"))
+			return err
+		})
+		m.docker.EXPECT().ContainerState("copilot-demo-api-nginx").Return(&dockerengine.ContainerState{
+			Status: dockerengine.ContainerStatusRunning,
+			Health: &dockerengine.ContainerHealth{Status: "starting"},
+		}, nil)
+		m.docker.EXPECT().ContainerState("copilot-demo-api-nginx").Return(&dockerengine.ContainerState{
+			Status: dockerengine.ContainerStatusRunning,
+			Health: &dockerengine.ContainerHealth{Status: dockerengine.ContainerHealthHealthy},
+		}, nil)
+		m.docker.EXPECT().Run(wantedMain).Return(nil)
+		m.docker.EXPECT().Logs("copilot-demo-api-api", gomock.Any()).DoAndReturn(func(_ string, w io.Writer) error {
+			_, err := w.Write([]byte("listening on 8080"))
+			return err
+		})
+	}
+	cleanUp := func(m runLocalExecuteMocks) {
+		gomock.InOrder(
+			m.docker.EXPECT().RemoveContainer("copilot-demo-api-api").Return(nil),
+			m.docker.EXPECT().RemoveContainer("copilot-demo-api-nginx").Return(nil),
+			m.docker.EXPECT().RemoveNetwork("copilot-demo-api").Return(nil),
+		)
+	}
+
+	testCases := map[string]struct {
+		inSecretsFile string
+		setupMocks    func(m runLocalExecuteMocks)
+
+		wantedLogs []string
+		wantedErr  error
+	}{
+		"error if the secrets file is not provided": {
+			setupMocks: readManifest,
+			wantedErr:  fmt.Errorf("provide the values of secret DB_PASSWORD with --%s", secretsFileFlag),
+		},
+		"error if the docker engine is not running": {
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m runLocalExecuteMocks) {
+				readManifest(m)
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(dockerengine.ErrDockerCommandNotFound)
+			},
+			wantedErr: errors.New("check if docker engine is running: docker: command not found"),
+		},
+		"removes the containers if a dependency is unhealthy": {
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m runLocalExecuteMocks) {
+				readManifest(m)
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.docker.EXPECT().Build(gomock.Any()).Return(nil)
+				m.docker.EXPECT().CreateNetwork("copilot-demo-api").Return(nil)
+				m.docker.EXPECT().Run(wantedSidecar).Return(nil)
+				m.docker.EXPECT().Logs("copilot-demo-api-nginx", gomock.Any()).Return(nil)
+				m.docker.EXPECT().ContainerState("copilot-demo-api-nginx").Return(&dockerengine.ContainerState{
+					Status: dockerengine.ContainerStatusRunning,
+					Health: &dockerengine.ContainerHealth{Status: dockerengine.ContainerHealthUnhealthy},
+				}, nil)
+				gomock.InOrder(
+					m.docker.EXPECT().RemoveContainer("copilot-demo-api-nginx").Return(nil),
+					m.docker.EXPECT().RemoveNetwork("copilot-demo-api").Return(nil),
+				)
+			},
+			wantedErr: errors.New("wait for dependency nginx of container api: container nginx is unhealthy"),
+		},
+		"returns the exit code of the main container": {
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m runLocalExecuteMocks) {
+				readManifest(m)
+				startContainers(m)
+				m.docker.EXPECT().ContainerState("copilot-demo-api-api").Return(&dockerengine.ContainerState{
+					Status:   dockerengine.ContainerStatusExited,
+					ExitCode: 137,
+				}, nil)
+				cleanUp(m)
+			},
+			wantedLogs: []string{"[nginx] nginx started\n", "[api] listening on 8080\n"},
+			wantedErr:  &errContainerExited{name: "api", exitCode: 137},
+		},
+		"runs until the main container exits successfully": {
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m runLocalExecuteMocks) {
+				readManifest(m)
+				startContainers(m)
+				m.docker.EXPECT().ContainerState("copilot-demo-api-api").Return(&dockerengine.ContainerState{
+					Status: dockerengine.ContainerStatusRunning,
+				}, nil)
+				m.docker.EXPECT().ContainerState("copilot-demo-api-api").Return(&dockerengine.ContainerState{
+					Status: dockerengine.ContainerStatusExited,
+				}, nil)
+				cleanUp(m)
+			},
+			wantedLogs: []string{"[nginx] nginx started\n", "[api] listening on 8080\n"},
+		},
+		"runs until interrupted": {
+			inSecretsFile: "secrets.yml",
+			setupMocks: func(m runLocalExecuteMocks) {
+				readManifest(m)
+				startContainers(m)
+				m.docker.EXPECT().ContainerState("copilot-demo-api-api").DoAndReturn(func(_ string) (*dockerengine.ContainerState, error) {
+					m.interrupt <- os.Interrupt
+					return &dockerengine.ContainerState{
+						Status: dockerengine.ContainerStatusRunning,
+					}, nil
+				})
+				cleanUp(m)
+			},
+			wantedLogs: []string{"[nginx] nginx started\n", "[api] listening on 8080\n"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := runLocalExecuteMocks{
+				ws:        mocks.NewMockwsWlDirReader(ctrl),
+				docker:    mocks.NewMocklocalContainerRunner(ctrl),
+				interrupt: make(chan os.Signal, 1),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			_ = afero.WriteFile(fs, "secrets.yml", []byte(secrets), 0644)
+			logs := &bytes.Buffer{}
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:     "demo",
+					envName:     "test",
+					wkldName:    "api",
+					secretsFile: tc.inSecretsFile,
+				},
+				ws:        m.ws,
+				fs:        fs,
+				docker:    m.docker,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
+					return manifest.NewInterpolator("demo", "test")
+				},
+				logWriter:    logs,
+				interrupt:    m.interrupt,
+				pollInterval: time.Millisecond,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			for _, line := range tc.wantedLogs {
+				require.Contains(t, logs.String(), line)
+			}
+		})
+	}
+}
@@ -4,11 +4,32 @@
 package cli
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	secretListAppNamePrompt = "Which application are the secrets in?"
+	secretListAppNameHelp   = "Secrets are stored in each environment of an application."
+
+	ssmParameterARNResource = ":parameter"
+)
+
 // BuildSecretCmd is the top level command for secret.
 func BuildSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +39,9 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +49,244 @@ Secrets are sensitive information that you need in your application.`,
 	}
 	return cmd
 }
+
+// secretInventory holds the secrets of an application in each of its environments,
+// along with the workloads in the workspace that reference them.
+type secretInventory struct {
+	envs    []string
+	secrets map[string]map[string]ssm.Secret // Secret name -> environment name -> SSM parameter.
+	refs    map[string]map[string][]string   // Secret name -> environment name -> workloads that reference the secret.
+}
+
+// names returns the sorted names of the secrets that exist in any environment or that are referenced by any workload.
+func (inv *secretInventory) names() []string {
+	set := make(map[string]struct{})
+	for name := range inv.secrets {
+		set[name] = struct{}{}
+	}
+	for name := range inv.refs {
+		set[name] = struct{}{}
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parameter returns the SSM parameter of the secret in the environment, and false if the secret is missing.
+func (inv *secretInventory) parameter(name, env string) (ssm.Secret, bool) {
+	param, ok := inv.secrets[name][env]
+	return param, ok
+}
+
+// workloads returns the sorted names of the workloads that reference the secret in any environment.
+func (inv *secretInventory) workloads(name string) []string {
+	set := make(map[string]struct{})
+	for _, wls := range inv.refs[name] {
+		for _, wl := range wls {
+			set[wl] = struct{}{}
+		}
+	}
+	wls := make([]string, 0, len(set))
+	for wl := range set {
+		wls = append(wls, wl)
+	}
+	sort.Strings(wls)
+	return wls
+}
+
+// warnMissing logs a warning for each workload that references the secret in an environment where it doesn't exist.
+func (inv *secretInventory) warnMissing(name string) {
+	for _, env := range inv.envs {
+		if _, ok := inv.parameter(name, env); ok {
+			continue
+		}
+		for _, wl := range inv.refs[name][env] {
+			log.Warningf("Manifest of %s references secret %s, which does not exist in environment %s.\n",
+				color.HighlightUserInput(wl), color.HighlightUserInput(name), color.HighlightUserInput(env))
+		}
+	}
+}
+
+// secretEnvironmentJSON is the serialized state of a secret in an environment.
+type secretEnvironmentJSON struct {
+	Environment  string     `json:"environment"`
+	Exists       bool       `json:"exists"`
+	Parameter    string     `json:"parameter,omitempty"`
+	Version      int64      `json:"version,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+	Workloads    []string   `json:"workloads,omitempty"`
+}
+
+// secretJSON is the serialized state of a secret across the environments of an application.
+type secretJSON struct {
+	Name         string                  `json:"name"`
+	Environments []secretEnvironmentJSON `json:"environments"`
+}
+
+func (inv *secretInventory) toJSON(name string) secretJSON {
+	out := secretJSON{
+		Name:         name,
+		Environments: make([]secretEnvironmentJSON, 0, len(inv.envs)),
+	}
+	for _, env := range inv.envs {
+		state := secretEnvironmentJSON{
+			Environment: env,
+			Workloads:   inv.refs[name][env],
+		}
+		if param, ok := inv.parameter(name, env); ok {
+			lastModified := param.LastModifiedDate
+			state.Exists = true
+			state.Parameter = param.Name
+			state.Version = param.Version
+			state.LastModified = &lastModified
+		}
+		out.Environments = append(out.Environments, state)
+	}
+	return out
+}
+
+// secretInventoryReader collects the secrets of an application and the references to them in the manifests of the workspace.
+type secretInventoryReader struct {
+	ws              wsWlReader // Nil if the command is run outside a workspace.
+	newSecretLister func(env *config.Environment) (secretLister, error)
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+	cmd             execRunner
+}
+
+func (r *secretInventoryReader) read(app string, envs []*config.Environment) (*secretInventory, error) {
+	inv := &secretInventory{
+		secrets: make(map[string]map[string]ssm.Secret),
+		refs:    make(map[string]map[string][]string),
+	}
+	for _, env := range envs {
+		inv.envs = append(inv.envs, env.Name)
+		lister, err := r.newSecretLister(env)
+		if err != nil {
+			return nil, err
+		}
+		params, err := lister.ListSecrets(map[string]string{
+			deploy.AppTagKey: app,
+			deploy.EnvTagKey: env.Name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets in environment %s: %w", env.Name, err)
+		}
+		for _, param := range params {
+			name := secretName(app, env.Name, param.Name)
+			if inv.secrets[name] == nil {
+				inv.secrets[name] = make(map[string]ssm.Secret)
+			}
+			inv.secrets[name][env.Name] = param
+		}
+		if err := r.readReferences(app, env, inv); err != nil {
+			return nil, err
+		}
+	}
+	return inv, nil
+}
+
+// readReferences records the secrets of the application referenced by the workloads of the workspace in the environment.
+// Workloads whose manifest can't be evaluated for the environment are skipped with a warning.
+func (r *secretInventoryReader) readReferences(app string, env *config.Environment, inv *secretInventory) error {
+	if r.ws == nil {
+		return nil
+	}
+	wls, err := r.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %w", err)
+	}
+	interpolatorOpts, err := manifestVars{
+		env:    env,
+		runner: r.cmd,
+	}.interpolatorOpts()
+	if err != nil {
+		return err
+	}
+	prefix := fmt.Sprintf(fmtSecretParameterName, app, env.Name, "")
+	for _, wl := range wls {
+		secrets, err := r.referencedSecrets(app, wl, env.Name, interpolatorOpts)
+		if err != nil {
+			log.Warningf("Skip checking the secrets of %s in environment %s: %v\n", wl, env.Name, err)
+			continue
+		}
+		for _, secret := range secrets {
+			if secret.IsSecretsManagerName() {
+				continue
+			}
+			param := secret.Value()
+			if idx := strings.Index(param, ssmParameterARNResource); strings.HasPrefix(param, "arn:") && idx != -1 {
+				// The resource of a parameter ARN is the fully qualified name of the parameter.
+				param = param[idx+len(ssmParameterARNResource):]
+			}
+			if !strings.HasPrefix(param, prefix) {
+				// Only the secrets created by Copilot for the application are tracked.
+				continue
+			}
+			name := strings.TrimPrefix(param, prefix)
+			if inv.refs[name] == nil {
+				inv.refs[name] = make(map[string][]string)
+			}
+			if !contains(wl, inv.refs[name][env.Name]) {
+				inv.refs[name][env.Name] = append(inv.refs[name][env.Name], wl)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *secretInventoryReader) referencedSecrets(app, wl, env string, opts []manifest.InterpolatorOption) ([]manifest.Secret, error) {
+	raw, err := r.ws.ReadWorkloadManifest(wl)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	interpolated, err := r.newInterpolator(app, env, opts...).Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables: %w", err)
+	}
+	mft, err := r.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, err
+	}
+	envMft, err := mft.ApplyEnv(env)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", env, err)
+	}
+	type secretsReferencer interface {
+		ReferencedSecrets() []manifest.Secret
+	}
+	referencer, ok := envMft.(secretsReferencer)
+	if !ok {
+		// The workload type doesn't support secrets.
+		return nil, nil
+	}
+	return referencer.ReferencedSecrets(), nil
+}
+
+func newSecretInventoryReader(sessProvider *sessions.Provider) *secretInventoryReader {
+	r := &secretInventoryReader{
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		cmd:             exec.NewCmd(),
+	}
+	r.newSecretLister = func(env *config.Environment) (secretLister, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return ssm.New(sess), nil
+	}
+	// Secrets can be listed outside a workspace, in which case the references from manifests are not checked.
+	if ws, err := workspace.New(); err == nil {
+		r.ws = ws
+	}
+	return r
+}
+
+// secretName returns the name of the secret stored in the SSM parameter, as given to "secret init".
+func secretName(app, env, param string) string {
+	return strings.TrimPrefix(param, fmt.Sprintf(fmtSecretParameterName, app, env, ""))
+}
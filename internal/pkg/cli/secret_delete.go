@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretDeleteNamePrompt = "Which secret would you like to delete?"
+
+	fmtSecretDeleteConfirmPrompt        = "Are you sure you want to delete secret %s from application %s?"
+	fmtSecretDeleteFromEnvConfirmPrompt = "Are you sure you want to delete secret %s from environment %s?"
+	secretDeleteConfirmHelp             = "This will delete the secret from all the environments of the application."
+	fmtSecretDeleteFromEnvConfirmHelp   = "This will delete the secret from just the %s environment."
+)
+
+var errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+
+type secretDeleteVars struct {
+	appName          string
+	name             string
+	envName          string
+	skipConfirmation bool
+}
+
+type secretDeleteOpts struct {
+	secretDeleteVars
+
+	store     store
+	sel       appSelector
+	prompt    prompter
+	inventory *secretInventoryReader
+
+	newSecretDeleter func(env *config.Environment) (ssmSecretDeleter, error)
+}
+
+func newSecretDeleteOpts(vars secretDeleteVars) (*secretDeleteOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret delete"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &secretDeleteOpts{
+		secretDeleteVars: vars,
+		store:            store,
+		sel:              selector.NewAppEnvSelector(prompter, store),
+		prompt:           prompter,
+		inventory:        newSecretInventoryReader(sessProvider),
+		newSecretDeleter: func(env *config.Environment) (ssmSecretDeleter, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ssm.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretDeleteOpts) Validate() error {
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application and the name of the secret if they are not provided, and confirms the deletion.
+func (o *secretDeleteOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("get application %s: %w", o.appName, err)
+		}
+	} else {
+		app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNameHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+	}
+	if o.name == "" {
+		name, err := o.prompt.Get(secretDeleteNamePrompt, secretInitSecretNamePromptHelp, validateSecretName,
+			prompt.WithFinalMessage("Secret name:"))
+		if err != nil {
+			return fmt.Errorf("ask for the secret name: %w", err)
+		}
+		o.name = name
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+
+	deletePrompt := fmt.Sprintf(fmtSecretDeleteConfirmPrompt, color.HighlightUserInput(o.name), o.appName)
+	deleteConfirmHelp := secretDeleteConfirmHelp
+	if o.envName != "" {
+		deletePrompt = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, color.HighlightUserInput(o.name), o.envName)
+		deleteConfirmHelp = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmHelp, o.envName)
+	}
+	confirmed, err := o.prompt.Confirm(deletePrompt, deleteConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("secret delete confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the environments where it exists.
+func (o *secretDeleteOpts) Execute() error {
+	envs, err := o.targetEnvs()
+	if err != nil {
+		return err
+	}
+	inv, err := o.inventory.read(o.appName, envs)
+	if err != nil {
+		return err
+	}
+	deleted := false
+	for _, env := range envs {
+		param, ok := inv.parameter(o.name, env.Name)
+		if !ok {
+			continue
+		}
+		if wls := inv.refs[o.name][env.Name]; len(wls) != 0 {
+			log.Warningf("Secret %s is still referenced in environment %s by %s. Remove it from their manifests before you deploy them again.\n",
+				color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name), strings.Join(wls, ", "))
+		}
+		deleter, err := o.newSecretDeleter(env)
+		if err != nil {
+			return err
+		}
+		if err := deleter.DeleteSecret(param.Name); err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, env.Name, err)
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+		deleted = true
+	}
+	if !deleted {
+		if o.envName != "" {
+			return fmt.Errorf("secret %s not found in environment %s", o.name, o.envName)
+		}
+		return fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+	return nil
+}
+
+func (o *secretDeleteOpts) targetEnvs() ([]*config.Environment, error) {
+	if o.envName != "" {
+		env, err := o.store.GetEnvironment(o.appName, o.envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	return envs, nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := secretDeleteVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from the environments of an application.",
+		Long: `Deletes a secret from all the environments of an application, or from a single environment.
+Warns about the workloads in your workspace that still reference the secret.`,
+		Example: `
+  Delete the "db_password" secret from all the environments of the "my-app" application.
+  /code $ copilot secret delete -a my-app -n db_password
+  Delete the "db_password" secret from the "test" environment without confirmation.
+  /code $ copilot secret delete -a my-app -n db_password -e test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretDeleteOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretDeleteEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretDeleteOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv              string
+		inSkipConfirmation bool
+		setupMocks         func(store *mocks.Mockstore, prompt *mocks.Mockprompter)
+
+		wantedError error
+	}{
+		"confirms the deletion from all the environments": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtSecretDeleteConfirmPrompt, "db_password", "my-app"), secretDeleteConfirmHelp, gomock.Any()).Return(true, nil)
+			},
+		},
+		"confirms the deletion from a single environment": {
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{Name: "test"}, nil)
+				prompt.EXPECT().Confirm(fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, "db_password", "test"), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+		},
+		"skips the confirmation": {
+			inSkipConfirmation: true,
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+		"returns an error if the deletion is cancelled": {
+			setupMocks: func(store *mocks.Mockstore, prompt *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSecretDeleteCancelled,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(mockStore, mockPrompt)
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName:          "my-app",
+					name:             "db_password",
+					envName:          tc.inEnv,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  mockStore,
+				prompt: mockPrompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSecretDeleteOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(store *mocks.Mockstore, inv secretInventoryMocks, deleters map[string]*mocks.MockssmSecretDeleter)
+
+		wantedError error
+	}{
+		"deletes the secret from the environments where it exists": {
+			inName: "token",
+			setupMocks: func(store *mocks.Mockstore, inv secretInventoryMocks, deleters map[string]*mocks.MockssmSecretDeleter) {
+				store.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.expectSecrets()
+				deleters["test"].EXPECT().DeleteSecret("/copilot/my-app/test/secrets/token").Return(nil)
+			},
+		},
+		"wraps the error from deleting the secret": {
+			inName: "db_password",
+			setupMocks: func(store *mocks.Mockstore, inv secretInventoryMocks, deleters map[string]*mocks.MockssmSecretDeleter) {
+				store.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.expectSecrets()
+				deleters["test"].EXPECT().DeleteSecret("/copilot/my-app/test/secrets/db_password").Return(nil)
+				deleters["prod"].EXPECT().DeleteSecret("/copilot/my-app/prod/secrets/db_password").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db_password from environment prod: some error"),
+		},
+		"returns an error if the secret doesn't exist in any environment": {
+			inName: "api_key",
+			setupMocks: func(store *mocks.Mockstore, inv secretInventoryMocks, deleters map[string]*mocks.MockssmSecretDeleter) {
+				store.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.expectSecrets()
+			},
+			wantedError: errors.New("secret api_key not found in application my-app"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			inventory, inventoryMocks := newSecretTestInventory(ctrl)
+			deleters := map[string]*mocks.MockssmSecretDeleter{
+				"test": mocks.NewMockssmSecretDeleter(ctrl),
+				"prod": mocks.NewMockssmSecretDeleter(ctrl),
+			}
+			tc.setupMocks(mockStore, inventoryMocks, deleters)
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName: "my-app",
+					name:    tc.inName,
+				},
+				store:     mockStore,
+				inventory: inventory,
+				newSecretDeleter: func(env *config.Environment) (ssmSecretDeleter, error) {
+					return deleters[env.Name], nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
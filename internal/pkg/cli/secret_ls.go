@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretExistsMark  = "✔"
+	secretMissingMark = "✘"
+
+	// Display settings of the secrets matrix.
+	secretMinCellWidth     = 10
+	secretTabWidth         = 4
+	secretCellPaddingWidth = 2
+	secretPaddingChar      = ' '
+)
+
+type secretListVars struct {
+	appName          string
+	shouldOutputJSON bool
+}
+
+type secretListOpts struct {
+	secretListVars
+
+	store     store
+	sel       appSelector
+	inventory *secretInventoryReader
+	w         io.Writer
+}
+
+func newSecretListOpts(vars secretListVars) (*secretListOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &secretListOpts{
+		secretListVars: vars,
+		store:          store,
+		sel:            selector.NewAppEnvSelector(prompt.New(), store),
+		inventory:      newSecretInventoryReader(sessProvider),
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *secretListOpts) Validate() error {
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *secretListOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNameHelp)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets of the application in each environment, and the workloads that reference them.
+func (o *secretListOpts) Execute() error {
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	inv, err := o.inventory.read(o.appName, envs)
+	if err != nil {
+		return err
+	}
+
+	var out string
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(inv)
+		if err != nil {
+			return err
+		}
+		out = data
+	} else {
+		out = o.humanOutput(inv)
+	}
+	fmt.Fprint(o.w, out)
+
+	for _, name := range inv.names() {
+		inv.warnMissing(name)
+	}
+	return nil
+}
+
+// humanOutput renders a matrix of the secrets across the environments of the application.
+func (o *secretListOpts) humanOutput(inv *secretInventory) string {
+	b := &strings.Builder{}
+	writer := tabwriter.NewWriter(b, secretMinCellWidth, secretTabWidth, secretCellPaddingWidth, secretPaddingChar, 0)
+	headers := append(append([]string{"Name"}, inv.envs...), "Used by")
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlineHeaders(headers), "\t"))
+	for _, name := range inv.names() {
+		row := []string{name}
+		for _, env := range inv.envs {
+			mark := secretExistsMark
+			if _, ok := inv.parameter(name, env); !ok {
+				mark = secretMissingMark
+			}
+			row = append(row, mark)
+		}
+		wls := "-"
+		if refs := inv.workloads(name); len(refs) != 0 {
+			wls = strings.Join(refs, ", ")
+		}
+		row = append(row, wls)
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (o *secretListOpts) jsonOutput(inv *secretInventory) (string, error) {
+	type serializedSecrets struct {
+		Secrets []secretJSON `json:"secrets"`
+	}
+	secrets := make([]secretJSON, 0, len(inv.names()))
+	for _, name := range inv.names() {
+		secrets = append(secrets, inv.toJSON(name))
+	}
+	b, err := json.Marshal(serializedSecrets{Secrets: secrets})
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := secretListVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application in each environment.",
+		Long: `Lists the secrets of an application in each environment, and the workloads in your workspace that reference them.
+Warns about the manifests that reference a secret that doesn't exist in an environment.`,
+		Example: `
+  Lists the secrets of the "my-app" application.
+  /code $ copilot secret ls -a my-app`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretListOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
+
+func underlineHeaders(headers []string) []string {
+	var lines []string
+	for _, header := range headers {
+		lines = append(lines, strings.Repeat("-", len(header)))
+	}
+	return lines
+}
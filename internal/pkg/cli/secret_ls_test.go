@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var secretTestLastModified = time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+const secretTestAPIManifest = `name: api
+type: Backend Service
+image:
+  location: nginx
+secrets:
+  DB_PASSWORD: /copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/db_password
+  TOKEN: /copilot/${COPILOT_APPLICATION_NAME}/${COPILOT_ENVIRONMENT_NAME}/secrets/token
+  GITHUB_TOKEN:
+    secretsmanager: github-token
+`
+
+type secretInventoryMocks struct {
+	ws      *mocks.MockwsWlReader
+	listers map[string]*mocks.MocksecretLister
+}
+
+// newSecretTestInventory returns a reader where "db_password" exists in the "test" and "prod" environments,
+// "token" only exists in "test", and the "api" service references both.
+func newSecretTestInventory(ctrl *gomock.Controller) (*secretInventoryReader, secretInventoryMocks) {
+	m := secretInventoryMocks{
+		ws: mocks.NewMockwsWlReader(ctrl),
+		listers: map[string]*mocks.MocksecretLister{
+			"test": mocks.NewMocksecretLister(ctrl),
+			"prod": mocks.NewMocksecretLister(ctrl),
+		},
+	}
+	return &secretInventoryReader{
+		ws: m.ws,
+		newSecretLister: func(env *config.Environment) (secretLister, error) {
+			return m.listers[env.Name], nil
+		},
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+	}, m
+}
+
+func (m secretInventoryMocks) expectSecrets() {
+	m.listers["test"].EXPECT().ListSecrets(map[string]string{
+		deploy.AppTagKey: "my-app",
+		deploy.EnvTagKey: "test",
+	}).Return([]ssm.Secret{
+		{Name: "/copilot/my-app/test/secrets/db_password", Version: 2, LastModifiedDate: secretTestLastModified},
+		{Name: "/copilot/my-app/test/secrets/token", Version: 1, LastModifiedDate: secretTestLastModified},
+	}, nil)
+	m.listers["prod"].EXPECT().ListSecrets(map[string]string{
+		deploy.AppTagKey: "my-app",
+		deploy.EnvTagKey: "prod",
+	}).Return([]ssm.Secret{
+		{Name: "/copilot/my-app/prod/secrets/db_password", Version: 1, LastModifiedDate: secretTestLastModified},
+	}, nil)
+	m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil).Times(2)
+	m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(secretTestAPIManifest), nil).Times(2)
+}
+
+var secretTestEnvs = []*config.Environment{
+	{App: "my-app", Name: "test"},
+	{App: "my-app", Name: "prod"},
+}
+
+func TestSecretListOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(m *mocks.Mockstore, inv secretInventoryMocks)
+
+		wantedOut   string
+		wantedError error
+	}{
+		"renders the secrets across the environments": {
+			setupMocks: func(m *mocks.Mockstore, inv secretInventoryMocks) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.expectSecrets()
+			},
+			wantedOut: `Name         test      prod      Used by
+----         ----      ----      -------
+db_password  ✔         ✔         api
+token        ✔         ✘         api
+`,
+		},
+		"renders the secrets in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.Mockstore, inv secretInventoryMocks) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.expectSecrets()
+			},
+			wantedOut: `{"secrets":[` +
+				`{"name":"db_password","environments":[` +
+				`{"environment":"test","exists":true,"parameter":"/copilot/my-app/test/secrets/db_password","version":2,"lastModified":"2022-10-01T12:00:00Z","workloads":["api"]},` +
+				`{"environment":"prod","exists":true,"parameter":"/copilot/my-app/prod/secrets/db_password","version":1,"lastModified":"2022-10-01T12:00:00Z","workloads":["api"]}]},` +
+				`{"name":"token","environments":[` +
+				`{"environment":"test","exists":true,"parameter":"/copilot/my-app/test/secrets/token","version":1,"lastModified":"2022-10-01T12:00:00Z","workloads":["api"]},` +
+				`{"environment":"prod","exists":false,"workloads":["api"]}]}]}` + "\n",
+		},
+		"wraps the error from listing the secrets of an environment": {
+			setupMocks: func(m *mocks.Mockstore, inv secretInventoryMocks) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				m.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+				inv.listers["test"].EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets in environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			inventory, inventoryMocks := newSecretTestInventory(ctrl)
+			tc.setupMocks(mockStore, inventoryMocks)
+			buf := new(bytes.Buffer)
+			opts := &secretListOpts{
+				secretListVars: secretListVars{
+					appName:          "my-app",
+					shouldOutputJSON: tc.inJSON,
+				},
+				store:     mockStore,
+				inventory: inventory,
+				w:         buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowNamePrompt = "Which secret would you like to show?"
+)
+
+type secretShowVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type secretShowOpts struct {
+	secretShowVars
+
+	store     store
+	sel       appSelector
+	prompt    prompter
+	inventory *secretInventoryReader
+	w         io.Writer
+}
+
+func newSecretShowOpts(vars secretShowVars) (*secretShowOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &secretShowOpts{
+		secretShowVars: vars,
+		store:          store,
+		sel:            selector.NewAppEnvSelector(prompter, store),
+		prompt:         prompter,
+		inventory:      newSecretInventoryReader(sessProvider),
+		w:              os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretShowOpts) Validate() error {
+	if o.name != "" {
+		return validateSecretName(o.name)
+	}
+	return nil
+}
+
+// Ask prompts for the application and the name of the secret if they are not provided.
+func (o *secretShowOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNameHelp)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.prompt.Get(secretShowNamePrompt, secretInitSecretNamePromptHelp, validateSecretName,
+		prompt.WithFinalMessage("Secret name:"))
+	if err != nil {
+		return fmt.Errorf("ask for the secret name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute shows the SSM parameter of the secret in each environment, and the workloads that reference it.
+// The value of the secret is never retrieved.
+func (o *secretShowOpts) Execute() error {
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return fmt.Errorf("list environments in application %s: %w", o.appName, err)
+	}
+	inv, err := o.inventory.read(o.appName, envs)
+	if err != nil {
+		return err
+	}
+	if !contains(o.name, inv.names()) {
+		return fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+
+	if o.shouldOutputJSON {
+		b, err := json.Marshal(inv.toJSON(o.name))
+		if err != nil {
+			return fmt.Errorf("marshal secret %s: %w", o.name, err)
+		}
+		fmt.Fprintf(o.w, "%s\n", b)
+	} else {
+		fmt.Fprint(o.w, o.humanOutput(inv))
+	}
+	inv.warnMissing(o.name)
+	return nil
+}
+
+func (o *secretShowOpts) humanOutput(inv *secretInventory) string {
+	b := &strings.Builder{}
+	fmt.Fprint(b, color.Bold.Sprint("About\n\n"))
+	writer := tabwriter.NewWriter(b, secretMinCellWidth, secretTabWidth, secretCellPaddingWidth, secretPaddingChar, 0)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", o.name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", o.appName)
+	writer.Flush()
+	fmt.Fprint(b, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer = tabwriter.NewWriter(b, secretMinCellWidth, secretTabWidth, secretCellPaddingWidth, secretPaddingChar, 0)
+	headers := []string{"Environment", "Parameter", "Version", "Last Modified", "Used by"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underlineHeaders(headers), "\t"))
+	for _, env := range inv.envs {
+		param, version, lastModified := secretMissingMark, "-", "-"
+		if p, ok := inv.parameter(o.name, env); ok {
+			param, version, lastModified = p.Name, strconv.FormatInt(p.Version, 10), p.LastModifiedDate.Format(time.RFC3339)
+		}
+		wls := "-"
+		if refs := inv.refs[o.name][env]; len(refs) != 0 {
+			wls = strings.Join(refs, ", ")
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join([]string{env, param, version, lastModified, wls}, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// buildSecretShowCmd builds the command for showing a secret across the environments of an application.
+func buildSecretShowCmd() *cobra.Command {
+	vars := secretShowVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows a secret in each environment of an application.",
+		Long: `Shows the SSM parameter of a secret in each environment of an application, and the workloads in your workspace that reference it.
+The value of the secret is never shown.`,
+		Example: `
+  Shows the "db_password" secret of the "my-app" application.
+  /code $ copilot secret show -a my-app -n db_password`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretShowOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretShowOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+		inJSON bool
+
+		wantedOut   string
+		wantedError error
+	}{
+		"renders the secret in each environment": {
+			inName: "token",
+			wantedOut: color.Bold.Sprint("About\n\n") +
+				`  Name         token
+  Application  my-app
+` + color.Bold.Sprint("\nEnvironments\n\n") +
+				`  Environment  Parameter                           Version   Last Modified         Used by
+  -----------  ---------                           -------   -------------         -------
+  test         /copilot/my-app/test/secrets/token  1         2022-10-01T12:00:00Z  api
+  prod         ✘                                   -         -                     api
+`,
+		},
+		"renders the secret in JSON": {
+			inName: "token",
+			inJSON: true,
+			wantedOut: `{"name":"token","environments":[` +
+				`{"environment":"test","exists":true,"parameter":"/copilot/my-app/test/secrets/token","version":1,"lastModified":"2022-10-01T12:00:00Z","workloads":["api"]},` +
+				`{"environment":"prod","exists":false,"workloads":["api"]}]}` + "\n",
+		},
+		"returns an error if the secret doesn't exist nor is referenced": {
+			inName:      "api_key",
+			wantedError: errors.New("secret api_key not found in application my-app"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			mockStore.EXPECT().ListEnvironments("my-app").Return(secretTestEnvs, nil)
+			inventory, inventoryMocks := newSecretTestInventory(ctrl)
+			inventoryMocks.expectSecrets()
+			buf := new(bytes.Buffer)
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName:          "my-app",
+					name:             tc.inName,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store:     mockStore,
+				inventory: inventory,
+				w:         buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
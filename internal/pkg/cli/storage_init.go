@@ -7,6 +7,8 @@ import (
 	"encoding"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -22,34 +24,55 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType   = "DynamoDB"
+	s3StorageType         = "S3"
+	rdsStorageType        = "Aurora"
+	redisStorageType      = "Redis"
+	openSearchStorageType = "OpenSearch"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	openSearchStorageType,
+}
+
+// Lifecycles of a storage resource.
+const (
+	workloadStorageLifecycle    = "workload"
+	environmentStorageLifecycle = "environment"
+)
+
+var storageLifecycles = []string{
+	workloadStorageLifecycle,
+	environmentStorageLifecycle,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption   = "DynamoDB"
+	s3StorageTypeOption         = "S3"
+	rdsStorageTypeOption        = "Aurora Serverless"
+	redisStorageTypeOption      = "ElastiCache Redis"
+	openSearchStorageTypeOption = "OpenSearch"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:   dynamoDBStorageType,
+	s3StorageTypeOption:         s3StorageType,
+	rdsStorageTypeOption:        rdsStorageType,
+	redisStorageTypeOption:      redisStorageType,
+	openSearchStorageTypeOption: openSearchStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -65,21 +88,34 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "In-memory",
+	},
+	openSearchStorageType: {
+		Value: openSearchStorageTypeOption,
+		Hint:  "Search",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Cluster"
+	openSearchFriendlyText    = "OpenSearch Domain"
 )
 
 // General-purpose prompts, collected for all storage resources.
 var (
 	fmtStorageInitTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to associate with %s?"
+	fmtStorageInitEnvOwner   = "the environments of %s"
 	storageInitTypeHelp      = `The type of storage you'd like to add to your workload. 
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is a fully managed in-memory data store, used as a cache, a session store or a message broker.
+OpenSearch is a fully managed search and analytics engine for full-text search and log analytics.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -128,6 +164,51 @@ var attributeTypes = []string{
 var (
 	storageInitRDSInitialDBNamePrompt = "What would you like to name the initial database in your cluster?"
 	storageInitRDSDBEnginePrompt      = "Which database engine would you like to use?"
+
+	storageInitRDSCapacityModePrompt = "How would you like to manage the " + color.Emphasize("capacity") + " of your cluster?"
+	storageInitRDSCapacityModeHelp   = `Aurora Serverless v1 pauses when idle and scales in coarse steps.
+Aurora Serverless v2 scales instantly in fine-grained increments and supports reader DB instances.
+A provisioned cluster runs DB instances of a fixed instance class.`
+	storageInitRDSMinCapacityPrompt   = "What is the " + color.Emphasize("minimum capacity") + " of your cluster in Aurora capacity units (ACUs)?"
+	storageInitRDSMaxCapacityPrompt   = "What is the " + color.Emphasize("maximum capacity") + " of your cluster in Aurora capacity units (ACUs)?"
+	storageInitRDSCapacityHelp        = "Each ACU is a combination of approximately 2 gibibytes (GiB) of memory, corresponding CPU, and networking. Must be between 0.5 and 128, in increments of 0.5."
+	storageInitRDSInstanceClassPrompt = "Which " + color.Emphasize("instance class") + " would you like to use for your DB instances?"
+	storageInitRDSInstanceClassHelp   = "The compute and memory capacity of the DB instances. You can pick a different instance class later by updating the template."
+	storageInitRDSReadersPrompt       = "How many " + color.Emphasize("reader DB instances") + " would you like to add to your cluster?"
+	storageInitRDSReadersHelp         = "Reader DB instances serve read-only queries and take over as the writer if the writer DB instance fails."
+)
+
+var rdsCapacityModeOptions = map[string]prompt.Option{
+	addon.RDSCapacityModeServerlessV1: {Value: addon.RDSCapacityModeServerlessV1, Hint: "Pauses when idle"},
+	addon.RDSCapacityModeServerlessV2: {Value: addon.RDSCapacityModeServerlessV2, Hint: "Scales instantly"},
+	addon.RDSCapacityModeProvisioned:  {Value: addon.RDSCapacityModeProvisioned, Hint: "Fixed instance class"},
+}
+
+// ElastiCache Redis and OpenSearch specific questions and help prompts.
+var (
+	fmtStorageInitEngineVersionPrompt = "Which " + color.Emphasize("engine version") + " would you like to use for your %s?"
+	fmtStorageInitNodeTypePrompt      = "Which " + color.Emphasize("node type") + " would you like to use for your %s?"
+	storageInitNodeTypeHelp           = "The compute and memory capacity of the nodes. You can pick a different node type later by updating the template."
+
+	storageInitRedisClusterModeConfirm      = "Would you like to enable " + color.Emphasize("cluster mode") + " to partition your data across multiple shards?"
+	storageInitRedisClusterModeHelp         = "With cluster mode, the data is partitioned across shards so that the cluster can scale beyond the memory of a single node."
+	storageInitOpenSearchClusterModeConfirm = "Would you like to spread the data nodes of your domain across two " + color.Emphasize("Availability Zones") + "?"
+	storageInitOpenSearchClusterModeHelp    = "With two Availability Zones, the domain runs two data nodes and stays available if one of the zones fails."
+
+	storageInitKMSEncryptionConfirm = "Would you like to encrypt your data at rest with a " + color.Emphasize("customer managed KMS key") + "?"
+	storageInitKMSEncryptionHelp    = `Your data is always encrypted at rest and in transit. By default, it is encrypted with a key managed by AWS.
+A customer managed KMS key lets you control the key policy and audit the usage of the key, for an additional cost.`
+)
+
+// ElastiCache Redis and OpenSearch specific constants and variables.
+const (
+	redisNodeTypePrefix          = "cache."
+	openSearchInstanceTypeSuffix = ".search"
+)
+
+var (
+	redisNodeTypes          = []string{"cache.t3.micro", "cache.t3.small", "cache.t3.medium", "cache.m6g.large", "cache.r6g.large"}
+	openSearchInstanceTypes = []string{"t3.medium.search", "m6g.large.search", "r6g.large.search"}
 )
 
 // RDS Aurora Serverless specific constants and variables.
@@ -136,8 +217,20 @@ const (
 
 	engineTypeMySQL      = "MySQL"
 	engineTypePostgreSQL = "PostgreSQL"
+
+	rdsMinCapacityUnits       = 0.5
+	rdsMaxCapacityUnits       = 128
+	rdsMinCapacityDefault     = "0.5"
+	rdsMaxCapacityDefault     = "8"
+	rdsInstanceClassPrefix    = "db."
+	rdsMaxReaders             = 15
+	rdsReadersDefault         = "0"
+	rdsMinBackupRetentionDays = 1
+	rdsMaxBackupRetentionDays = 35
 )
 
+var rdsInstanceClasses = []string{"db.t4g.medium", "db.r6g.large", "db.r6g.xlarge", "db.r6g.2xlarge"}
+
 var engineTypes = []string{
 	engineTypeMySQL,
 	engineTypePostgreSQL,
@@ -149,6 +242,8 @@ type initStorageVars struct {
 	storageType  string
 	storageName  string
 	workloadName string
+	lifecycle    string
+	grants       []string // Workloads that access a storage resource with an environment lifecycle.
 
 	// Dynamo DB specific values collected via flags or prompts
 	partitionKey string
@@ -161,6 +256,21 @@ type initStorageVars struct {
 	rdsEngine         string
 	rdsParameterGroup string
 	rdsInitialDBName  string
+
+	rdsCapacityMode        string
+	rdsMinCapacity         float64
+	rdsMaxCapacity         float64
+	rdsInstanceClass       string
+	rdsReaders             int
+	rdsBackupRetention     int
+	rdsDeletionPolicy      string
+	rdsPerformanceInsights bool
+
+	// ElastiCache Redis and OpenSearch specific values collected via flags or prompts
+	engineVersion string
+	nodeType      string
+	clusterMode   bool
+	kmsEncryption bool
 }
 
 type initStorageOpts struct {
@@ -176,6 +286,13 @@ type initStorageOpts struct {
 
 	// Cached data.
 	workloadType string
+	interactive  bool // True if the user is prompted for required inputs that weren't set with flags.
+
+	promptForClusterMode   bool // True if the cluster mode flag was not set explicitly.
+	promptForKMSEncryption bool // True if the KMS encryption flag was not set explicitly.
+
+	promptForRDSCapacityMode bool // True if the capacity mode flag was not set explicitly.
+	promptForRDSReaders      bool // True if the readers flag was not set explicitly.
 }
 
 func newStorageInitOpts(vars initStorageVars) (*initStorageOpts, error) {
@@ -208,8 +325,11 @@ func (o *initStorageOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if err := o.validateLifecycle(); err != nil {
+		return err
+	}
 	if o.workloadName != "" {
-		if err := o.validateWorkloadName(); err != nil {
+		if err := o.validateWorkloadName(o.workloadName); err != nil {
 			return err
 		}
 	}
@@ -225,7 +345,7 @@ func (o *initStorageOpts) Validate() error {
 			err = dynamoTableNameValidation(o.storageName)
 		case s3StorageType:
 			err = s3BucketNameValidation(o.storageName)
-		case rdsStorageType:
+		case rdsStorageType, redisStorageType, openSearchStorageType:
 			err = rdsNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
@@ -238,12 +358,143 @@ func (o *initStorageOpts) Validate() error {
 	if err := o.validateDDB(); err != nil {
 		return err
 	}
+	if err := o.validateRedisOpenSearch(); err != nil {
+		return err
+	}
 
 	if o.rdsEngine != "" {
 		if err := validateEngine(o.rdsEngine); err != nil {
 			return err
 		}
 	}
+	return o.validateRDS()
+}
+
+// validateRDS validates the Aurora flags that don't depend on the capacity mode.
+func (o *initStorageOpts) validateRDS() error {
+	if o.rdsCapacityMode != "" {
+		if err := validateRDSCapacityMode(o.rdsCapacityMode); err != nil {
+			return err
+		}
+	}
+	for _, acu := range []float64{o.rdsMinCapacity, o.rdsMaxCapacity} {
+		if acu == 0 {
+			continue
+		}
+		if err := validateRDSCapacityUnits(acu); err != nil {
+			return err
+		}
+	}
+	if o.rdsMinCapacity != 0 && o.rdsMaxCapacity != 0 && o.rdsMinCapacity > o.rdsMaxCapacity {
+		return fmt.Errorf("minimum capacity %v must not exceed maximum capacity %v", o.rdsMinCapacity, o.rdsMaxCapacity)
+	}
+	if o.rdsInstanceClass != "" {
+		if err := validateRDSInstanceClass(o.rdsInstanceClass); err != nil {
+			return err
+		}
+	}
+	if o.rdsReaders < 0 || o.rdsReaders > rdsMaxReaders {
+		return errInvalidReaders
+	}
+	if o.rdsBackupRetention != 0 && (o.rdsBackupRetention < rdsMinBackupRetentionDays || o.rdsBackupRetention > rdsMaxBackupRetentionDays) {
+		return errInvalidBackupRetention
+	}
+	if o.rdsDeletionPolicy != "" {
+		if err := validateRDSDeletionPolicy(o.rdsDeletionPolicy); err != nil {
+			return err
+		}
+	}
+	return o.validateRDSCapacityModeFlags()
+}
+
+// validateRDSCapacityModeFlags validates the Aurora flags that depend on the capacity mode.
+// The capacity mode defaults to Aurora Serverless v1 if it's not set.
+func (o *initStorageOpts) validateRDSCapacityModeFlags() error {
+	mode := o.rdsCapacityMode
+	if mode == "" {
+		mode = addon.RDSCapacityModeServerlessV1
+	}
+	if mode != addon.RDSCapacityModeServerlessV2 && (o.rdsMinCapacity != 0 || o.rdsMaxCapacity != 0) {
+		return fmt.Errorf(`--%s and --%s require the %q capacity mode`, storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag, addon.RDSCapacityModeServerlessV2)
+	}
+	if mode != addon.RDSCapacityModeProvisioned && o.rdsInstanceClass != "" {
+		return fmt.Errorf(`--%s requires the %q capacity mode`, storageRDSInstanceClassFlag, addon.RDSCapacityModeProvisioned)
+	}
+	if mode == addon.RDSCapacityModeServerlessV1 && (o.rdsReaders != 0 || o.rdsPerformanceInsights) {
+		return fmt.Errorf(`--%s and --%s are not supported by the %q capacity mode`, storageRDSReadersFlag, storageRDSInsightsFlag, addon.RDSCapacityModeServerlessV1)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateLifecycle() error {
+	if o.lifecycle != "" && !contains(o.lifecycle, storageLifecycles) {
+		return fmt.Errorf("invalid lifecycle %s: must be one of %s", o.lifecycle, prettify(storageLifecycles))
+	}
+	if o.lifecycle != environmentStorageLifecycle {
+		if len(o.grants) != 0 {
+			return fmt.Errorf("--%s can only be used with --%s %s", storageGrantFlag, storageLifecycleFlag, environmentStorageLifecycle)
+		}
+		return nil
+	}
+	if o.workloadName != "" {
+		return fmt.Errorf("cannot specify --%s and --%s %s at once", workloadFlag, storageLifecycleFlag, environmentStorageLifecycle)
+	}
+	for _, wl := range o.grants {
+		if err := o.validateWorkloadName(wl); err != nil {
+			return err
+		}
+		mft, err := o.ws.ReadWorkloadManifest(wl)
+		if err != nil {
+			return fmt.Errorf("read manifest for %s: %w", wl, err)
+		}
+		wlType, err := mft.WorkloadType()
+		if err != nil {
+			return fmt.Errorf("read 'type' from manifest for %s: %w", wl, err)
+		}
+		if wlType == manifest.RequestDrivenWebServiceType || wlType == manifest.StaticSiteType {
+			return fmt.Errorf("cannot grant %s access to the storage: %s does not support environment addons", wl, wlType)
+		}
+	}
+	return nil
+}
+
+// validateRedisOpenSearch validates the flags of ElastiCache Redis clusters and OpenSearch domains, and rejects them for other storage types.
+// It's a no-op until the storage type is known.
+func (o *initStorageOpts) validateRedisOpenSearch() error {
+	switch o.storageType {
+	case "":
+		return nil
+	case redisStorageType, openSearchStorageType:
+	default:
+		for _, f := range []struct {
+			name string
+			set  bool
+		}{
+			{name: storageEngineVersionFlag, set: o.engineVersion != ""},
+			{name: storageNodeTypeFlag, set: o.nodeType != ""},
+			{name: storageClusterModeFlag, set: o.clusterMode},
+			{name: storageKMSEncryptionFlag, set: o.kmsEncryption},
+		} {
+			if f.set {
+				return fmt.Errorf("--%s can only be used with --%s %s or %s", f.name, storageTypeFlag, redisStorageType, openSearchStorageType)
+			}
+		}
+		return nil
+	}
+	validateVersion, validateNodeType := validateRedisEngineVersion, validateRedisNodeType
+	if o.storageType == openSearchStorageType {
+		validateVersion, validateNodeType = validateOpenSearchEngineVersion, validateOpenSearchInstanceType
+	}
+	if o.engineVersion != "" {
+		if err := validateVersion(o.engineVersion); err != nil {
+			return err
+		}
+	}
+	if o.nodeType != "" {
+		if err := validateNodeType(o.nodeType); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -276,12 +527,21 @@ func (o *initStorageOpts) validateDDB() error {
 }
 
 func (o *initStorageOpts) Ask() error {
+	o.interactive = o.storageType == "" || o.storageName == "" ||
+		(o.workloadName == "" && o.lifecycle != environmentStorageLifecycle)
 	if err := o.askStorageWl(); err != nil {
 		return err
 	}
+	typeSelected := o.storageType == ""
 	if err := o.askStorageType(); err != nil {
 		return err
 	}
+	if typeSelected {
+		// The flags that depend on the storage type could not be validated before it was selected.
+		if err := o.validateRedisOpenSearch(); err != nil {
+			return err
+		}
+	}
 
 	// Storage name needs to be asked after workload because for Aurora the default storage name uses the workload name.
 	if err := o.askStorageName(); err != nil {
@@ -299,6 +559,7 @@ func (o *initStorageOpts) Ask() error {
 			return err
 		}
 	case rdsStorageType:
+		o.interactive = o.interactive || o.rdsEngine == "" || o.rdsInitialDBName == ""
 		if err := o.askAuroraEngineType(); err != nil {
 			return err
 		}
@@ -306,6 +567,31 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.askAuroraInitialDBName(); err != nil {
 			return err
 		}
+		if err := o.askAuroraCapacityMode(); err != nil {
+			return err
+		}
+		if err := o.askAuroraCapacity(); err != nil {
+			return err
+		}
+		if err := o.askAuroraInstanceClass(); err != nil {
+			return err
+		}
+		if err := o.askAuroraReaders(); err != nil {
+			return err
+		}
+	case redisStorageType, openSearchStorageType:
+		if err := o.askEngineVersion(); err != nil {
+			return err
+		}
+		if err := o.askNodeType(); err != nil {
+			return err
+		}
+		if err := o.askClusterMode(); err != nil {
+			return err
+		}
+		if err := o.askKMSEncryption(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -318,8 +604,11 @@ func (o *initStorageOpts) askStorageType() error {
 	for _, st := range storageTypes {
 		options = append(options, storageTypeOptions[st])
 	}
-	storageTypeOption, err := o.prompt.SelectOption(fmt.Sprintf(
-		fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName)),
+	owner := color.HighlightUserInput(o.workloadName)
+	if o.lifecycle == environmentStorageLifecycle {
+		owner = fmt.Sprintf(fmtStorageInitEnvOwner, color.HighlightUserInput(o.appName))
+	}
+	storageTypeOption, err := o.prompt.SelectOption(fmt.Sprintf(fmtStorageInitTypePrompt, owner),
 		storageInitTypeHelp,
 		options,
 		prompt.WithFinalMessage("Storage type:"))
@@ -376,8 +665,18 @@ func (o *initStorageOpts) askStorageName() error {
 	case dynamoDBStorageType:
 		validator = dynamoTableNameValidation
 		friendlyText = dynamoDBTableFriendlyText
+	case redisStorageType:
+		validator = rdsNameValidation
+		friendlyText = redisFriendlyText
+	case openSearchStorageType:
+		validator = rdsNameValidation
+		friendlyText = openSearchFriendlyText
 	case rdsStorageType:
-		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+		owner := o.workloadName
+		if o.lifecycle == environmentStorageLifecycle {
+			owner = o.appName
+		}
+		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, owner), rdsNameValidation)
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -393,7 +692,7 @@ func (o *initStorageOpts) askStorageName() error {
 }
 
 func (o *initStorageOpts) askStorageWl() error {
-	if o.workloadName != "" {
+	if o.workloadName != "" || o.lifecycle == environmentStorageLifecycle {
 		return nil
 	}
 	workload, err := o.sel.Workload(storageInitSvcPrompt, "")
@@ -586,22 +885,182 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
-func (o *initStorageOpts) validateWorkloadName() error {
+// askAuroraCapacityMode asks for the capacity mode only if the user is already prompted for other inputs,
+// so that scripted runs keep the Aurora Serverless v1 default.
+func (o *initStorageOpts) askAuroraCapacityMode() error {
+	if !o.promptForRDSCapacityMode || !o.interactive {
+		return nil
+	}
+	var options []prompt.Option
+	for _, mode := range addon.RDSCapacityModes {
+		options = append(options, rdsCapacityModeOptions[mode])
+	}
+	mode, err := o.prompt.SelectOption(storageInitRDSCapacityModePrompt,
+		storageInitRDSCapacityModeHelp,
+		options,
+		prompt.WithFinalMessage("Capacity mode:"))
+	if err != nil {
+		return fmt.Errorf("select capacity mode: %w", err)
+	}
+	o.rdsCapacityMode = mode
+	return nil
+}
+
+func (o *initStorageOpts) askAuroraCapacity() error {
+	if o.rdsCapacityMode != addon.RDSCapacityModeServerlessV2 {
+		return nil
+	}
+	if o.rdsMinCapacity == 0 {
+		acu, err := o.askCapacityUnits(storageInitRDSMinCapacityPrompt, rdsMinCapacityDefault, "Minimum capacity:")
+		if err != nil {
+			return fmt.Errorf("input minimum capacity: %w", err)
+		}
+		o.rdsMinCapacity = acu
+	}
+	if o.rdsMaxCapacity == 0 {
+		acu, err := o.askCapacityUnits(storageInitRDSMaxCapacityPrompt, rdsMaxCapacityDefault, "Maximum capacity:")
+		if err != nil {
+			return fmt.Errorf("input maximum capacity: %w", err)
+		}
+		o.rdsMaxCapacity = acu
+	}
+	if o.rdsMinCapacity > o.rdsMaxCapacity {
+		return fmt.Errorf("minimum capacity %v must not exceed maximum capacity %v", o.rdsMinCapacity, o.rdsMaxCapacity)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) askCapacityUnits(msg, defaultValue, finalMsg string) (float64, error) {
+	in, err := o.prompt.Get(msg, storageInitRDSCapacityHelp, validateRDSCapacity,
+		prompt.WithDefaultInput(defaultValue), prompt.WithFinalMessage(finalMsg))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(in, 64)
+}
+
+func (o *initStorageOpts) askAuroraInstanceClass() error {
+	if o.rdsCapacityMode != addon.RDSCapacityModeProvisioned || o.rdsInstanceClass != "" {
+		return nil
+	}
+	class, err := o.prompt.SelectOne(storageInitRDSInstanceClassPrompt,
+		storageInitRDSInstanceClassHelp,
+		rdsInstanceClasses,
+		prompt.WithFinalMessage("Instance class:"))
+	if err != nil {
+		return fmt.Errorf("select instance class: %w", err)
+	}
+	o.rdsInstanceClass = class
+	return nil
+}
+
+func (o *initStorageOpts) askAuroraReaders() error {
+	if !o.promptForRDSReaders {
+		return nil
+	}
+	if o.rdsCapacityMode != addon.RDSCapacityModeServerlessV2 && o.rdsCapacityMode != addon.RDSCapacityModeProvisioned {
+		return nil
+	}
+	in, err := o.prompt.Get(storageInitRDSReadersPrompt, storageInitRDSReadersHelp, validateRDSReaders,
+		prompt.WithDefaultInput(rdsReadersDefault), prompt.WithFinalMessage("Reader DB instances:"))
+	if err != nil {
+		return fmt.Errorf("input number of reader DB instances: %w", err)
+	}
+	readers, err := strconv.Atoi(in)
+	if err != nil {
+		return fmt.Errorf("convert number of reader DB instances %s: %w", in, err)
+	}
+	o.rdsReaders = readers
+	return nil
+}
+
+func (o *initStorageOpts) askEngineVersion() error {
+	if o.engineVersion != "" {
+		return nil
+	}
+	versions, friendlyText := addon.RedisEngineVersions, redisFriendlyText
+	if o.storageType == openSearchStorageType {
+		versions, friendlyText = addon.OpenSearchEngineVersions, openSearchFriendlyText
+	}
+	version, err := o.prompt.SelectOne(fmt.Sprintf(fmtStorageInitEngineVersionPrompt, color.HighlightUserInput(friendlyText)),
+		"",
+		versions,
+		prompt.WithFinalMessage("Engine version:"))
+	if err != nil {
+		return fmt.Errorf("select engine version: %w", err)
+	}
+	o.engineVersion = version
+	return nil
+}
+
+func (o *initStorageOpts) askNodeType() error {
+	if o.nodeType != "" {
+		return nil
+	}
+	nodeTypes, friendlyText := redisNodeTypes, redisFriendlyText
+	if o.storageType == openSearchStorageType {
+		nodeTypes, friendlyText = openSearchInstanceTypes, openSearchFriendlyText
+	}
+	nodeType, err := o.prompt.SelectOne(fmt.Sprintf(fmtStorageInitNodeTypePrompt, color.HighlightUserInput(friendlyText)),
+		storageInitNodeTypeHelp,
+		nodeTypes,
+		prompt.WithFinalMessage("Node type:"))
+	if err != nil {
+		return fmt.Errorf("select node type: %w", err)
+	}
+	o.nodeType = nodeType
+	return nil
+}
+
+func (o *initStorageOpts) askClusterMode() error {
+	if !o.promptForClusterMode {
+		return nil
+	}
+	confirm, help := storageInitRedisClusterModeConfirm, storageInitRedisClusterModeHelp
+	if o.storageType == openSearchStorageType {
+		confirm, help = storageInitOpenSearchClusterModeConfirm, storageInitOpenSearchClusterModeHelp
+	}
+	clusterMode, err := o.prompt.Confirm(confirm, help, prompt.WithFinalMessage("Cluster mode:"))
+	if err != nil {
+		return fmt.Errorf("confirm cluster mode: %w", err)
+	}
+	o.clusterMode = clusterMode
+	return nil
+}
+
+func (o *initStorageOpts) askKMSEncryption() error {
+	if !o.promptForKMSEncryption {
+		return nil
+	}
+	kmsEncryption, err := o.prompt.Confirm(storageInitKMSEncryptionConfirm, storageInitKMSEncryptionHelp,
+		prompt.WithFinalMessage("Customer managed KMS key:"))
+	if err != nil {
+		return fmt.Errorf("confirm KMS encryption: %w", err)
+	}
+	o.kmsEncryption = kmsEncryption
+	return nil
+}
+
+func (o *initStorageOpts) validateWorkloadName(wl string) error {
 	names, err := o.ws.ListWorkloads()
 	if err != nil {
 		return fmt.Errorf("retrieve local workload names: %w", err)
 	}
 	for _, name := range names {
-		if o.workloadName == name {
+		if wl == name {
 			return nil
 		}
 	}
-	return fmt.Errorf("workload %s not found in the workspace", o.workloadName)
+	return fmt.Errorf("workload %s not found in the workspace", wl)
 }
 
 func (o *initStorageOpts) Execute() error {
-	if err := o.readWorkloadType(); err != nil {
-		return err
+	addonsDir := addon.EnvAddonsDirName
+	if o.lifecycle != environmentStorageLifecycle {
+		if err := o.readWorkloadType(); err != nil {
+			return err
+		}
+		addonsDir = o.workloadName
 	}
 
 	addonBlobs, err := o.addonBlobs()
@@ -609,7 +1068,7 @@ func (o *initStorageOpts) Execute() error {
 		return err
 	}
 	for _, addon := range addonBlobs {
-		path, err := o.ws.WriteAddon(addon.blob, o.workloadName, addon.name)
+		path, err := o.ws.WriteAddon(addon.blob, addonsDir, addon.name)
 		if err != nil {
 			e, ok := err.(*workspace.ErrFileExists)
 			if !ok {
@@ -626,10 +1085,57 @@ func (o *initStorageOpts) Execute() error {
 			color.HighlightResource(path),
 		)
 	}
+	for _, wl := range o.grants {
+		if err := o.grantAccess(wl); err != nil {
+			return err
+		}
+	}
 	log.Infoln()
 	return nil
 }
 
+// grantAccess references the outputs of the environment storage resource in the manifest of the workload,
+// so that the workload is injected with its environment variables and attached its access policy.
+func (o *initStorageOpts) grantAccess(wl string) error {
+	raw, err := o.ws.ReadWorkloadManifest(wl)
+	if err != nil {
+		return fmt.Errorf("read manifest for %s: %w", wl, err)
+	}
+	out, err := addEnvAddonsToManifest(raw, o.envAddons())
+	if err != nil {
+		return fmt.Errorf("add environment addons to the manifest of %s: %w", wl, err)
+	}
+	path, err := o.ws.OverwriteWorkloadManifest(out, wl)
+	if err != nil {
+		return fmt.Errorf("write manifest for %s: %w", wl, err)
+	}
+	path, err = relPath(path)
+	if err != nil {
+		return err
+	}
+	log.Successf("Granted %s access to %s in its manifest at %s\n", wl, o.storageName, color.HighlightResource(path))
+	return nil
+}
+
+// envAddons returns the outputs of the environment addon that a workload needs to access the storage resource.
+func (o *initStorageOpts) envAddons() manifest.EnvAddons {
+	switch o.storageType {
+	case rdsStorageType:
+		return manifest.EnvAddons{
+			Secrets: []string{template.EnvVarSecretFunc(o.storageName)},
+		}
+	case redisStorageType, openSearchStorageType:
+		return manifest.EnvAddons{
+			Variables: []string{endpointOutputName(o.storageName)},
+			Secrets:   []string{template.EnvVarSecretFunc(o.storageName)},
+		}
+	}
+	return manifest.EnvAddons{
+		Variables: []string{template.EnvVarNameFunc(o.storageName)},
+		Policies:  []string{template.StripNonAlphaNumFunc(o.storageName) + "AccessPolicy"},
+	}
+}
+
 type addonBlob struct {
 	name        string
 	description string
@@ -672,6 +1178,10 @@ func (o *initStorageOpts) newAddonTemplate() (encoding.BinaryMarshaler, error) {
 		templateBlob, err = o.newS3Template()
 	case rdsStorageType:
 		templateBlob, err = o.newRDSTemplate()
+	case redisStorageType:
+		templateBlob, err = o.newRedisTemplate(), nil
+	case openSearchStorageType:
+		templateBlob, err = o.newOpenSearchTemplate(), nil
 	}
 	if err != nil {
 		return nil, err
@@ -692,7 +1202,8 @@ func (o *initStorageOpts) newAddonParams() (encoding.BinaryMarshaler, error) {
 func (o *initStorageOpts) newDDBTemplate() (*addon.DynamoDBTemplate, error) {
 	props := addon.DynamoDBProps{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:         o.storageName,
+			EnvLifecycle: o.lifecycle == environmentStorageLifecycle,
 		},
 	}
 
@@ -718,7 +1229,8 @@ func (o *initStorageOpts) newDDBTemplate() (*addon.DynamoDBTemplate, error) {
 func (o *initStorageOpts) newS3Template() (*addon.S3Template, error) {
 	props := &addon.S3Props{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:         o.storageName,
+			EnvLifecycle: o.lifecycle == environmentStorageLifecycle,
 		},
 	}
 	return addon.NewS3Template(props), nil
@@ -747,9 +1259,41 @@ func (o *initStorageOpts) newRDSTemplate() (*addon.RDSTemplate, error) {
 		ParameterGroup: o.rdsParameterGroup,
 		Envs:           envs,
 		WorkloadType:   o.workloadType,
+		EnvLifecycle:   o.lifecycle == environmentStorageLifecycle,
+
+		CapacityMode:        o.rdsCapacityMode,
+		MinCapacity:         o.rdsMinCapacity,
+		MaxCapacity:         o.rdsMaxCapacity,
+		InstanceClass:       o.rdsInstanceClass,
+		ReaderReplicas:      o.rdsReaders,
+		BackupRetentionDays: o.rdsBackupRetention,
+		DeletionPolicy:      o.rdsDeletionPolicy,
+		PerformanceInsights: o.rdsPerformanceInsights,
 	}), nil
 }
 
+func (o *initStorageOpts) newRedisTemplate() *addon.RedisTemplate {
+	return addon.NewRedisTemplate(addon.RedisProps{
+		ClusterName:   o.storageName,
+		EngineVersion: o.engineVersion,
+		NodeType:      o.nodeType,
+		ClusterMode:   o.clusterMode,
+		KMSEncryption: o.kmsEncryption,
+		EnvLifecycle:  o.lifecycle == environmentStorageLifecycle,
+	})
+}
+
+func (o *initStorageOpts) newOpenSearchTemplate() *addon.OpenSearchTemplate {
+	return addon.NewOpenSearchTemplate(addon.OpenSearchProps{
+		DomainName:    o.storageName,
+		EngineVersion: o.engineVersion,
+		InstanceType:  o.nodeType,
+		ClusterMode:   o.clusterMode,
+		KMSEncryption: o.kmsEncryption,
+		EnvLifecycle:  o.lifecycle == environmentStorageLifecycle,
+	})
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -796,6 +1340,25 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		newVar = template.ToSnakeCaseFunc(endpointOutputName(o.storageName))
+		retrieveEnvVarCode = fmt.Sprintf(`const redis = require('redis');
+const client = redis.createClient({
+    url: `+"`rediss://${process.env.%s}:6379`"+`,
+    password: process.env.%s,
+});`, newVar, template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName)))
+	case openSearchStorageType:
+		newVar = template.ToSnakeCaseFunc(endpointOutputName(o.storageName))
+		retrieveEnvVarCode = fmt.Sprintf(`const { Client } = require('@opensearch-project/opensearch');
+const {username, password} = JSON.parse(process.env.%s);
+const client = new Client({
+    node: `+"`https://${encodeURIComponent(username)}:${encodeURIComponent(password)}@${process.env.%s}`"+`,
+});`, template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName)), newVar)
+	}
+
+	if o.lifecycle == environmentStorageLifecycle {
+		logRecommendedActions(o.envLifecycleActions(newVar, retrieveEnvVarCode))
+		return nil
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -815,6 +1378,187 @@ For example, in JavaScript you can write:
 	return nil
 }
 
+const envAddonsManifestKey = "env_addons"
+
+// addEnvAddonsToManifest merges the environment addons into the "env_addons" section of a workload manifest.
+// The manifest is edited as text, only inserting the missing entries, so that the comments and the layout of the file,
+// including those of an existing "env_addons" section, are preserved.
+func addEnvAddonsToManifest(raw []byte, addons manifest.EnvAddons) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	var key, value *yaml.Node
+	if len(doc.Content) != 0 {
+		key, value = mappingEntry(doc.Content[0], envAddonsManifestKey)
+	}
+	if key == nil {
+		content := string(raw)
+		if !strings.HasSuffix(content, "\n") {
+			content += "\n"
+		}
+		return []byte(content + "\n" + envAddonsManifestBlock(addons)), nil
+	}
+	var sections *yaml.Node
+	switch {
+	case value.Kind == yaml.ScalarNode && value.Tag == "!!null":
+	case value.Kind == yaml.MappingNode && value.Style&yaml.FlowStyle == 0:
+		sections = value
+	default:
+		return nil, fmt.Errorf(`"%s" must be a map written in block style to be updated`, envAddonsManifestKey)
+	}
+	indent, end := "  ", key.Line
+	if sections != nil && len(sections.Content) != 0 {
+		indent, end = strings.Repeat(" ", sections.Content[0].Column-1), lastLine(sections)
+	}
+
+	lines := strings.SplitAfter(string(raw), "\n")
+	if last := lines[len(lines)-1]; last != "" && !strings.HasSuffix(last, "\n") {
+		lines[len(lines)-1] += "\n"
+	}
+	inserts := make(map[int]string) // Text to insert after a line, keyed by the 1-based line number.
+	for _, section := range []struct {
+		key    string
+		values []string
+	}{
+		{key: "variables", values: addons.Variables},
+		{key: "secrets", values: addons.Secrets},
+		{key: "policies", values: addons.Policies},
+	} {
+		var sectionKey, sectionValue *yaml.Node
+		if sections != nil {
+			sectionKey, sectionValue = mappingEntry(sections, section.key)
+		}
+		var existing []string
+		if sectionValue != nil && sectionValue.Kind == yaml.SequenceNode {
+			for _, item := range sectionValue.Content {
+				existing = append(existing, item.Value)
+			}
+		}
+		var missing []string
+		for _, val := range section.values {
+			if !contains(val, existing) && !contains(val, missing) {
+				missing = append(missing, val)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		switch {
+		case sectionKey == nil:
+			inserts[end] += fmt.Sprintf("%s%s:\n%s", indent, section.key, sequenceItems(indent+"  ", missing))
+		case sectionValue.Kind == yaml.ScalarNode && sectionValue.Tag == "!!null":
+			inserts[sectionKey.Line] += sequenceItems(indent+"  ", missing)
+		case sectionValue.Kind == yaml.SequenceNode && sectionValue.Style&yaml.FlowStyle == 0:
+			last := sectionValue.Content[len(sectionValue.Content)-1]
+			inserts[last.Line] += sequenceItems(strings.Repeat(" ", sectionValue.Content[0].Column-3), missing)
+		case sectionValue.Kind == yaml.SequenceNode && sectionValue.Line == sectionKey.Line:
+			// Rewrite a flow sequence written on the same line as its key, such as "variables: [bucketName]".
+			line := lines[sectionValue.Line-1]
+			open := sectionValue.Column - 1
+			closing := strings.Index(line[open:], "]")
+			if closing == -1 {
+				return nil, fmt.Errorf(`"%s.%s" must be a list written on a single line or in block style to be updated`, envAddonsManifestKey, section.key)
+			}
+			lines[sectionValue.Line-1] = line[:open] + "[" + strings.Join(append(existing, missing...), ", ") + "]" + line[open+closing+1:]
+		default:
+			return nil, fmt.Errorf(`"%s.%s" must be a list written on a single line or in block style to be updated`, envAddonsManifestKey, section.key)
+		}
+	}
+	var b strings.Builder
+	for i, line := range lines {
+		b.WriteString(line)
+		b.WriteString(inserts[i+1])
+	}
+	out := b.String()
+	if !strings.HasSuffix(string(raw), "\n") {
+		out = strings.TrimSuffix(out, "\n")
+	}
+	return []byte(out), nil
+}
+
+// mappingEntry returns the key and value nodes of the entry named key in a mapping node, or nils if there is none.
+func mappingEntry(mapping *yaml.Node, key string) (*yaml.Node, *yaml.Node) {
+	if mapping.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i], mapping.Content[i+1]
+		}
+	}
+	return nil, nil
+}
+
+// lastLine returns the line number of the last line of a node.
+func lastLine(node *yaml.Node) int {
+	line := node.Line
+	for _, child := range node.Content {
+		if l := lastLine(child); l > line {
+			line = l
+		}
+	}
+	return line
+}
+
+// sequenceItems returns the lines of a block sequence with the given indentation.
+func sequenceItems(indent string, values []string) string {
+	var b strings.Builder
+	for _, val := range values {
+		b.WriteString(fmt.Sprintf("%s- %s\n", indent, val))
+	}
+	return b.String()
+}
+
+// envAddonsManifestBlock returns the "env_addons" section of a workload manifest.
+func envAddonsManifestBlock(addons manifest.EnvAddons) string {
+	var b strings.Builder
+	b.WriteString(envAddonsManifestKey + ":\n")
+	for _, section := range []struct {
+		key    string
+		values []string
+	}{
+		{key: "variables", values: addons.Variables},
+		{key: "secrets", values: addons.Secrets},
+		{key: "policies", values: addons.Policies},
+	} {
+		if len(section.values) == 0 {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("  %s:\n", section.key))
+		for _, val := range section.values {
+			b.WriteString(fmt.Sprintf("    - %s\n", val))
+		}
+	}
+	return b.String()
+}
+
+// endpointOutputName returns the name of the output that holds the endpoint of a Redis cluster or an OpenSearch domain.
+func endpointOutputName(storageName string) string {
+	return template.StripNonAlphaNumFunc(storageName) + "Endpoint"
+}
+
+func (o *initStorageOpts) envLifecycleActions(newVar, retrieveEnvVarCode string) []string {
+	var actions []string
+	workloads := "your workloads"
+	if len(o.grants) == 0 {
+		actions = append(actions, fmt.Sprintf("Grant your workloads access to %s by updating their manifests with:\n%s",
+			o.storageName, color.HighlightCodeBlock(strings.TrimSuffix(envAddonsManifestBlock(o.envAddons()), "\n"))))
+	} else {
+		workloads = english.WordSeries(o.grants, "and")
+	}
+	actions = append(actions, fmt.Sprintf(
+		`Update the code of %s to leverage the injected environment variable %s.
+For example, in JavaScript you can write:
+%s`,
+		workloads,
+		newVar,
+		color.HighlightCodeBlock(retrieveEnvVarCode)))
+	actions = append(actions, fmt.Sprintf("Run %s to deploy your storage resources, then %s to deploy the workloads that access them.",
+		color.HighlightCode("copilot env deploy"), color.HighlightCode("copilot deploy")))
+	return actions
+}
+
 // buildStorageInitCmd builds the command and adds it to the CLI.
 func buildStorageInitCmd() *cobra.Command {
 	vars := initStorageVars{}
@@ -823,7 +1567,9 @@ func buildStorageInitCmd() *cobra.Command {
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
 		Long: `Creates a new AWS CloudFormation template for a storage resource.
 Storage resources are stored in the Copilot addons directory (e.g. ./copilot/frontend/addons) for a given workload and deployed to your environments when you run ` + color.HighlightCode("copilot deploy") + `. 
-Resource names are injected into your containers as environment variables for easy access.`,
+Resource names are injected into your containers as environment variables for easy access.
+Storage resources with an environment lifecycle are stored in ./copilot/environments/addons, deployed when you run ` + color.HighlightCode("copilot env deploy") + `,
+and shared by the workloads that are granted access to them.`,
 		Example: `
   Create an S3 bucket named "my-bucket" attached to the "frontend" service.
   /code $ copilot storage init -n my-bucket -t S3 -w frontend
@@ -832,18 +1578,32 @@ Resource names are injected into your containers as environment variables for ea
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create an Aurora Serverless v2 cluster with a reader DB instance that is retained when its stack is deleted.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine MySQL --capacity-mode serverless-v2 --min-capacity 0.5 --max-capacity 16 --readers 1 --deletion-policy Retain
+  Create an ElastiCache Redis cluster with cluster mode enabled.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend --engine-version 7.0 --node-type cache.t3.small --cluster-mode
+  Create an OpenSearch domain encrypted with a customer managed KMS key.
+  /code $ copilot storage init -n my-search -t OpenSearch -w frontend --engine-version OpenSearch_2.3 --node-type t3.medium.search --kms-encryption
+  Create an S3 bucket deployed with the environments and shared by the "api" and "worker" workloads.
+  /code $ copilot storage init -n my-bucket -t S3 --lifecycle environment --grant api,worker`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.promptForClusterMode = !cmd.Flags().Changed(storageClusterModeFlag)
+			opts.promptForKMSEncryption = !cmd.Flags().Changed(storageKMSEncryptionFlag)
+			opts.promptForRDSCapacityMode = !cmd.Flags().Changed(storageRDSCapacityModeFlag)
+			opts.promptForRDSReaders = !cmd.Flags().Changed(storageRDSReadersFlag)
 			return run(opts)
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, typeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
+	cmd.Flags().StringVar(&vars.lifecycle, storageLifecycleFlag, workloadStorageLifecycle, storageLifecycleFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.grants, storageGrantFlag, nil, storageGrantFlagDescription)
 
 	cmd.Flags().StringVar(&vars.partitionKey, storagePartitionKeyFlag, "", storagePartitionKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.sortKey, storageSortKeyFlag, "", storageSortKeyFlagDescription)
@@ -854,12 +1614,29 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsCapacityMode, storageRDSCapacityModeFlag, "", storageRDSCapacityModeFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMinCapacity, storageRDSMinCapacityFlag, 0, storageRDSMinCapacityFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMaxCapacity, storageRDSMaxCapacityFlag, 0, storageRDSMaxCapacityFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsInstanceClass, storageRDSInstanceClassFlag, "", storageRDSInstanceClassFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsReaders, storageRDSReadersFlag, 0, storageRDSReadersFlagDescription)
+	cmd.Flags().IntVar(&vars.rdsBackupRetention, storageRDSBackupFlag, 0, storageRDSBackupFlagDescription)
+	cmd.Flags().StringVar(&vars.rdsDeletionPolicy, storageRDSDeletionFlag, "", storageRDSDeletionFlagDescription)
+	cmd.Flags().BoolVar(&vars.rdsPerformanceInsights, storageRDSInsightsFlag, false, storageRDSInsightsFlagDescription)
+
+	cmd.Flags().StringVar(&vars.engineVersion, storageEngineVersionFlag, "", storageEngineVersionFlagDescription)
+	cmd.Flags().StringVar(&vars.nodeType, storageNodeTypeFlag, "", storageNodeTypeFlagDescription)
+	cmd.Flags().BoolVar(&vars.clusterMode, storageClusterModeFlag, false, storageClusterModeFlagDescription)
+	cmd.Flags().BoolVar(&vars.kmsEncryption, storageKMSEncryptionFlag, false, storageKMSEncryptionFlagDescription)
 
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(workloadFlag))
 
+	lifecycleFlags := pflag.NewFlagSet("Lifecycle", pflag.ContinueOnError)
+	lifecycleFlags.AddFlag(cmd.Flags().Lookup(storageLifecycleFlag))
+	lifecycleFlags.AddFlag(cmd.Flags().Lookup(storageGrantFlag))
+
 	ddbFlags := pflag.NewFlagSet("DynamoDB", pflag.ContinueOnError)
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePartitionKeyFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageSortKeyFlag))
@@ -867,17 +1644,33 @@ Resource names are injected into your containers as environment variables for ea
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageLSIConfigFlag))
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storageNoLSIFlag))
 
-	auroraFlags := pflag.NewFlagSet("Aurora Serverless", pflag.ContinueOnError)
+	auroraFlags := pflag.NewFlagSet("Aurora", pflag.ContinueOnError)
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSEngineFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSCapacityModeFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSMinCapacityFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSMaxCapacityFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInstanceClassFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSReadersFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSBackupFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSDeletionFlag))
+	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInsightsFlag))
+
+	redisOpenSearchFlags := pflag.NewFlagSet("Redis and OpenSearch", pflag.ContinueOnError)
+	redisOpenSearchFlags.AddFlag(cmd.Flags().Lookup(storageEngineVersionFlag))
+	redisOpenSearchFlags.AddFlag(cmd.Flags().Lookup(storageNodeTypeFlag))
+	redisOpenSearchFlags.AddFlag(cmd.Flags().Lookup(storageClusterModeFlag))
+	redisOpenSearchFlags.AddFlag(cmd.Flags().Lookup(storageKMSEncryptionFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
-		"Required":          requiredFlags.FlagUsages(),
-		"DynamoDB":          ddbFlags.FlagUsages(),
-		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"sections":             `Required,Lifecycle,DynamoDB,Aurora,Redis and OpenSearch`,
+		"Required":             requiredFlags.FlagUsages(),
+		"Lifecycle":            lifecycleFlags.FlagUsages(),
+		"DynamoDB":             ddbFlags.FlagUsages(),
+		"Aurora":               auroraFlags.FlagUsages(),
+		"Redis and OpenSearch": redisOpenSearchFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
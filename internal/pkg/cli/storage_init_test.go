@@ -10,7 +10,10 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
@@ -30,6 +33,19 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 		inEngine      string
+		inLifecycle   string
+		inGrants      []string
+
+		inEngineVersion  string
+		inNodeType       string
+		inKMSEncryption  bool
+		inCapacityMode   string
+		inMinCapacity    float64
+		inMaxCapacity    float64
+		inInstanceClass  string
+		inReaders        int
+		inBackup         int
+		inDeletionPolicy string
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -173,6 +189,198 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"invalid capacity mode": {
+			inAppName:      "meow",
+			inCapacityMode: "serverless",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid capacity mode serverless: must be one of "serverless-v1", "serverless-v2", "provisioned"`),
+		},
+		"capacity not in increments of 0.5 ACUs": {
+			inAppName:     "meow",
+			inMinCapacity: 0.75,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("capacity must be between 0.5 and 128 ACUs, in increments of 0.5"),
+		},
+		"minimum capacity exceeds maximum capacity": {
+			inAppName:     "meow",
+			inMinCapacity: 16,
+			inMaxCapacity: 8,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("minimum capacity 16 must not exceed maximum capacity 8"),
+		},
+		"invalid instance class": {
+			inAppName:       "meow",
+			inInstanceClass: "r6g.large",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid instance class r6g.large: must start with "db."`),
+		},
+		"backup retention out of range": {
+			inAppName: "meow",
+			inBackup:  36,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("backup retention must be between 1 and 35 days"),
+		},
+		"invalid deletion policy": {
+			inAppName:        "meow",
+			inDeletionPolicy: "Keep",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid deletion policy Keep: must be one of "Delete", "Retain", "Snapshot"`),
+		},
+		"error if the capacity of a provisioned cluster is set": {
+			inAppName:      "meow",
+			inCapacityMode: addon.RDSCapacityModeProvisioned,
+			inMinCapacity:  2,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`--min-capacity and --max-capacity require the "serverless-v2" capacity mode`),
+		},
+		"error if the instance class of a serverless cluster is set": {
+			inAppName:       "meow",
+			inInstanceClass: "db.r6g.large",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`--instance-class requires the "provisioned" capacity mode`),
+		},
+		"error if readers are set without a capacity mode since it defaults to Aurora Serverless v1": {
+			inAppName: "meow",
+			inReaders: 1,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`--readers and --performance-insights are not supported by the "serverless-v1" capacity mode`),
+		},
+		"valid Aurora Serverless v2 flags": {
+			inAppName:        "meow",
+			inCapacityMode:   "serverless-v2",
+			inMinCapacity:    0.5,
+			inMaxCapacity:    16,
+			inBackup:         7,
+			inDeletionPolicy: "Snapshot",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"invalid lifecycle": {
+			inAppName:   "bowie",
+			inLifecycle: "app",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("invalid lifecycle app: must be one of \"workload\", \"environment\""),
+		},
+		"fails when --grant is provided without the environment lifecycle": {
+			inAppName:   "bowie",
+			inLifecycle: workloadStorageLifecycle,
+			inGrants:    []string{"api"},
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--grant can only be used with --lifecycle environment"),
+		},
+		"fails when --workload is provided with the environment lifecycle": {
+			inAppName:   "bowie",
+			inSvcName:   "api",
+			inLifecycle: environmentStorageLifecycle,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot specify --workload and --lifecycle environment at once"),
+		},
+		"fails when a granted workload is a Request-Driven Web Service": {
+			inAppName:   "bowie",
+			inLifecycle: environmentStorageLifecycle,
+			inGrants:    []string{"api", "frontend"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil).Times(2)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().ReadWorkloadManifest("frontend").Return([]byte("type: Request-Driven Web Service"), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot grant frontend access to the storage: Request-Driven Web Service does not support environment addons"),
+		},
+		"fails when a granted workload is a Static Site": {
+			inAppName:   "bowie",
+			inLifecycle: environmentStorageLifecycle,
+			inGrants:    []string{"website"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ListWorkloads().Return([]string{"website"}, nil)
+				m.EXPECT().ReadWorkloadManifest("website").Return([]byte("type: Static Site"), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("cannot grant website access to the storage: Static Site does not support environment addons"),
+		},
+		"fails when the engine version of a Redis cluster is invalid": {
+			inAppName:       "bowie",
+			inStorageType:   redisStorageType,
+			inEngineVersion: "5.0",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid engine version 5.0: must be one of "7.0", "6.2"`),
+		},
+		"fails when the node type of an OpenSearch domain is invalid": {
+			inAppName:       "bowie",
+			inStorageType:   openSearchStorageType,
+			inEngineVersion: "OpenSearch_2.3",
+			inNodeType:      "cache.t3.micro",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New(`invalid instance type cache.t3.micro: must end with ".search"`),
+		},
+		"fails when a Redis and OpenSearch flag is used with another storage type": {
+			inAppName:       "bowie",
+			inStorageType:   s3StorageType,
+			inKMSEncryption: true,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--kms-encryption can only be used with --storage-type Redis or OpenSearch"),
+		},
+		"successfully validates granted workloads": {
+			inAppName:   "bowie",
+			inLifecycle: environmentStorageLifecycle,
+			inGrants:    []string{"api"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("type: Backend Service"), nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -194,6 +402,20 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+					lifecycle:    tc.inLifecycle,
+					grants:       tc.inGrants,
+
+					rdsCapacityMode:    tc.inCapacityMode,
+					rdsMinCapacity:     tc.inMinCapacity,
+					rdsMaxCapacity:     tc.inMaxCapacity,
+					rdsInstanceClass:   tc.inInstanceClass,
+					rdsReaders:         tc.inReaders,
+					rdsBackupRetention: tc.inBackup,
+					rdsDeletionPolicy:  tc.inDeletionPolicy,
+
+					engineVersion: tc.inEngineVersion,
+					nodeType:      tc.inNodeType,
+					kmsEncryption: tc.inKMSEncryption,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -239,6 +461,17 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 		inDBEngine      string
 		inInitialDBName string
 
+		inCapacityMode           string
+		inMinCapacity            float64
+		inInstanceClass          string
+		promptForRDSCapacityMode bool
+		promptForRDSReaders      bool
+
+		inEngineVersion        string
+		inNodeType             string
+		promptForClusterMode   bool
+		promptForKMSEncryption bool
+
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
 		mockWS     func(m *mocks.MockwsAddonManager)
@@ -266,6 +499,14 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "In-memory",
+					},
+					{
+						Value: openSearchStorageTypeOption,
+						Hint:  "Search",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -273,6 +514,22 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"error if the engine version does not match the selected storage type": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   "search",
+			inEngineVersion: "7.0",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(openSearchStorageTypeOption, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+			},
+
+			wantedErr: errors.New(`invalid engine version 7.0: must be one of "OpenSearch_2.3", "OpenSearch_1.3"`),
+		},
 		"error if storage type not gotten": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -851,6 +1108,158 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("input initial database name: some error"),
 		},
+		"asks for the capacity and readers of an Aurora Serverless v2 cluster": {
+			inAppName:                wantedAppName,
+			inSvcName:                wantedSvcName,
+			inStorageName:            wantedBucketName,
+			inStorageType:            rdsStorageType,
+			inDBEngine:               wantedDBEngine,
+			promptForRDSCapacityMode: true,
+			promptForRDSReaders:      true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(storageInitRDSInitialDBNamePrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return(wantedInitialDBName, nil)
+				m.EXPECT().SelectOption(storageInitRDSCapacityModePrompt, gomock.Any(), []prompt.Option{
+					rdsCapacityModeOptions[addon.RDSCapacityModeServerlessV1],
+					rdsCapacityModeOptions[addon.RDSCapacityModeServerlessV2],
+					rdsCapacityModeOptions[addon.RDSCapacityModeProvisioned],
+				}, gomock.Any()).Return(addon.RDSCapacityModeServerlessV2, nil)
+				m.EXPECT().Get(storageInitRDSMinCapacityPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("1", nil)
+				m.EXPECT().Get(storageInitRDSMaxCapacityPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("16", nil)
+				m.EXPECT().Get(storageInitRDSReadersPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:      rdsStorageType,
+				storageName:      wantedBucketName,
+				workloadName:     wantedSvcName,
+				rdsEngine:        wantedDBEngine,
+				rdsInitialDBName: wantedInitialDBName,
+				rdsCapacityMode:  addon.RDSCapacityModeServerlessV2,
+				rdsMinCapacity:   1,
+				rdsMaxCapacity:   16,
+				rdsReaders:       2,
+			},
+		},
+		"defaults to Aurora Serverless v1 without prompting if every other input is set with flags": {
+			inAppName:                wantedAppName,
+			inSvcName:                wantedSvcName,
+			inStorageName:            wantedBucketName,
+			inStorageType:            rdsStorageType,
+			inDBEngine:               wantedDBEngine,
+			inInitialDBName:          wantedInitialDBName,
+			promptForRDSCapacityMode: true,
+			promptForRDSReaders:      true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:      rdsStorageType,
+				storageName:      wantedBucketName,
+				workloadName:     wantedSvcName,
+				rdsEngine:        wantedDBEngine,
+				rdsInitialDBName: wantedInitialDBName,
+			},
+		},
+		"asks for the instance class of a provisioned cluster": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   wantedBucketName,
+			inStorageType:   rdsStorageType,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+			inCapacityMode:  addon.RDSCapacityModeProvisioned,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(storageInitRDSInstanceClassPrompt, gomock.Any(), rdsInstanceClasses, gomock.Any()).Return("db.r6g.large", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:      rdsStorageType,
+				storageName:      wantedBucketName,
+				workloadName:     wantedSvcName,
+				rdsEngine:        wantedDBEngine,
+				rdsInitialDBName: wantedInitialDBName,
+				rdsCapacityMode:  addon.RDSCapacityModeProvisioned,
+				rdsInstanceClass: "db.r6g.large",
+			},
+		},
+		"asks for the engine version, node type, cluster mode and encryption of a Redis cluster": {
+			inAppName:              wantedAppName,
+			inSvcName:              wantedSvcName,
+			inStorageName:          "cache",
+			inStorageType:          redisStorageType,
+			promptForClusterMode:   true,
+			promptForKMSEncryption: true,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"7.0", "6.2"}, gomock.Any()).Return("6.2", nil)
+				m.EXPECT().SelectOne(gomock.Any(), storageInitNodeTypeHelp, redisNodeTypes, gomock.Any()).Return("cache.t3.small", nil)
+				m.EXPECT().Confirm(storageInitRedisClusterModeConfirm, gomock.Any(), gomock.Any()).Return(true, nil)
+				m.EXPECT().Confirm(storageInitKMSEncryptionConfirm, gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:   redisStorageType,
+				storageName:   "cache",
+				workloadName:  wantedSvcName,
+				engineVersion: "6.2",
+				nodeType:      "cache.t3.small",
+				clusterMode:   true,
+			},
+		},
+		"does not prompt for the settings of an OpenSearch domain passed as flags": {
+			inAppName:       wantedAppName,
+			inSvcName:       wantedSvcName,
+			inStorageName:   "search",
+			inStorageType:   openSearchStorageType,
+			inEngineVersion: "OpenSearch_1.3",
+			inNodeType:      "m6g.large.search",
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Backend Service"), nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:   openSearchStorageType,
+				storageName:   "search",
+				workloadName:  wantedSvcName,
+				engineVersion: "OpenSearch_1.3",
+				nodeType:      "m6g.large.search",
+			},
+		},
+		"error if a Redis cluster is attached to a Request-Driven Web Service": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageName: "cache",
+			inStorageType: redisStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockWS: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Request-Driven Web Service"), nil)
+			},
+
+			wantedErr: errors.New("invalid storage type Redis: not supported by Request-Driven Web Service"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -874,11 +1283,23 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 					rdsEngine:        tc.inDBEngine,
 					rdsInitialDBName: tc.inInitialDBName,
+					rdsCapacityMode:  tc.inCapacityMode,
+					rdsMinCapacity:   tc.inMinCapacity,
+					rdsInstanceClass: tc.inInstanceClass,
+
+					engineVersion: tc.inEngineVersion,
+					nodeType:      tc.inNodeType,
 				},
 				appName: tc.inAppName,
 				sel:     mockConfig,
 				prompt:  mockPrompt,
 				ws:      mockWS,
+
+				promptForClusterMode:   tc.promptForClusterMode,
+				promptForKMSEncryption: tc.promptForKMSEncryption,
+
+				promptForRDSCapacityMode: tc.promptForRDSCapacityMode,
+				promptForRDSReaders:      tc.promptForRDSReaders,
 			}
 			tc.mockPrompt(mockPrompt)
 			tc.mockCfg(mockConfig)
@@ -925,6 +1346,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName  string
 		inParameterGroup string
 
+		inLifecycle string
+		inGrants    []string
+
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
 
@@ -1049,6 +1473,96 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: fmt.Errorf("some error"),
 		},
+		"happy calls for Redis": {
+			inAppName:     wantedAppName,
+			inStorageType: redisStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "cache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Backend Service"), nil)
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "cache").Return("/frontend/addons/cache.yml", nil)
+			},
+		},
+		"happy calls for OpenSearch with an environment lifecycle": {
+			inAppName:     wantedAppName,
+			inStorageType: openSearchStorageType,
+			inStorageName: "search",
+			inLifecycle:   environmentStorageLifecycle,
+			inGrants:      []string{"api"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "environments", "search").Return("/copilot/environments/addons/search.yml", nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\n"), nil)
+				m.EXPECT().OverwriteWorkloadManifest([]byte(`name: api
+
+env_addons:
+  variables:
+    - searchEndpoint
+  secrets:
+    - searchSecret
+`), "api").Return("/copilot/api/manifest.yml", nil)
+			},
+		},
+		"happy calls for S3 with an environment lifecycle": {
+			inAppName:     wantedAppName,
+			inStorageType: s3StorageType,
+			inStorageName: "my-bucket",
+			inLifecycle:   environmentStorageLifecycle,
+			inGrants:      []string{"api"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "environments", "my-bucket").Return("/copilot/environments/addons/my-bucket.yml", nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\ntype: Backend Service\n"), nil)
+				m.EXPECT().OverwriteWorkloadManifest([]byte(`name: api
+type: Backend Service
+
+env_addons:
+  variables:
+    - mybucketName
+  policies:
+    - mybucketAccessPolicy
+`), "api").Return("/copilot/api/manifest.yml", nil)
+			},
+		},
+		"happy calls for RDS with an environment lifecycle": {
+			inAppName:     wantedAppName,
+			inStorageType: rdsStorageType,
+			inStorageName: "mycluster",
+			inEngine:      engineTypePostgreSQL,
+			inLifecycle:   environmentStorageLifecycle,
+			inGrants:      []string{"api"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "environments", "mycluster").Return("/copilot/environments/addons/mycluster.yml", nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\ntype: Backend Service\n"), nil)
+				m.EXPECT().OverwriteWorkloadManifest([]byte(`name: api
+type: Backend Service
+
+env_addons:
+  secrets:
+    - myclusterSecret
+`), "api").Return("/copilot/api/manifest.yml", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(wantedAppName).Return([]*config.Environment{{Name: "test"}}, nil)
+			},
+		},
+		"error if cannot overwrite the manifest of a granted workload": {
+			inAppName:     wantedAppName,
+			inStorageType: s3StorageType,
+			inStorageName: "my-bucket",
+			inLifecycle:   environmentStorageLifecycle,
+			inGrants:      []string{"api"},
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), "environments", "my-bucket").Return("/copilot/environments/addons/my-bucket.yml", nil)
+				m.EXPECT().ReadWorkloadManifest("api").Return([]byte("name: api\n"), nil)
+				m.EXPECT().OverwriteWorkloadManifest(gomock.Any(), "api").Return("", errors.New("some error"))
+			},
+
+			wantedErr: errors.New("write manifest for api: some error"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1071,6 +1585,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+
+					lifecycle: tc.inLifecycle,
+					grants:    tc.inGrants,
 				},
 				appName: tc.inAppName,
 				ws:      mockAddon,
@@ -1094,3 +1611,126 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 	}
 
 }
+
+func TestAddEnvAddonsToManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest  string
+		inEnvAddons manifest.EnvAddons
+
+		wanted      string
+		wantedError error
+	}{
+		"appends the section at the end of the manifest": {
+			inManifest: `# The manifest for the "api" service.
+name: api
+type: Backend Service`,
+			inEnvAddons: manifest.EnvAddons{
+				Secrets: []string{"dbSecret"},
+			},
+			wanted: `# The manifest for the "api" service.
+name: api
+type: Backend Service
+
+env_addons:
+  secrets:
+    - dbSecret
+`,
+		},
+		"merges into the existing section and preserves the rest of the manifest": {
+			inManifest: `name: api
+
+env_addons:
+  variables:
+    - bucketName # Shared bucket.
+  policies:
+    - bucketAccessPolicy
+
+# Overrides per environment.
+environments:
+  test:
+    count: 1
+`,
+			inEnvAddons: manifest.EnvAddons{
+				Variables: []string{"bucketName", "tableName"},
+				Policies:  []string{"tableAccessPolicy"},
+			},
+			wanted: `name: api
+
+env_addons:
+  variables:
+    - bucketName # Shared bucket.
+    - tableName
+  policies:
+    - bucketAccessPolicy
+    - tableAccessPolicy
+
+# Overrides per environment.
+environments:
+  test:
+    count: 1
+`,
+		},
+		"adds the missing lists and preserves the comments of the existing section": {
+			inManifest: `name: api
+env_addons:
+    # Written by storage init.
+    variables: [bucketName] # Shared bucket.
+    secrets:
+environments:
+  test:
+    count: 1`,
+			inEnvAddons: manifest.EnvAddons{
+				Variables: []string{"tableName"},
+				Secrets:   []string{"tableSecret"},
+				Policies:  []string{"tableAccessPolicy"},
+			},
+			wanted: `name: api
+env_addons:
+    # Written by storage init.
+    variables: [bucketName, tableName] # Shared bucket.
+    secrets:
+      - tableSecret
+    policies:
+      - tableAccessPolicy
+environments:
+  test:
+    count: 1`,
+		},
+		"fills an empty section": {
+			inManifest: `name: api
+env_addons:
+`,
+			inEnvAddons: manifest.EnvAddons{
+				Secrets: []string{"dbSecret"},
+			},
+			wanted: `name: api
+env_addons:
+  secrets:
+    - dbSecret
+`,
+		},
+		"returns an error if the section is written in flow style": {
+			inManifest: `name: api
+env_addons: {variables: [bucketName]}
+`,
+			inEnvAddons: manifest.EnvAddons{
+				Secrets: []string{"dbSecret"},
+			},
+			wantedError: errors.New(`"env_addons" must be a map written in block style to be updated`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := addEnvAddonsToManifest([]byte(tc.inManifest), tc.inEnvAddons)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
@@ -32,8 +32,12 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
+	cmd.AddCommand(buildSvcCpCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
+	cmd.AddCommand(buildSvcDiffCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/ecs/cp"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/spf13/cobra"
+)
+
+const containerPathSeparator = ":"
+
+type svcCpOpts struct {
+	*svcExecOpts
+	src string
+	dst string
+
+	newCopier      func(sess *session.Session, container cp.Container, progress cp.ProgressFunc) ecsFileCopier
+	renderProgress func(bar *termprogress.TransferBar) error
+
+	// Cached variables set during Validate.
+	download   bool
+	remotePath string
+	localPath  string
+}
+
+func newSvcCpOpts(vars execVars, src, dst string) (*svcCpOpts, error) {
+	execOpts, err := newSvcExecOpts(vars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcCpOpts{
+		svcExecOpts: execOpts,
+		src:         src,
+		dst:         dst,
+		newCopier: func(sess *session.Session, container cp.Container, progress cp.ProgressFunc) ecsFileCopier {
+			return cp.New(awsecs.New(sess), container, progress)
+		},
+		renderProgress: func(bar *termprogress.TransferBar) error {
+			return termprogress.Render(context.Background(), termprogress.NewTabbedFileWriter(os.Stderr), bar)
+		},
+	}, nil
+}
+
+// Validate returns an error if the paths to copy from and to are invalid.
+func (o *svcCpOpts) Validate() error {
+	srcTask, srcPath, srcInContainer := parseContainerPath(o.src)
+	dstTask, dstPath, dstInContainer := parseContainerPath(o.dst)
+	switch {
+	case srcInContainer && dstInContainer:
+		return errors.New("cannot copy files between two containers")
+	case !srcInContainer && !dstInContainer:
+		return fmt.Errorf("one of the paths must be in a container, specified as %s", color.HighlightCode("<task>:<path>"))
+	case srcInContainer:
+		o.download, o.taskID, o.remotePath, o.localPath = true, srcTask, srcPath, dstPath
+	default:
+		o.download, o.taskID, o.remotePath, o.localPath = false, dstTask, dstPath, srcPath
+	}
+	if o.remotePath == "" {
+		return errors.New("path in the container must not be empty")
+	}
+	if o.localPath == "" {
+		return errors.New("local path must not be empty")
+	}
+	return o.svcExecOpts.Validate()
+}
+
+// Execute copies the files between the local file system and a running container of the service.
+func (o *svcCpOpts) Execute() error {
+	sess, in, err := o.selectContainerTarget()
+	if err != nil {
+		return err
+	}
+	if o.download {
+		log.Infof("Copy %s from container %s in task %s to %s.\n", color.HighlightUserInput(o.remotePath),
+			color.HighlightUserInput(in.Container), color.HighlightResource(in.Task), color.HighlightUserInput(o.localPath))
+	} else {
+		log.Infof("Copy %s to %s in container %s in task %s.\n", color.HighlightUserInput(o.localPath),
+			color.HighlightUserInput(o.remotePath), color.HighlightUserInput(in.Container), color.HighlightResource(in.Task))
+	}
+
+	label := filepath.Base(o.localPath)
+	if o.download {
+		label = path.Base(o.remotePath)
+	}
+	bar := termprogress.NewTransferBar(label)
+	rendered := make(chan error, 1)
+	go func() {
+		rendered <- o.renderProgress(bar)
+	}()
+	copier := o.newCopier(sess, cp.Container{
+		Cluster: in.Cluster,
+		Task:    in.Task,
+		Name:    in.Container,
+	}, bar.Update)
+	if o.download {
+		err = copier.Download(o.remotePath, o.localPath)
+	} else {
+		err = copier.Upload(o.localPath, o.remotePath)
+	}
+	bar.Stop()
+	if renderErr := <-rendered; renderErr != nil && err == nil {
+		err = fmt.Errorf("render progress: %w", renderErr)
+	}
+	if err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to copy files. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return err
+	}
+	log.Successf("Copied %s to %s.\n", color.HighlightUserInput(o.src), color.HighlightUserInput(o.dst))
+	return nil
+}
+
+// parseContainerPath parses a path specified as "[<task>]:<path>" for a path in a container.
+// If the task is empty, a random running task is used.
+// Paths without a separator, or whose part before the separator is a directory or a Windows volume, are local.
+func parseContainerPath(arg string) (taskID, filePath string, inContainer bool) {
+	if filepath.VolumeName(arg) != "" {
+		return "", arg, false
+	}
+	parts := strings.SplitN(arg, containerPathSeparator, 2)
+	if len(parts) == 1 || strings.ContainsAny(parts[0], `/\`) {
+		return "", arg, false
+	}
+	return parts[0], parts[1], true
+}
+
+// buildSvcCpCmd builds the command for copying files into and out of a running container of a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := execVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp <src> <dst>",
+		Short: "Copy files into and out of a running container part of a service.",
+		Long: `Copy files and directories into and out of a running container part of a service, through ECS Exec.
+A path in a container is specified as <task>:<path>, where <task> is a prefix of the ID of a running task.
+If the task is omitted, as in :<path>, a running task is chosen at random.
+The checksum of the copied files is verified before they are extracted.`,
+		Example: `
+  Copy a heap dump out of the task prefixed with ID "8c38184" of the "api" service.
+  /code $ copilot svc cp -a my-app -e test -n api 8c38184:/tmp/heap.hprof ./heap.hprof
+  Copy a local configuration directory into the "envoy" container of a random task.
+  /code $ copilot svc cp -e test -n api --container envoy ./envoy :/etc/envoy`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("requires a source and a destination path")
+			}
+			return nil
+		},
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcCpOpts(vars, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", cpContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/ecs/cp"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSrc string
+		inDst string
+
+		wantedDownload   bool
+		wantedTaskID     string
+		wantedRemotePath string
+		wantedLocalPath  string
+		wantedError      error
+	}{
+		"copies out of the container of a task": {
+			inSrc:            "8c38184:/tmp/heap.hprof",
+			inDst:            "./heap.hprof",
+			wantedDownload:   true,
+			wantedTaskID:     "8c38184",
+			wantedRemotePath: "/tmp/heap.hprof",
+			wantedLocalPath:  "./heap.hprof",
+		},
+		"copies into the container of a random task": {
+			inSrc:            "config/app.conf",
+			inDst:            ":/etc/app.conf",
+			wantedRemotePath: "/etc/app.conf",
+			wantedLocalPath:  "config/app.conf",
+		},
+		"treats a local path with a separator in a file name as local": {
+			inSrc:            "./logs/10:00.log",
+			inDst:            "8c38184:/tmp",
+			wantedTaskID:     "8c38184",
+			wantedRemotePath: "/tmp",
+			wantedLocalPath:  "./logs/10:00.log",
+		},
+		"returns an error if both paths are in containers": {
+			inSrc:       "8c38184:/tmp/a",
+			inDst:       "1b2c3d4:/tmp/a",
+			wantedError: errors.New("cannot copy files between two containers"),
+		},
+		"returns an error if no path is in a container": {
+			inSrc:       "./a",
+			inDst:       "./b",
+			wantedError: errors.New("one of the paths must be in a container, specified as `<task>:<path>`"),
+		},
+		"returns an error if the path in the container is empty": {
+			inSrc:       "8c38184:",
+			inDst:       ".",
+			wantedError: errors.New("path in the container must not be empty"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSSMPluginManager := mocks.NewMockssmPluginManager(ctrl)
+			mockSSMPluginManager.EXPECT().ValidateBinary().Return(nil).AnyTimes()
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					ssmPluginManager: mockSSMPluginManager,
+				},
+				src: tc.inSrc,
+				dst: tc.inDst,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDownload, opts.download)
+			require.Equal(t, tc.wantedTaskID, opts.taskID)
+			require.Equal(t, tc.wantedRemotePath, opts.remotePath)
+			require.Equal(t, tc.wantedLocalPath, opts.localPath)
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Load Balanced Web Service",
+	}
+	wantedContainer := cp.Container{
+		Cluster: "mockCluster",
+		Task:    "mockTaskID",
+		Name:    "envoy",
+	}
+	testCases := map[string]struct {
+		inDownload bool
+		setupMocks func(m *mocks.MockecsFileCopier)
+
+		wantedError error
+	}{
+		"downloads the file from the container": {
+			inDownload: true,
+			setupMocks: func(m *mocks.MockecsFileCopier) {
+				m.EXPECT().Download("/tmp/heap.hprof", "./heap.hprof").Return(nil)
+			},
+		},
+		"uploads the file to the container": {
+			setupMocks: func(m *mocks.MockecsFileCopier) {
+				m.EXPECT().Upload("./heap.hprof", "/tmp/heap.hprof").Return(nil)
+			},
+		},
+		"returns the error from copying the file": {
+			setupMocks: func(m *mocks.MockecsFileCopier) {
+				m.EXPECT().Upload("./heap.hprof", "/tmp/heap.hprof").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockSessProvider := mocks.NewMocksessionProvider(ctrl)
+			mockCopier := mocks.NewMockecsFileCopier(ctrl)
+			mockStore.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+			mockStore.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{}, nil)
+			mockSessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+				ClusterName: "mockCluster",
+				Tasks: []*awsecs.Task{
+					{
+						TaskArn:    aws.String(mockTaskARN),
+						LastStatus: aws.String("RUNNING"),
+					},
+				},
+			}, nil)
+			tc.setupMocks(mockCopier)
+			var rendered bool
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						appName:       "mockApp",
+						envName:       "mockEnv",
+						name:          "mockSvc",
+						taskID:        "mock",
+						containerName: "envoy",
+					},
+					store: mockStore,
+					newSvcDescriber: func(_ *session.Session) serviceDescriber {
+						return mockSvcDescriber
+					},
+					sessProvider: mockSessProvider,
+				},
+				newCopier: func(_ *session.Session, container cp.Container, _ cp.ProgressFunc) ecsFileCopier {
+					require.Equal(t, wantedContainer, container)
+					return mockCopier
+				},
+				renderProgress: func(bar *termprogress.TransferBar) error {
+					<-bar.Done()
+					rendered = true
+					return nil
+				},
+				download:   tc.inDownload,
+				remotePath: "/tmp/heap.hprof",
+				localPath:  "./heap.hprof",
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.True(t, rendered)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -16,11 +16,15 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	awssession "github.com/aws/aws-sdk-go/aws/session"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -43,6 +47,9 @@ const (
 	fmtSvcDeleteResourcesStart    = "Deleting resources of service %s from application %s."
 	fmtSvcDeleteResourcesFailed   = "Failed to delete resources of service %s from application %s.\n"
 	fmtSvcDeleteResourcesComplete = "Deleted resources of service %s from application %s.\n"
+	fmtSvcEmptyBucketStart        = "Emptying the bucket of service %s in environment %s."
+	fmtSvcEmptyBucketFailed       = "Failed to empty the bucket of service %s in environment %s: %v.\n"
+	fmtSvcEmptyBucketComplete     = "Emptied the bucket of service %s in environment %s.\n"
 )
 
 var (
@@ -60,14 +67,19 @@ type deleteSvcOpts struct {
 	deleteSvcVars
 
 	// Interfaces to dependencies.
-	store     store
-	sess      sessionProvider
-	spinner   progress
-	prompt    prompter
-	sel       configSelector
-	appCFN    svcRemoverFromApp
-	getSvcCFN func(session *awssession.Session) wlDeleter
-	getECR    func(session *awssession.Session) imageRemover
+	store             store
+	sess              sessionProvider
+	spinner           progress
+	prompt            prompter
+	sel               configSelector
+	appCFN            svcRemoverFromApp
+	getSvcCFN         func(session *awssession.Session) wlDeleter
+	getECR            func(session *awssession.Session) imageRemover
+	getStackDescriber func(session *awssession.Session) stackDescriber
+	getS3             func(session *awssession.Session) bucketEmptier
+
+	// Cached variables.
+	svcType string
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
@@ -94,6 +106,12 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		getECR: func(session *awssession.Session) imageRemover {
 			return ecr.New(session)
 		},
+		getStackDescriber: func(session *awssession.Session) stackDescriber {
+			return awscloudformation.New(session)
+		},
+		getS3: func(session *awssession.Session) bucketEmptier {
+			return s3.New(session)
+		},
 	}, nil
 }
 
@@ -163,6 +181,14 @@ func (o *deleteSvcOpts) Ask() error {
 // If the service is being removed from the application, Execute will
 // also delete the ECR repository and the SSM parameter.
 func (o *deleteSvcOpts) Execute() error {
+	// The service might be missing from the store if a previous deletion was interrupted.
+	// Its type is only needed to empty the bucket of a static site, so keep deleting its stacks.
+	if svc, err := o.store.GetService(o.appName, o.name); err != nil {
+		log.Debugf("Unable to get the type of service %s: %v.\n", o.name, err)
+	} else {
+		o.svcType = svc.Type
+	}
+
 	envs, err := o.appEnvironments()
 	if err != nil {
 		return err
@@ -260,6 +286,13 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 			return err
 		}
 
+		// The bucket of a static site must be empty before its stack can be deleted.
+		if o.svcType == manifest.StaticSiteType {
+			if err := o.emptySiteBucket(sess, env.Name); err != nil {
+				return err
+			}
+		}
+
 		cfClient := o.getSvcCFN(sess)
 		o.spinner.Start(fmt.Sprintf(fmtSvcDeleteStart, o.name, env.Name))
 		if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
@@ -275,6 +308,35 @@ func (o *deleteSvcOpts) deleteStacks(envs []*config.Environment) error {
 	return nil
 }
 
+func (o *deleteSvcOpts) emptySiteBucket(sess *awssession.Session, env string) error {
+	stackName := stack.NameForService(o.appName, env, o.name)
+	descr, err := o.getStackDescriber(sess).Describe(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			// The service isn't deployed to this environment.
+			return nil
+		}
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	var bucket string
+	for _, out := range descr.Outputs {
+		if aws.StringValue(out.OutputKey) == stack.StaticSiteOutputBucketName {
+			bucket = aws.StringValue(out.OutputValue)
+		}
+	}
+	if bucket == "" {
+		return nil
+	}
+	o.spinner.Start(fmt.Sprintf(fmtSvcEmptyBucketStart, o.name, env))
+	if err := o.getS3(sess).EmptyBucket(bucket); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcEmptyBucketFailed, o.name, env, err))
+		return fmt.Errorf("empty bucket %s: %w", bucket, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcEmptyBucketComplete, o.name, env))
+	return nil
+}
+
 // This is to make mocking easier in unit tests
 func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 	var uniqueRegions []string
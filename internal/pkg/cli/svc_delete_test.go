@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -311,6 +315,8 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
+	stackDescriber *mocks.MockstackDescriber
+	s3             *mocks.MockbucketEmptier
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -345,6 +351,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			inSvcName: mockSvcName,
 			setupMocks: func(mocks deleteSvcMocks) {
 				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.LoadBalancedWebServiceType,
+					}, nil),
+
 					// appEnvironments
 					mocks.store.EXPECT().ListEnvironments(gomock.Eq(mockAppName)).Times(1).Return(mockEnvs, nil),
 
@@ -380,6 +390,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			inEnvName: mockEnvName,
 			setupMocks: func(mocks deleteSvcMocks) {
 				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.LoadBalancedWebServiceType,
+					}, nil),
+
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 
@@ -407,6 +421,10 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			inEnvName: mockEnvName,
 			setupMocks: func(mocks deleteSvcMocks) {
 				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.LoadBalancedWebServiceType,
+					}, nil),
+
 					// appEnvironments
 					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Times(1).Return(mockEnv, nil),
 
@@ -419,6 +437,97 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("delete service: %w", testError),
 		},
+		"deletes the stacks even if the service configuration cannot be retrieved": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(nil, testError),
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"empties the bucket of a static site before deleting its stack": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.StaticSiteType,
+					}, nil),
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+
+					// emptySiteBucket
+					mocks.stackDescriber.EXPECT().Describe("badgoose-test-backend").Return(&awscloudformation.StackDescription{
+						Outputs: []*sdkcloudformation.Output{
+							{
+								OutputKey:   aws.String("BucketName"),
+								OutputValue: aws.String("mockBucket"),
+							},
+						},
+					}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcEmptyBucketStart, mockSvcName, mockEnvName)),
+					mocks.s3.EXPECT().EmptyBucket("mockBucket").Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcEmptyBucketComplete, mockSvcName, mockEnvName)),
+
+					// deleteStacks
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"skips emptying the bucket of a static site that is not deployed": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.StaticSiteType,
+					}, nil),
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackDescriber.EXPECT().Describe(gomock.Any()).Return(nil, &awscloudformation.ErrStackNotFound{}),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcDeleteStart, mockSvcName, mockEnvName)),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtSvcDeleteComplete, mockSvcName, mockEnvName)),
+				)
+			},
+		},
+		"errors when emptying the bucket of a static site": {
+			inAppName: mockAppName,
+			inSvcName: mockSvcName,
+			inEnvName: mockEnvName,
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetService(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifest.StaticSiteType,
+					}, nil),
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.stackDescriber.EXPECT().Describe(gomock.Any()).Return(&awscloudformation.StackDescription{
+						Outputs: []*sdkcloudformation.Output{
+							{
+								OutputKey:   aws.String("BucketName"),
+								OutputValue: aws.String("mockBucket"),
+							},
+						},
+					}, nil),
+					mocks.spinner.EXPECT().Start(fmt.Sprintf(fmtSvcEmptyBucketStart, mockSvcName, mockEnvName)),
+					mocks.s3.EXPECT().EmptyBucket("mockBucket").Return(testError),
+					mocks.spinner.EXPECT().Stop(log.Serrorf(fmtSvcEmptyBucketFailed, mockSvcName, mockEnvName, testError)),
+				)
+			},
+			wantedError: fmt.Errorf("empty bucket mockBucket: %w", testError),
+		},
 	}
 
 	for name, test := range tests {
@@ -434,6 +543,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			mockSvcCFN := mocks.NewMockwlDeleter(ctrl)
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockImageRemover := mocks.NewMockimageRemover(ctrl)
+			mockStackDescriber := mocks.NewMockstackDescriber(ctrl)
+			mockBucketEmptier := mocks.NewMockbucketEmptier(ctrl)
 			mockGetSvcCFN := func(_ *session.Session) wlDeleter {
 				return mockSvcCFN
 			}
@@ -449,6 +560,8 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mockSpinner,
 				svcCFN:         mockSvcCFN,
 				ecr:            mockImageRemover,
+				stackDescriber: mockStackDescriber,
+				s3:             mockBucketEmptier,
 			}
 
 			test.setupMocks(mocks)
@@ -465,6 +578,12 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				appCFN:    mockAppCFN,
 				getSvcCFN: mockGetSvcCFN,
 				getECR:    mockGetImageRemover,
+				getStackDescriber: func(_ *session.Session) stackDescriber {
+					return mockStackDescriber
+				},
+				getS3: func(_ *session.Session) bucketEmptier {
+					return mockBucketEmptier
+				},
 			}
 
 			// WHEN
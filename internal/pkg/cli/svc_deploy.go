@@ -5,6 +5,8 @@ package cli
 
 import (
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -12,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/spf13/afero"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +32,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
+const (
+	continueDeploymentPrompt = "Continue with the deployment?"
+)
+
 type deployWkldVars struct {
 	appName         string
 	name            string
@@ -37,6 +44,8 @@ type deployWkldVars struct {
 	resourceTags    map[string]string
 	forceNewUpdate  bool // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback bool
+	showDiff        bool
+	varFile         string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -48,15 +57,17 @@ type deploySvcOpts struct {
 	store                store
 	ws                   wsWlDirReader
 	unmarshal            func([]byte) (manifest.WorkloadManifest, error)
-	newInterpolator      func(app, env string) interpolator
+	newInterpolator      func(app, env string, opts ...manifest.InterpolatorOption) interpolator
 	cmd                  execRunner
+	fs                   afero.Fs
 	sessProvider         *sessions.Provider
 	newSvcDeployer       func() (workloadDeployer, error)
 	envFeaturesDescriber versionCompatibilityChecker
 
-	spinner progress
-	sel     wsSelector
-	prompt  prompter
+	spinner    progress
+	sel        wsSelector
+	prompt     prompter
+	diffWriter io.Writer
 
 	// cached variables
 	targetApp       *config.Application
@@ -65,6 +76,7 @@ type deploySvcOpts struct {
 	appliedManifest interface{}
 	rootUserARN     string
 	deployRecs      clideploy.ActionRecommender
+	noDeploy        bool
 }
 
 func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
@@ -93,7 +105,9 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		prompt:          prompter,
 		newInterpolator: newManifestInterpolator,
 		cmd:             exec.NewCmd(),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
 		sessProvider:    sessProvider,
+		diffWriter:      os.Stdout,
 	}
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -130,6 +144,8 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		deployer, err = clideploy.NewRDWSDeployer(&in)
 	case *manifest.WorkerService:
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
+	case *manifest.StaticSite:
+		deployer, err = clideploy.NewStaticSiteDeployer(&in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -139,13 +155,57 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	return deployer, nil
 }
 
-func newManifestInterpolator(app, env string) interpolator {
-	return manifest.NewInterpolator(app, env)
+func newManifestInterpolator(app, env string, opts ...manifest.InterpolatorOption) interpolator {
+	return manifest.NewInterpolator(app, env, opts...)
+}
+
+// manifestVars holds the sources of the variables substituted in a manifest in addition to the OS environment variables.
+type manifestVars struct {
+	env     *config.Environment // Environment of the manifest to predefine its account ID and region.
+	runner  execRunner          // Runner to predefine the git SHA of the workspace, if any.
+	fs      afero.Fs
+	varFile string // Path to a file of user defined variables, if any.
+}
+
+// interpolatorOpts returns the options to substitute the predefined and user defined variables in a manifest.
+func (v manifestVars) interpolatorOpts() ([]manifest.InterpolatorOption, error) {
+	var opts []manifest.InterpolatorOption
+	if v.env != nil {
+		opts = append(opts, manifest.WithAccountID(v.env.AccountID), manifest.WithRegion(v.env.Region))
+	}
+	if v.runner != nil {
+		// Best effort to predefine the git SHA, since the workspace might not be a git repository.
+		if sha, err := gitCommitSHA(v.runner); err == nil {
+			opts = append(opts, manifest.WithGitSHA(sha))
+		}
+	}
+	if v.varFile == "" {
+		return opts, nil
+	}
+	content, err := afero.ReadFile(v.fs, v.varFile)
+	if err != nil {
+		return nil, fmt.Errorf("read var file %s: %w", v.varFile, err)
+	}
+	vars, err := manifest.ParseVarFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("parse var file %s: %w", v.varFile, err)
+	}
+	return append(opts, manifest.WithVariables(vars)), nil
+}
+
+func validateVarFile(fs afero.Fs, path string) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := fs.Stat(path); err != nil {
+		return fmt.Errorf("check if var file %s exists: %w", path, err)
+	}
+	return nil
 }
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
-	return nil
+	return validateVarFile(o.fs, o.varFile)
 }
 
 // Ask prompts for and validates any required flags.
@@ -176,11 +236,20 @@ func (o *deploySvcOpts) Execute() error {
 			return err
 		}
 	}
+	interpolatorOpts, err := manifestVars{
+		env:     o.targetEnv,
+		runner:  o.cmd,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return err
+	}
 	mft, err := workloadManifest(&workloadManifestInput{
 		name:         o.name,
 		appName:      o.appName,
 		envName:      o.envName,
-		interpolator: o.newInterpolator(o.appName, o.envName),
+		interpolator: o.newInterpolator(o.appName, o.envName, interpolatorOpts...),
 		ws:           o.ws,
 		unmarshal:    o.unmarshal,
 	})
@@ -212,15 +281,26 @@ func (o *deploySvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	stackConfig := clideploy.StackRuntimeConfiguration{
+		ImageDigest:        uploadOut.ImageDigest,
+		EnvFileARN:         uploadOut.EnvFileARN,
+		AddonsURL:          uploadOut.AddonsURL,
+		RootUserARN:        o.rootUserARN,
+		Tags:               tags.Merge(targetApp.Tags, o.resourceTags),
+		CustomResourceURLs: uploadOut.CustomResourceURLs,
+	}
+	if o.showDiff {
+		contd, err := confirmWorkloadDiff(deployer, stackConfig, o.diffWriter, o.prompt)
+		if err != nil {
+			return err
+		}
+		if !contd {
+			o.noDeploy = true
+			return nil
+		}
+	}
 	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
-		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
-			ImageDigest:        uploadOut.ImageDigest,
-			EnvFileARN:         uploadOut.EnvFileARN,
-			AddonsURL:          uploadOut.AddonsURL,
-			RootUserARN:        o.rootUserARN,
-			Tags:               tags.Merge(targetApp.Tags, o.resourceTags),
-			CustomResourceURLs: uploadOut.CustomResourceURLs,
-		},
+		StackRuntimeConfiguration: stackConfig,
 		Options: clideploy.Options{
 			ForceNewUpdate:  o.forceNewUpdate,
 			DisableRollback: o.disableRollback,
@@ -247,6 +327,9 @@ After fixing the deployment, you can:
 
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
+	if o.noDeploy {
+		return nil
+	}
 	var recommendations []string
 	uriRecs, err := o.uriRecommendedActions()
 	if err != nil {
@@ -345,6 +428,38 @@ func (o *deploySvcOpts) configureClients() error {
 	return nil
 }
 
+// confirmWorkloadDiff writes the differences between the workload's template and its deployed template,
+// then asks the user whether to continue with the deployment.
+func confirmWorkloadDiff(deployer workloadDeployer, config clideploy.StackRuntimeConfiguration, w io.Writer, prompter prompter) (bool, error) {
+	output, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
+		StackRuntimeConfiguration: config,
+	})
+	if err != nil {
+		return false, fmt.Errorf("generate the template to compare against the deployed stack: %w", err)
+	}
+	return confirmDiff(deployer, output.Template, w, prompter)
+}
+
+// confirmDiff writes the differences between the template and the deployed template,
+// then asks the user whether to continue with the deployment.
+func confirmDiff(differ templateDiffer, tmpl string, w io.Writer, prompter prompter) (bool, error) {
+	out, err := differ.DeployDiff(tmpl)
+	if err != nil {
+		return false, err
+	}
+	if out == "" {
+		out = "No changes.\n"
+	}
+	if _, err := w.Write([]byte(out)); err != nil {
+		return false, fmt.Errorf("write diff: %w", err)
+	}
+	contd, err := prompter.Confirm(continueDeploymentPrompt, "")
+	if err != nil {
+		return false, fmt.Errorf("ask whether to continue with the deployment: %w", err)
+	}
+	return contd, nil
+}
+
 type workloadManifestInput struct {
 	name         string
 	appName      string
@@ -500,7 +615,9 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Shows the changes to the service's stack before deploying it.
+  /code $ copilot svc deploy --name frontend --env test --diff`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -516,6 +633,8 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 
 	return cmd
 }
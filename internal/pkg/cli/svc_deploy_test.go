@@ -6,6 +6,8 @@ package cli
 import (
 	"errors"
 	"fmt"
+	osexec "os/exec"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -16,6 +18,8 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/spf13/afero"
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
@@ -127,6 +131,7 @@ type deployMocks struct {
 	mockInterpolator         *mocks.Mockinterpolator
 	mockWsReader             *mocks.MockwsWlDirReader
 	mockEnvFeaturesDescriber *mocks.MockversionCompatibilityChecker
+	mockPrompter             *mocks.Mockprompter
 	mockMft                  *mockWorkloadMft
 }
 
@@ -138,8 +143,10 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	)
 	mockError := errors.New("some error")
 	testCases := map[string]struct {
-		mock func(m *deployMocks)
+		inShowDiff bool
+		mock       func(m *deployMocks)
 
+		wantedDiff  string
 		wantedError error
 	}{
 		"error out if fail to read workload manifest": {
@@ -216,6 +223,87 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: some error"),
 		},
+		"error if failed to generate the template to show diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("generate the template to compare against the deployed stack: some error"),
+		},
+		"error if failed to compare against the deployed template": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("template").Return("", mockError)
+			},
+			wantedError: mockError,
+		},
+		"do not deploy if the user declines after seeing the diff": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("template").Return("~ DesiredCount: 1 -> 2\n", nil)
+				m.mockPrompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(false, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(0)
+			},
+			wantedDiff: "~ DesiredCount: 1 -> 2\n",
+		},
+		"deploy after showing that there are no changes": {
+			inShowDiff: true,
+			mock: func(m *deployMocks) {
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return nil
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return(nil, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&deploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template: "template",
+				}, nil)
+				m.mockDeployer.EXPECT().DeployDiff("template").Return("", nil)
+				m.mockPrompter.EXPECT().Confirm(continueDeploymentPrompt, "").Return(true, nil)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Return(nil, nil)
+			},
+			wantedDiff: "No changes.\n",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -229,21 +317,26 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				mockInterpolator:         mocks.NewMockinterpolator(ctrl),
 				mockWsReader:             mocks.NewMockwsWlDirReader(ctrl),
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
+				mockPrompter:             mocks.NewMockprompter(ctrl),
 			}
 			tc.mock(m)
+			diffWriter := &strings.Builder{}
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					appName: mockAppName,
-					name:    mockSvcName,
-					envName: mockEnvName,
+					appName:  mockAppName,
+					name:     mockSvcName,
+					envName:  mockEnvName,
+					showDiff: tc.inShowDiff,
 
 					clientConfigured: true,
 				},
+				prompt:     m.mockPrompter,
+				diffWriter: diffWriter,
 				newSvcDeployer: func() (workloadDeployer, error) {
 					return m.mockDeployer, nil
 				},
-				newInterpolator: func(app, env string) interpolator {
+				newInterpolator: func(app, env string, _ ...manifest.InterpolatorOption) interpolator {
 					return m.mockInterpolator
 				},
 				ws: m.mockWsReader,
@@ -349,3 +442,104 @@ func (m *mockWorkloadMft) Validate() error {
 func (m *mockWorkloadMft) RequiredEnvironmentFeatures() []string {
 	return m.mockRequiredEnvironmentFeatures()
 }
+
+func TestManifestVars_interpolatorOpts(t *testing.T) {
+	testCases := map[string]struct {
+		varFile     string
+		setupMocks  func(m *mocks.MockexecRunner)
+		setupFS     func(fs afero.Fs)
+		wantedValue string
+		wantedErr   string
+	}{
+		"predefines the account ID, region and git SHA": {
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", []string{"rev-parse", "HEAD"}, gomock.Any()).
+					DoAndReturn(func(_ string, _ []string, opts ...exec.CmdOption) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						_, err := cmd.Stdout.Write([]byte("abc123\n"))
+						return err
+					})
+			},
+			wantedValue: "123456789012-us-west-2-abc123",
+		},
+		"skips the git SHA if the workspace is not a git repository": {
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			wantedErr: `line 1: environment variable "COPILOT_GIT_SHA" is not defined`,
+		},
+		"adds the variables from the var file": {
+			varFile: "vars.env",
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "vars.env", []byte("# Git SHA of the release.\nCOPILOT_GIT_SHA_OVERRIDE=def456\n"), 0644)
+			},
+			wantedValue: "123456789012-us-west-2-def456",
+		},
+		"error if the var file cannot be read": {
+			varFile: "vars.env",
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			wantedErr: "read var file vars.env: open vars.env: file does not exist",
+		},
+		"error if the var file is malformed": {
+			varFile: "vars.env",
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("git", gomock.Any(), gomock.Any()).Return(errors.New("not a git repository"))
+			},
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "vars.env", []byte("TAG"), 0644)
+			},
+			wantedErr: `parse var file vars.env: line 1: "TAG" must be of the form KEY=VALUE, where KEY contains only letters, digits and underscores`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			runner := mocks.NewMockexecRunner(ctrl)
+			tc.setupMocks(runner)
+			fs := afero.NewMemMapFs()
+			if tc.setupFS != nil {
+				tc.setupFS(fs)
+			}
+			vars := manifestVars{
+				env: &config.Environment{
+					AccountID: "123456789012",
+					Region:    "us-west-2",
+				},
+				runner:  runner,
+				fs:      fs,
+				varFile: tc.varFile,
+			}
+
+			// WHEN
+			opts, err := vars.interpolatorOpts()
+			if err == nil {
+				in := "${COPILOT_ACCOUNT_ID}-${COPILOT_REGION}-${COPILOT_GIT_SHA}"
+				if tc.varFile != "" {
+					in = "${COPILOT_ACCOUNT_ID}-${COPILOT_REGION}-${COPILOT_GIT_SHA_OVERRIDE}"
+				}
+				var out string
+				out, err = manifest.NewInterpolator("phonetool", "test", opts...).Interpolate(in)
+				if err == nil {
+					require.Equal(t, tc.wantedValue, strings.TrimSpace(out))
+				}
+			}
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDiffNamePrompt     = "Which service would you like to compare with its deployed version?"
+	svcDiffNameHelpPrompt = "The local manifest of the service is compared with the manifest of its latest deployment."
+	svcDiffEnvPrompt      = "Which environment is the service deployed to?"
+	svcDiffEnvHelpPrompt  = "The service is compared with its deployment in this environment."
+
+	fmtDetectDriftStart    = "Detecting drift on stack %s."
+	fmtDetectDriftComplete = "Drift detection complete on stack %s."
+	fmtDetectDriftFailed   = "Failed to detect drift on stack %s."
+)
+
+type svcDiffVars struct {
+	appName string
+	envName string
+	name    string
+	varFile string
+}
+
+type svcDiffOpts struct {
+	svcDiffVars
+
+	store           store
+	ws              wsSvcReader
+	sel             wsSelector
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+	cmd             execRunner
+	fs              afero.Fs
+	spinner         progress
+	w               io.Writer
+
+	// Clients initialized once the environment of the service is known.
+	initClients func(env *config.Environment) error
+	deployedMft deployedManifestGetter
+	drifter     stackDriftDetector
+}
+
+func newSvcDiffOpts(vars svcDiffVars) (*svcDiffOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc diff"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	opts := &svcDiffOpts{
+		svcDiffVars:     vars,
+		store:           store,
+		ws:              ws,
+		sel:             selector.NewLocalWorkloadSelector(prompt.New(), store, ws),
+		unmarshal:       manifest.UnmarshalWorkload,
+		newInterpolator: newManifestInterpolator,
+		cmd:             exec.NewCmd(),
+		fs:              &afero.Afero{Fs: afero.NewOsFs()},
+		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
+		w:               os.Stdout,
+	}
+	opts.initClients = func(env *config.Environment) error {
+		d, err := describe.NewWorkloadStackDescriber(describe.NewServiceConfig{
+			App:         opts.appName,
+			Svc:         opts.name,
+			ConfigStore: store,
+		}, env.Name)
+		if err != nil {
+			return fmt.Errorf("create stack describer for service %s: %w", opts.name, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.deployedMft = d
+		opts.drifter = awscloudformation.New(envSess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcDiffOpts) Validate() error {
+	return validateVarFile(o.fs, o.varFile)
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcDiffOpts) Ask() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if err := o.validateOrAskSvcName(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnvName()
+}
+
+// Execute compares the local manifest of the service with the deployed one, and detects drift on the service stack.
+// It returns an error that makes the CLI exit with a non-zero code if there is any difference.
+func (o *svcDiffOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	if err := o.initClients(env); err != nil {
+		return err
+	}
+	interpolatorOpts, err := manifestVars{
+		env:     env,
+		runner:  o.cmd,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return err
+	}
+	interpolator := o.newInterpolator(o.appName, o.envName, interpolatorOpts...)
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s: %w", o.name, err)
+	}
+	mft, localMft, err := o.normalizedManifest(raw, interpolator)
+	if err != nil {
+		return err
+	}
+	deployedRaw, err := o.readDeployedManifest()
+	if err != nil {
+		return err
+	}
+	_, deployedMft, err := o.normalizedManifest(deployedRaw, interpolator)
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForService(o.appName, o.envName, o.name)
+	o.spinner.Start(fmt.Sprintf(fmtDetectDriftStart, stackName))
+	drifts, err := o.drifter.StackDrift(context.Background(), stackName)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtDetectDriftFailed+"\n", stackName))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtDetectDriftComplete+"\n", stackName))
+	if autoscaled, ok := mft.(interface{ HasAutoscaling() bool }); ok && autoscaled.HasAutoscaling() {
+		drifts = withoutDesiredCount(drifts)
+	}
+	report, err := newDriftReport(deployedMft, localMft, drifts)
+	if err != nil {
+		return err
+	}
+	if err := report.write(o.w); err != nil {
+		return err
+	}
+	if report.hasDrifted() {
+		return &errDriftDetected{
+			name: fmt.Sprintf("service %s in environment %s", o.name, o.envName),
+		}
+	}
+	return nil
+}
+
+func (o *svcDiffOpts) readDeployedManifest() ([]byte, error) {
+	out, err := o.deployedMft.Manifest()
+	if err != nil {
+		var errNotFound *describe.ErrManifestNotFoundInTemplate
+		if errors.As(err, &errNotFound) {
+			log.Infof("Redeploy the service with %s to store its manifest in the stack.\n",
+				color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.envName)))
+		}
+		return nil, fmt.Errorf("get deployed manifest of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	return out, nil
+}
+
+// normalizedManifest interpolates a manifest, applies the environment overrides and serializes it
+// so that it can be compared field by field. It also returns the manifest with the overrides applied.
+func (o *svcDiffOpts) normalizedManifest(raw []byte, interpolator interpolator) (manifest.WorkloadManifest, []byte, error) {
+	interpolated, err := interpolator.Interpolate(string(raw))
+	if err != nil {
+		return nil, nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
+	mft, err := o.unmarshal([]byte(interpolated))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	out, err := manifest.MarshalNormalized(envMft)
+	if err != nil {
+		return nil, nil, fmt.Errorf("normalize manifest of service %s: %w", o.name, err)
+	}
+	return envMft, out, nil
+}
+
+func (o *svcDiffOpts) validateOrAskSvcName() error {
+	if o.name != "" {
+		names, err := o.ws.ListServices()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		for _, name := range names {
+			if o.name == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+	}
+	name, err := o.sel.Service(svcDiffNamePrompt, svcDiffNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select service: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *svcDiffOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Environment(svcDiffEnvPrompt, svcDiffEnvHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// buildSvcDiffCmd builds the command for comparing a service with its deployed version.
+func buildSvcDiffCmd() *cobra.Command {
+	vars := svcDiffVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares a service with its deployed version and detects drift.",
+		Long: `Compares the local manifest of a service with the manifest of its last deployment, field by field,
+and detects drift on the resources of its CloudFormation stack.
+Exits with code 2 if the service has drifted from its deployed configuration.`,
+
+		Example: `
+  Compare the "frontend" service in your workspace with its deployment in the "prod" environment.
+  /code $ copilot svc diff -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDiffOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
+	return cmd
+}
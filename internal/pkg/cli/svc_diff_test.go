@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type svcDiffMocks struct {
+	store        *mocks.Mockstore
+	ws           *mocks.MockwsSvcReader
+	interpolator *mocks.Mockinterpolator
+	deployedMft  *mocks.MockdeployedManifestGetter
+	drifter      *mocks.MockstackDriftDetector
+	spinner      *mocks.Mockprogress
+}
+
+func TestSvcDiffOpts_Execute(t *testing.T) {
+	const localMft = `name: fe
+type: Backend Service
+image:
+  location: nginx
+count: 1
+environments:
+  test:
+    count: 2
+`
+	const deployedMft = `name: fe
+type: Backend Service
+image:
+  location: nginx
+count: 1
+environments:
+  test:
+    count: 3
+`
+	const autoscaledMft = `name: fe
+type: Backend Service
+image:
+  location: nginx
+count:
+  range: 1-10
+  cpu_percentage: 70
+`
+	testCases := map[string]struct {
+		setupMocks func(m *svcDiffMocks)
+
+		wantedOutput   string
+		wantedError    string
+		wantedExitCode int
+	}{
+		"returns a wrapped error if the deployed manifest can't be retrieved": {
+			setupMocks: func(m *svcDiffMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			wantedError: "get deployed manifest of service fe in environment test: some error",
+		},
+		"returns the error if drift can't be detected on the stack": {
+			setupMocks: func(m *svcDiffMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(localMft), nil)
+				m.spinner.EXPECT().Start("Detecting drift on stack phonetool-test-fe.")
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test-fe").Return(nil, errors.New("some error"))
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: "some error",
+		},
+		"succeeds if the service hasn't drifted": {
+			setupMocks: func(m *svcDiffMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(localMft), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test-fe").Return(nil, nil)
+				m.spinner.EXPECT().Stop(log.Ssuccessf("Drift detection complete on stack phonetool-test-fe.\n"))
+			},
+			wantedOutput: `Manifest
+No differences between the local and the deployed manifest.
+
+Stack resources
+No resources drifted from the deployed template.
+`,
+		},
+		"ignores the desired count of an autoscaled service": {
+			setupMocks: func(m *svcDiffMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return([]byte(autoscaledMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(autoscaledMft), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test-fe").Return([]*awscloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Service"),
+						ResourceType:             aws.String("AWS::ECS::Service"),
+						StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+						PropertyDifferences: []*cloudformation.PropertyDifference{
+							{
+								DifferenceType: aws.String(cloudformation.DifferenceTypeNotEqual),
+								PropertyPath:   aws.String("/DesiredCount"),
+								ExpectedValue:  aws.String("1"),
+								ActualValue:    aws.String("7"),
+							},
+						},
+					},
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `Manifest
+No differences between the local and the deployed manifest.
+
+Stack resources
+No resources drifted from the deployed template.
+`,
+		},
+		"reports the manifest differences and the drifted resources": {
+			setupMocks: func(m *svcDiffMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("fe").Return([]byte(localMft), nil)
+				m.deployedMft.EXPECT().Manifest().Return([]byte(deployedMft), nil)
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.drifter.EXPECT().StackDrift(gomock.Any(), "phonetool-test-fe").Return([]*awscloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Service"),
+						ResourceType:             aws.String("AWS::ECS::Service"),
+						StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+						PropertyDifferences: []*cloudformation.PropertyDifference{
+							{
+								DifferenceType: aws.String(cloudformation.DifferenceTypeNotEqual),
+								PropertyPath:   aws.String("/DesiredCount"),
+								ExpectedValue:  aws.String("3"),
+								ActualValue:    aws.String("5"),
+							},
+						},
+					},
+				}, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `Manifest
+~ count: 3 -> 2
+
+Stack resources
+Service (AWS::ECS::Service): MODIFIED
+  NOT_EQUAL /DesiredCount: expected 3, actual 5
+`,
+			wantedError:    "service fe in environment test has drifted from its deployed configuration",
+			wantedExitCode: 2,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &svcDiffMocks{
+				store:        mocks.NewMockstore(ctrl),
+				ws:           mocks.NewMockwsSvcReader(ctrl),
+				interpolator: mocks.NewMockinterpolator(ctrl),
+				deployedMft:  mocks.NewMockdeployedManifestGetter(ctrl),
+				drifter:      mocks.NewMockstackDriftDetector(ctrl),
+				spinner:      mocks.NewMockprogress(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			m.interpolator.EXPECT().Interpolate(gomock.Any()).DoAndReturn(func(s string) (string, error) { return s, nil }).AnyTimes()
+			tc.setupMocks(m)
+			out := &strings.Builder{}
+			opts := &svcDiffOpts{
+				svcDiffVars: svcDiffVars{
+					appName: "phonetool",
+					envName: "test",
+					name:    "fe",
+				},
+				store:     m.store,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
+					return m.interpolator
+				},
+				fs:      afero.NewMemMapFs(),
+				spinner: m.spinner,
+				w:       out,
+				initClients: func(_ *config.Environment) error {
+					return nil
+				},
+				deployedMft: m.deployedMft,
+				drifter:     m.drifter,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedExitCode != 0 {
+				var exitCodeErr *errDriftDetected
+				require.ErrorAs(t, err, &exitCodeErr)
+				require.Equal(t, tc.wantedExitCode, exitCodeErr.ExitCode())
+			}
+			require.Equal(t, tc.wantedOutput, out.String())
+		})
+	}
+}
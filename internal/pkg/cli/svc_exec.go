@@ -109,41 +109,49 @@ func (o *svcExecOpts) Ask() error {
 
 // Execute executes a command in a running container.
 func (o *svcExecOpts) Execute() error {
+	sess, in, err := o.selectContainerTarget()
+	if err != nil {
+		return err
+	}
+	in.Command = o.command
+	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
+		color.HighlightUserInput(in.Container), color.HighlightResource(in.Task))
+	if err = o.newCommandExecutor(sess).ExecuteCommand(in); err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to execute command %s. Is %s set in your manifest?\n", o.command, color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("execute command %s in container %s: %w", o.command, in.Container, err)
+	}
+	return nil
+}
+
+// selectContainerTarget returns the session of the environment, and the cluster, task and container to execute into.
+func (o *svcExecOpts) selectContainerTarget() (*session.Session, awsecs.ExecuteCommandInput, error) {
 	wkld, err := o.store.GetWorkload(o.appName, o.name)
 	if err != nil {
-		return fmt.Errorf("get workload: %w", err)
+		return nil, awsecs.ExecuteCommandInput{}, fmt.Errorf("get workload: %w", err)
 	}
 	if wkld.Type == manifest.RequestDrivenWebServiceType {
-		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+		return nil, awsecs.ExecuteCommandInput{}, fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
 	}
 	sess, err := o.envSession()
 	if err != nil {
-		return err
+		return nil, awsecs.ExecuteCommandInput{}, err
 	}
 	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
 	if err != nil {
-		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+		return nil, awsecs.ExecuteCommandInput{}, fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
 	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
 	if err != nil {
-		return err
+		return nil, awsecs.ExecuteCommandInput{}, err
 	}
-	container := o.selectContainer()
-	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
-		color.HighlightUserInput(container), color.HighlightResource(taskID))
-	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
+	return sess, awsecs.ExecuteCommandInput{
 		Cluster:   svcDesc.ClusterName,
-		Command:   o.command,
-		Container: container,
 		Task:      taskID,
-	}); err != nil {
-		var errExecCmd *awsecs.ErrExecuteCommand
-		if errors.As(err, &errExecCmd) {
-			log.Errorf("Failed to execute command %s. Is %s set in your manifest?\n", o.command, color.HighlightCode("exec: true"))
-		}
-		return fmt.Errorf("execute command %s in container %s: %w", o.command, container, err)
-	}
-	return nil
+		Container: o.selectContainer(),
+	}, nil
 }
 
 func (o *svcExecOpts) validateOrAskApp() error {
@@ -41,6 +41,7 @@ Other repositories are specified with either repository-url/image:tag or reposit
 
 const (
 	defaultSvcPortString = "80"
+	defaultSourceDir     = "public"
 	service              = "service"
 	job                  = "job"
 )
@@ -57,11 +58,15 @@ A %s is a private, non internet-facing service accessible from other services in
 To learn more see: https://git.io/JEEJt
 
 A %s is a private service that can consume messages published to topics in your application.
-To learn more see: https://git.io/JEEJY`,
+To learn more see: https://git.io/JEEJY
+
+A %s is an internet-facing website whose files are stored in Amazon S3 and served by Amazon CloudFront.
+To learn more see: https://aws.github.io/copilot-cli/docs/manifest/static-site/`,
 		manifest.RequestDrivenWebServiceType,
 		manifest.LoadBalancedWebServiceType,
 		manifest.BackendServiceType,
 		manifest.WorkerServiceType,
+		manifest.StaticSiteType,
 	)
 
 	fmtWkldInitNamePrompt     = "What do you want to %s this %s?"
@@ -81,6 +86,10 @@ You should set this to the port which your Dockerfile uses to communicate with t
 	svcInitPublisherHelpPrompt = `A publisher is an existing SNS Topic to which a service publishes messages. 
 These messages can be consumed by the Worker Service.`
 
+	svcInitSourceDirPrompt     = "Which %s contains the files of your site?"
+	svcInitSourceDirHelpPrompt = `The files in this directory are uploaded to an S3 bucket and served by a CloudFront distribution.
+If your site is built with a tool, specify the output directory of the build and set "source.build" in the manifest.`
+
 	wkldInitImagePrompt = fmt.Sprintf("What's the %s ([registry/]repository[:tag|@digest]) of the image to use?", color.Emphasize("location"))
 )
 
@@ -89,6 +98,7 @@ var serviceTypeHints = map[string]string{
 	manifest.LoadBalancedWebServiceType:  "Internet to ECS on Fargate",
 	manifest.BackendServiceType:          "ECS on Fargate",
 	manifest.WorkerServiceType:           "Events to SQS to ECS on Fargate",
+	manifest.StaticSiteType:              "S3 and CloudFront",
 }
 
 type initWkldVars struct {
@@ -104,7 +114,8 @@ type initWkldVars struct {
 type initSvcVars struct {
 	initWkldVars
 
-	port uint16
+	port      uint16
+	sourceDir string
 }
 
 type initSvcOpts struct {
@@ -206,6 +217,9 @@ func (o *initSvcOpts) Validate() error {
 			return err
 		}
 	}
+	if o.sourceDir != "" && o.wkldType != "" && o.wkldType != manifest.StaticSiteType {
+		return fmt.Errorf("--%s can only be specified with a %s", sourceDirFlag, manifest.StaticSiteType)
+	}
 	if o.image != "" && o.wkldType == manifest.RequestDrivenWebServiceType {
 		if err := validateAppRunnerImage(o.image); err != nil {
 			return err
@@ -259,6 +273,9 @@ func (o *initSvcOpts) Ask() error {
 	if shouldSkipAsking {
 		return nil
 	}
+	if o.wkldType == manifest.StaticSiteType {
+		return o.askSourceDir()
+	}
 	err = o.askDockerfile()
 	if err != nil {
 		return err
@@ -289,7 +306,7 @@ func (o *initSvcOpts) Execute() error {
 		}
 	}
 	// If the user passes in an image, their docker engine isn't necessarily running, and we can't do anything with the platform because we're not building the Docker image.
-	if o.image == "" && !o.manifestExists {
+	if o.image == "" && !o.manifestExists && o.wkldType != manifest.StaticSiteType {
 		platform, err := legitimizePlatform(o.dockerEngine, o.wkldType)
 		if err != nil {
 			return err
@@ -312,6 +329,7 @@ func (o *initSvcOpts) Execute() error {
 		},
 		Port:        o.port,
 		HealthCheck: hc,
+		SourceDir:   o.sourceDir,
 	})
 	if err != nil {
 		return err
@@ -533,6 +551,24 @@ func (o *initSvcOpts) askSvcPort() (err error) {
 	return nil
 }
 
+func (o *initSvcOpts) askSourceDir() error {
+	if o.sourceDir != "" {
+		return nil
+	}
+	dir, err := o.prompt.Get(
+		fmt.Sprintf(svcInitSourceDirPrompt, color.Emphasize("directory")),
+		svcInitSourceDirHelpPrompt,
+		prompt.RequireNonEmpty,
+		prompt.WithDefaultInput(defaultSourceDir),
+		prompt.WithFinalMessage("Source directory:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get source directory: %w", err)
+	}
+	o.sourceDir = dir
+	return nil
+}
+
 func legitimizePlatform(engine dockerEngine, wkldType string) (manifest.PlatformString, error) {
 	if err := engine.CheckDockerEngineRunning(); err != nil {
 		// This is a best-effort attempt to detect the platform for users.
@@ -681,7 +717,10 @@ This command is also run as part of "copilot init".`,
   /code $ copilot svc init --name frontend --svc-type "Load Balanced Web Service" --dockerfile ./frontend/Dockerfile
 
   Create a "subscribers" backend service.
-  /code $ copilot svc init --name subscribers --svc-type "Backend Service"`,
+  /code $ copilot svc init --name subscribers --svc-type "Backend Service"
+
+  Create a "website" static site from the files in the "public" directory.
+  /code $ copilot svc init --name website --svc-type "Static Site" --source-dir ./public`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitSvcOpts(vars)
 			if err != nil {
@@ -709,6 +748,7 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVarP(&vars.dockerfilePath, dockerFileFlag, dockerFileFlagShort, "", dockerFileFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().Uint16Var(&vars.port, svcPortFlag, 0, svcPortFlagDescription)
+	cmd.Flags().StringVar(&vars.sourceDir, sourceDirFlag, "", sourceDirFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.subscriptions, subscribeTopicsFlag, []string{}, subscribeTopicsFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSubscribe, noSubscriptionFlag, false, noSubscriptionFlagDescription)
 
@@ -44,6 +44,7 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 		inSvcPort        uint16
 		inSubscribeTags  []string
 		inNoSubscribe    bool
+		inSourceDir      string
 
 		setupMocks     func(mocks initSvcMocks)
 		mockFileSystem func(mockFS afero.Fs)
@@ -102,6 +103,15 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 			},
 			wantedErr: errors.New("validate subscribe configuration: cannot specify both --no-subscribe and --subscribe-topics"),
 		},
+		"fail if source dir is set for a service that is not a static site": {
+			inSvcName:   "frontend",
+			inSvcType:   "Load Balanced Web Service",
+			inSourceDir: "public",
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedErr: errors.New("--source-dir can only be specified with a Static Site"),
+		},
 		"valid flags": {
 			inSvcName:        "frontend",
 			inSvcType:        "Load Balanced Web Service",
@@ -141,7 +151,8 @@ func TestSvcInitOpts_Validate(t *testing.T) {
 						subscriptions:  tc.inSubscribeTags,
 						noSubscribe:    tc.inNoSubscribe,
 					},
-					port: tc.inSvcPort,
+					port:      tc.inSvcPort,
+					sourceDir: tc.inSourceDir,
 				},
 				store:     mockstore,
 				fs:        &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -185,14 +196,16 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 		inSvcPort        uint16
 		inSubscribeTags  []string
 		inNoSubscribe    bool
+		inSourceDir      string
 
 		setupMocks func(mocks initSvcMocks)
 
-		wantedErr error
+		wantedSourceDir string
+		wantedErr       error
 	}{
 		"invalid service type": {
 			inSvcType: "TestSvcType",
-			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service"`),
+			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service", "Static Site"`),
 		},
 		"invalid service name": {
 			inSvcType: wantedSvcType,
@@ -334,6 +347,10 @@ type: Request-Driven Web Service`), nil)
 						Value: manifest.WorkerServiceType,
 						Hint:  "Events to SQS to ECS on Fargate",
 					},
+					{
+						Value: manifest.StaticSiteType,
+						Hint:  "S3 and CloudFront",
+					},
 				}), gomock.Any()).
 					Return(wantedSvcType, nil)
 				m.mockStore.EXPECT().GetService(mockAppName, wantedSvcName).Return(nil, &config.ErrNoSuchService{}).Times(2)
@@ -609,6 +626,40 @@ type: Request-Driven Web Service`), nil)
 				).Return([]deploy.Topic{*mockTopic}, nil)
 			},
 		},
+		"prompt for the source directory of a static site instead of a Dockerfile": {
+			inSvcType: manifest.StaticSiteType,
+			inSvcName: wantedSvcName,
+
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetService(mockAppName, wantedSvcName).Return(nil, &config.ErrNoSuchService{})
+				m.mockMftReader.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(nil, &workspace.ErrFileNotExists{FileName: wantedSvcName})
+				m.mockPrompt.EXPECT().Get(gomock.Eq(fmt.Sprintf(svcInitSourceDirPrompt, "directory")), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("dist", nil)
+			},
+			wantedSourceDir: "dist",
+		},
+		"skip asking for the source directory of a static site if the flag is set": {
+			inSvcType:   manifest.StaticSiteType,
+			inSvcName:   wantedSvcName,
+			inSourceDir: "public",
+
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetService(mockAppName, wantedSvcName).Return(nil, &config.ErrNoSuchService{})
+				m.mockMftReader.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(nil, &workspace.ErrFileNotExists{FileName: wantedSvcName})
+			},
+			wantedSourceDir: "public",
+		},
+		"return an error if fail to get the source directory of a static site": {
+			inSvcType: manifest.StaticSiteType,
+			inSvcName: wantedSvcName,
+
+			setupMocks: func(m initSvcMocks) {
+				m.mockStore.EXPECT().GetService(mockAppName, wantedSvcName).Return(nil, &config.ErrNoSuchService{})
+				m.mockMftReader.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(nil, &workspace.ErrFileNotExists{FileName: wantedSvcName})
+				m.mockPrompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", mockError)
+			},
+			wantedErr: fmt.Errorf("get source directory: mock error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -648,7 +699,8 @@ type: Request-Driven Web Service`), nil)
 						subscriptions:  tc.inSubscribeTags,
 						appName:        mockAppName,
 					},
-					port: tc.inSvcPort,
+					port:      tc.inSvcPort,
+					sourceDir: tc.inSourceDir,
 				},
 				store: mockStore,
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
@@ -677,6 +729,9 @@ type: Request-Driven Web Service`), nil)
 				if opts.image != "" {
 					require.Equal(t, wantedImage, opts.image)
 				}
+				if tc.wantedSourceDir != "" {
+					require.Equal(t, tc.wantedSourceDir, opts.sourceDir)
+				}
 			}
 		})
 	}
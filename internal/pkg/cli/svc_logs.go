@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -20,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -43,10 +45,20 @@ type wkldLogsVars struct {
 	taskIDs          []string
 	since            time.Duration
 	logGroup         string
+	outputFields     []string
+	level            string
 }
 
-type svcLogsOpts struct {
+type svcLogsVars struct {
 	wkldLogsVars
+
+	svcNames      []string // Services to interleave the logs of, mutually exclusive with the name of a single service.
+	containerName string
+	filterPattern string
+}
+
+type svcLogsOpts struct {
+	svcLogsVars
 	wkldLogOpts
 	// cached variables.
 	targetEnv *config.Environment
@@ -65,7 +77,7 @@ type wkldLogOpts struct {
 	initLogsSvc func() error // Overridden in tests.
 }
 
-func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
+func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc logs"))
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
@@ -78,7 +90,7 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
 	}
 	opts := &svcLogsOpts{
-		wkldLogsVars: vars,
+		svcLogsVars: vars,
 		wkldLogOpts: wkldLogOpts{
 			w:           log.OutputWriter,
 			configStore: configStore,
@@ -91,24 +103,33 @@ func newSvcLogOpts(vars wkldLogsVars) (*svcLogsOpts, error) {
 		if err != nil {
 			return fmt.Errorf("get environment: %w", err)
 		}
-		workload, err := configStore.GetWorkload(opts.appName, opts.name)
-		if err != nil {
-			return fmt.Errorf("get workload: %w", err)
-		}
 		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 		if err != nil {
 			return err
 		}
-		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
-			App:         opts.appName,
-			Env:         opts.envName,
-			Svc:         opts.name,
-			Sess:        sess,
-			LogGroup:    opts.logGroup,
-			WkldType:    workload.Type,
-			TaskIDs:     opts.taskIDs,
-			ConfigStore: configStore,
-		})
+		var cfgs []*logging.NewServiceLogsConfig
+		for _, name := range opts.services() {
+			workload, err := configStore.GetWorkload(opts.appName, name)
+			if err != nil {
+				return fmt.Errorf("get workload: %w", err)
+			}
+			cfgs = append(cfgs, &logging.NewServiceLogsConfig{
+				App:         opts.appName,
+				Env:         opts.envName,
+				Svc:         name,
+				Sess:        sess,
+				LogGroup:    opts.logGroup,
+				WkldType:    workload.Type,
+				TaskIDs:     opts.taskIDs,
+				Container:   opts.containerName,
+				ConfigStore: configStore,
+			})
+		}
+		if len(opts.svcNames) != 0 {
+			opts.logsSvc, err = logging.NewServicesClient(cfgs)
+		} else {
+			opts.logsSvc, err = logging.NewServiceClient(cfgs[0])
+		}
 		if err != nil {
 			return err
 		}
@@ -155,6 +176,25 @@ func (o *svcLogsOpts) Validate() error {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
 
+	if err := o.validateStructuredLogFlags(); err != nil {
+		return err
+	}
+
+	if len(o.svcNames) != 0 {
+		if o.name != "" {
+			return fmt.Errorf("only one of --%s or --%s may be used", nameFlag, svcsFlag)
+		}
+		if o.taskIDs != nil {
+			return fmt.Errorf("only one of --%s or --%s may be used", tasksFlag, svcsFlag)
+		}
+		if o.containerName != "" {
+			return fmt.Errorf("only one of --%s or --%s may be used", containerFlag, svcsFlag)
+		}
+		if o.logGroup != "" {
+			return fmt.Errorf("only one of --%s or --%s may be used", logGroupFlag, svcsFlag)
+		}
+	}
+
 	return nil
 }
 
@@ -163,6 +203,9 @@ func (o *svcLogsOpts) Ask() error {
 	if err := o.validateOrAskApp(); err != nil {
 		return err
 	}
+	if len(o.svcNames) != 0 {
+		return o.validateAndAskSvcsEnvName()
+	}
 	return o.validateAndAskSvcEnvName()
 }
 
@@ -171,24 +214,21 @@ func (o *svcLogsOpts) Execute() error {
 	if err := o.initLogsSvc(); err != nil {
 		return err
 	}
-	eventsWriter := logging.WriteHumanLogs
-	if o.shouldOutputJSON {
-		eventsWriter = logging.WriteJSONLogs
-	}
 	var limit *int64
 	if o.limit != 0 {
 		limit = aws.Int64(int64(o.limit))
 	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
-		Follow:    o.follow,
-		Limit:     limit,
-		EndTime:   o.endTime,
-		StartTime: o.startTime,
-		TaskIDs:   o.taskIDs,
-		OnEvents:  eventsWriter,
+		Follow:        o.follow,
+		Limit:         limit,
+		EndTime:       o.endTime,
+		StartTime:     o.startTime,
+		TaskIDs:       o.taskIDs,
+		FilterPattern: o.filterPattern,
+		OnEvents:      o.eventsWriter(),
 	})
 	if err != nil {
-		return fmt.Errorf("write log events for service %s: %w", o.name, err)
+		return fmt.Errorf("write log events for %s %s: %w", english.PluralWord(len(o.services()), "service", "services"), strings.Join(o.services(), ", "), err)
 	}
 	return nil
 }
@@ -229,6 +269,44 @@ func (o *svcLogsOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
+// validateAndAskSvcsEnvName validates that all the services are deployed in the environment.
+// If the environment isn't provided, it prompts for one of the environments where the first service is deployed.
+func (o *svcLogsOpts) validateAndAskSvcsEnvName() error {
+	for _, name := range o.svcNames {
+		if _, err := o.configStore.GetService(o.appName, name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcLogNamePrompt, svcLogNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.svcNames[0]))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.envName = deployedService.Env
+	for _, name := range o.svcNames[1:] {
+		deployed, err := o.deployStore.IsServiceDeployed(o.appName, o.envName, name)
+		if err != nil {
+			return fmt.Errorf("check if service %s is deployed in environment %s: %w", name, o.envName, err)
+		}
+		if !deployed {
+			return fmt.Errorf("service %s is not deployed in environment %s", name, o.envName)
+		}
+	}
+	return nil
+}
+
+// services returns the names of the services to retrieve logs from.
+func (o *svcLogsOpts) services() []string {
+	if len(o.svcNames) != 0 {
+		return o.svcNames
+	}
+	return []string{o.name}
+}
+
 func (o *svcLogsOpts) getTargetEnv() (*config.Environment, error) {
 	if o.targetEnv != nil {
 		return o.targetEnv, nil
@@ -241,6 +319,28 @@ func (o *svcLogsOpts) getTargetEnv() (*config.Environment, error) {
 	return o.targetEnv, nil
 }
 
+func (v wkldLogsVars) validateStructuredLogFlags() error {
+	if v.level != "" && !logging.IsValidLogLevel(v.level) {
+		return fmt.Errorf("invalid --%s %s: must be one of %s", levelFlag, v.level, prettify(logging.LogLevels))
+	}
+	if len(v.outputFields) != 0 && v.shouldOutputJSON {
+		return fmt.Errorf("only one of --%s or --%s may be used", outputFieldsFlag, jsonFlag)
+	}
+	return nil
+}
+
+// eventsWriter returns the handler that writes log events in the requested format.
+func (v wkldLogsVars) eventsWriter() func(w io.Writer, logs []logging.HumanJSONStringer) error {
+	structured := logging.StructuredLogWriter{
+		Fields: v.outputFields,
+		Level:  v.level,
+	}
+	if v.shouldOutputJSON {
+		return structured.WriteJSONLogs
+	}
+	return structured.WriteHumanLogs
+}
+
 func parseSince(since time.Duration) *int64 {
 	sinceSec := int64(since.Round(time.Second).Seconds())
 	timeNow := time.Now().Add(time.Duration(-sinceSec) * time.Second)
@@ -257,7 +357,7 @@ func parseRFC3339(timeStr string) (int64, error) {
 
 // buildSvcLogsCmd builds the command for displaying service logs in an application.
 func buildSvcLogsCmd() *cobra.Command {
-	vars := wkldLogsVars{}
+	vars := svcLogsVars{}
 	cmd := &cobra.Command{
 		Use:   "logs",
 		Short: "Displays logs of a deployed service.",
@@ -274,7 +374,13 @@ func buildSvcLogsCmd() *cobra.Command {
   Displays logs in real time.
   /code $ copilot svc logs --follow
   Display logs from specific log group.
-  /code $ copilot svc logs --log-group system`,
+  /code $ copilot svc logs --log-group system
+  Displays error logs from the "nginx" sidecar.
+  /code $ copilot svc logs --container nginx --filter ERROR
+  Displays the level, message and trace ID of JSON logs at or above the "error" level.
+  /code $ copilot svc logs --output-fields level,msg,trace_id --level error
+  Displays the interleaved logs of multiple services in real time.
+  /code $ copilot svc logs --svcs frontend,api,worker -e test --follow`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcLogOpts(vars)
 			if err != nil {
@@ -294,5 +400,10 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
+	cmd.Flags().StringVar(&vars.filterPattern, filterFlag, "", filterFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.outputFields, outputFieldsFlag, nil, outputFieldsFlagDescription)
+	cmd.Flags().StringVar(&vars.level, levelFlag, "", levelFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerLogsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.svcNames, svcsFlag, nil, svcsLogsFlagDescription)
 	return cmd
 }
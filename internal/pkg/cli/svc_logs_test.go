@@ -20,6 +20,7 @@ import (
 
 type svcLogsMock struct {
 	configStore *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
 	sel         *mocks.MockdeploySelector
 }
 
@@ -40,6 +41,8 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputSvcs      []string
+		inputTaskIDs   []string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -99,6 +102,22 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("--limit 10001 is out-of-bounds, value must be between 1 and 10000"),
 		},
+		"returns error if both name and svcs flags are set": {
+			inputSvc:  "fe",
+			inputSvcs: []string{"fe", "api"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --name or --svcs may be used"),
+		},
+		"returns error if both tasks and svcs flags are set": {
+			inputTaskIDs: []string{"1234"},
+			inputSvcs:    []string{"fe", "api"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --tasks or --svcs may be used"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -110,15 +129,19 @@ func TestSvcLogs_Validate(t *testing.T) {
 			tc.mockstore(mockstore)
 
 			svcLogs := &svcLogsOpts{
-				wkldLogsVars: wkldLogsVars{
-					follow:         tc.inputFollow,
-					limit:          tc.inputLimit,
-					envName:        tc.inputEnvName,
-					humanStartTime: tc.inputStartTime,
-					humanEndTime:   tc.inputEndTime,
-					since:          tc.inputSince,
-					name:           tc.inputSvc,
-					appName:        tc.inputApp,
+				svcLogsVars: svcLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						follow:         tc.inputFollow,
+						limit:          tc.inputLimit,
+						envName:        tc.inputEnvName,
+						humanStartTime: tc.inputStartTime,
+						humanEndTime:   tc.inputEndTime,
+						since:          tc.inputSince,
+						name:           tc.inputSvc,
+						appName:        tc.inputApp,
+						taskIDs:        tc.inputTaskIDs,
+					},
+					svcNames: tc.inputSvcs,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -147,6 +170,7 @@ func TestSvcLogs_Ask(t *testing.T) {
 	testCases := map[string]struct {
 		inputApp     string
 		inputSvc     string
+		inputSvcs    []string
 		inputEnvName string
 
 		setupMocks func(mocks svcLogsMock)
@@ -228,6 +252,38 @@ func TestSvcLogs_Ask(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("select deployed services for application my-app: some error"),
 		},
+		"prompt for the env of multiple services": {
+			inputApp:  inputApp,
+			inputSvcs: []string{"fe", "api"},
+			setupMocks: func(m svcLogsMock) {
+				m.configStore.EXPECT().GetApplication(gomock.Any()).AnyTimes()
+				m.configStore.EXPECT().GetService("my-app", "fe").Return(&config.Workload{}, nil)
+				m.configStore.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(svcLogNamePrompt, svcLogNameHelpPrompt, inputApp, gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "my-env",
+						Name: "fe",
+					}, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("my-app", "my-env", "api").Return(true, nil)
+			},
+			wantedApp: inputApp,
+			wantedEnv: inputEnv,
+		},
+		"return error if one of the services is not deployed in the env": {
+			inputApp:  inputApp,
+			inputSvcs: []string{"fe", "api"},
+			setupMocks: func(m svcLogsMock) {
+				m.configStore.EXPECT().GetApplication(gomock.Any()).AnyTimes()
+				m.configStore.EXPECT().GetService(gomock.Any(), gomock.Any()).Return(&config.Workload{}, nil).Times(2)
+				m.sel.EXPECT().DeployedService(svcLogNamePrompt, svcLogNameHelpPrompt, inputApp, gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "my-env",
+						Name: "fe",
+					}, nil)
+				m.deployStore.EXPECT().IsServiceDeployed("my-app", "my-env", "api").Return(false, nil)
+			},
+			wantedError: fmt.Errorf("service api is not deployed in environment my-env"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -237,22 +293,28 @@ func TestSvcLogs_Ask(t *testing.T) {
 
 			mockstore := mocks.NewMockstore(ctrl)
 			mockSel := mocks.NewMockdeploySelector(ctrl)
+			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
 
 			mocks := svcLogsMock{
 				configStore: mockstore,
+				deployStore: mockDeployStore,
 				sel:         mockSel,
 			}
 
 			tc.setupMocks(mocks)
 
 			svcLogs := &svcLogsOpts{
-				wkldLogsVars: wkldLogsVars{
-					envName: tc.inputEnvName,
-					name:    tc.inputSvc,
-					appName: tc.inputApp,
+				svcLogsVars: svcLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						envName: tc.inputEnvName,
+						name:    tc.inputSvc,
+						appName: tc.inputApp,
+					},
+					svcNames: tc.inputSvcs,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
+					deployStore: mockDeployStore,
 					sel:         mockSel,
 				},
 			}
@@ -285,6 +347,8 @@ func TestSvcLogs_Execute(t *testing.T) {
 		endTime   int64
 		startTime int64
 		taskIDs   []string
+		svcNames  []string
+		filter    string
 
 		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
 
@@ -348,6 +412,30 @@ func TestSvcLogs_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("write log events for service mockSvc: some error"),
 		},
+		"passes the filter pattern": {
+			inputSvc: "mockSvc",
+			filter:   "ERROR",
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, "ERROR", param.FilterPattern)
+				}).Return(nil)
+				return m
+			},
+		},
+		"returns error with all the service names if fail to get event logs of multiple services": {
+			svcNames: []string{"fe", "api"},
+
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).
+					Return(errors.New("some error"))
+				return m
+			},
+
+			wantedError: fmt.Errorf("write log events for services fe, api: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -356,11 +444,15 @@ func TestSvcLogs_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			svcLogs := &svcLogsOpts{
-				wkldLogsVars: wkldLogsVars{
-					name:    tc.inputSvc,
-					follow:  tc.follow,
-					limit:   tc.limit,
-					taskIDs: tc.taskIDs,
+				svcLogsVars: svcLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						name:    tc.inputSvc,
+						follow:  tc.follow,
+						limit:   tc.limit,
+						taskIDs: tc.taskIDs,
+					},
+					svcNames:      tc.svcNames,
+					filterPattern: tc.filter,
 				},
 				wkldLogOpts: wkldLogOpts{
 					startTime:   &tc.startTime,
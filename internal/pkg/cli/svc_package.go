@@ -51,6 +51,7 @@ type packageSvcVars struct {
 	tag          string
 	outputDir    string
 	uploadAssets bool
+	varFile      string
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -72,7 +73,7 @@ type packageSvcOpts struct {
 	sessProvider         *sessions.Provider
 	sel                  wsSelector
 	unmarshal            func([]byte) (manifest.WorkloadManifest, error)
-	newInterpolator      func(app, env string) interpolator
+	newInterpolator      func(app, env string, opts ...manifest.InterpolatorOption) interpolator
 	newTplGenerator      func(*packageSvcOpts) (workloadTemplateGenerator, error)
 	envFeaturesDescriber versionCompatibilityChecker
 
@@ -149,6 +150,8 @@ func newWkldTplGenerator(o *packageSvcOpts) (workloadTemplateGenerator, error) {
 		deployer, err = clideploy.NewRDWSDeployer(&in)
 	case *manifest.WorkerService:
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
+	case *manifest.StaticSite:
+		deployer, err = clideploy.NewStaticSiteDeployer(&in)
 	case *manifest.ScheduledJob:
 		deployer, err = clideploy.NewJobDeployer(&in)
 	default:
@@ -162,7 +165,7 @@ func newWkldTplGenerator(o *packageSvcOpts) (workloadTemplateGenerator, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageSvcOpts) Validate() error {
-	return nil
+	return validateVarFile(o.fs, o.varFile)
 }
 
 // Ask prompts for and validates any required flags.
@@ -303,11 +306,20 @@ type wkldCfnTemplates struct {
 
 // getSvcTemplates returns the CloudFormation stack's template and its parameters for the service.
 func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*wkldCfnTemplates, error) {
+	interpolatorOpts, err := manifestVars{
+		env:     env,
+		runner:  o.runner,
+		fs:      o.fs,
+		varFile: o.varFile,
+	}.interpolatorOpts()
+	if err != nil {
+		return nil, err
+	}
 	mft, err := workloadManifest(&workloadManifestInput{
 		name:         o.name,
 		appName:      o.appName,
 		envName:      o.envName,
-		interpolator: o.newInterpolator(o.appName, o.envName),
+		interpolator: o.newInterpolator(o.appName, o.envName, interpolatorOpts...),
 		ws:           o.ws,
 		unmarshal:    o.unmarshal,
 	})
@@ -453,5 +465,6 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
+	cmd.Flags().StringVar(&vars.varFile, varFileFlag, "", varFileFlagDescription)
 	return cmd
 }
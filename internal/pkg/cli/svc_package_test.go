@@ -269,7 +269,7 @@ count: 1`
 					opts.addonsClient = m.addons
 					return nil
 				},
-				newInterpolator: func(_, _ string) interpolator {
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
 					return m.interpolator
 				},
 				newTplGenerator: func(_ *packageSvcOpts) (workloadTemplateGenerator, error) {
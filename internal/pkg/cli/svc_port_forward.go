@@ -0,0 +1,304 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPortForwardNamePrompt     = "Which service would you like to forward a port to?"
+	svcPortForwardNameHelpPrompt = "Copilot forwards a local port to one of the running tasks of your chosen service."
+	svcPortForwardPortPrompt     = "Which ports would you like to forward?"
+	svcPortForwardPortHelpPrompt = `The local port to listen on, and the port to forward to, specified as <local>:<remote>.
+For example, "8080:80" forwards connections to localhost:8080 to port 80.`
+
+	portMappingSeparator = ":"
+)
+
+var (
+	svcPortForwardTaskPrompt     = fmt.Sprintf("Which %s would you like to forward the port to?", color.Emphasize("task"))
+	svcPortForwardTaskHelpPrompt = "Connections to the local port are forwarded through the first essential container of the task."
+)
+
+// svcPortForwardTypes are the types of services whose tasks can be reached through Session Manager.
+var svcPortForwardTypes = []string{
+	manifest.LoadBalancedWebServiceType,
+	manifest.BackendServiceType,
+	manifest.WorkerServiceType,
+}
+
+type svcPortForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	ports            string
+	taskID           string
+	remoteHost       string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcPortForwardOpts struct {
+	svcPortForwardVars
+
+	store            store
+	sel              deploySelector
+	prompter         prompter
+	newTaskSel       func(*session.Session) runningTaskSelector
+	newPortForwarder func(*session.Session) ssmPortForwarder
+	ssmPluginManager ssmPluginManager
+	sessProvider     sessionProvider
+
+	task *awsecs.Task
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc port-forward"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcPortForwardOpts{
+		svcPortForwardVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompter, ssmStore, deployStore),
+		prompter:           prompter,
+		newTaskSel: func(sess *session.Session) runningTaskSelector {
+			return selector.NewTaskSelector(prompter, ecs.New(sess))
+		},
+		newPortForwarder: func(sess *session.Session) ssmPortForwarder {
+			return ssm.New(sess)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		sessProvider:     sessProvider,
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.ports != "" {
+		if err := validatePortMapping(o.ports); err != nil {
+			return err
+		}
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask prompts for and validates any required flags, and selects the running task to forward the port to.
+func (o *svcPortForwardOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	if err := o.askPorts(); err != nil {
+		return err
+	}
+	return o.selectTask()
+}
+
+// Execute forwards the local port to the running task until the session is interrupted.
+func (o *svcPortForwardOpts) Execute() error {
+	sess, err := o.userSession()
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(o.task.TaskArn))
+	if err != nil {
+		return err
+	}
+	cluster, err := awsecs.ClusterName(aws.StringValue(o.task.ClusterArn))
+	if err != nil {
+		return err
+	}
+	runtimeID, err := o.runtimeID()
+	if err != nil {
+		return err
+	}
+	localPort, remotePort := parsePortMapping(o.ports)
+	target := fmt.Sprintf("port %s of task %s", remotePort, color.HighlightResource(taskID))
+	if o.remoteHost != "" {
+		target = fmt.Sprintf("%s:%s through task %s", color.HighlightUserInput(o.remoteHost), remotePort, color.HighlightResource(taskID))
+	}
+	log.Infof("Forward local port %s to %s. Press %s to stop.\n", color.HighlightUserInput(localPort), target, color.HighlightCode("Ctrl-C"))
+	if err := o.newPortForwarder(sess).PortForward(ssm.PortForwardInput{
+		Cluster:    cluster,
+		Task:       taskID,
+		RuntimeID:  runtimeID,
+		LocalPort:  localPort,
+		RemotePort: remotePort,
+		RemoteHost: o.remoteHost,
+	}); err != nil {
+		return fmt.Errorf("forward local port %s to task %s: %w", localPort, taskID, err)
+	}
+	log.Successf("Stopped forwarding local port %s.\n", color.HighlightUserInput(localPort))
+	return nil
+}
+
+func (o *svcPortForwardOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcPortForwardOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.name), selector.WithServiceTypesFilter(svcPortForwardTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcPortForwardOpts) askPorts() error {
+	if o.ports != "" {
+		return nil
+	}
+	ports, err := o.prompter.Get(svcPortForwardPortPrompt, svcPortForwardPortHelpPrompt, validatePortMapping,
+		prompt.WithFinalMessage("Ports:"))
+	if err != nil {
+		return fmt.Errorf("get ports to forward: %w", err)
+	}
+	o.ports = ports
+	return nil
+}
+
+func (o *svcPortForwardOpts) selectTask() error {
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	task, err := o.newTaskSel(sess).RunningTask(svcPortForwardTaskPrompt, svcPortForwardTaskHelpPrompt,
+		selector.WithAppEnv(o.appName, o.envName), selector.WithWorkload(o.name), selector.WithTaskID(o.taskID))
+	if err != nil {
+		return fmt.Errorf("select running task of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	o.task = task
+	return nil
+}
+
+// runtimeID returns the runtime ID of the first essential container of the task, in which the session is opened.
+func (o *svcPortForwardOpts) runtimeID() (string, error) {
+	for _, container := range o.task.Containers {
+		// The first essential container is named with the workload name.
+		if aws.StringValue(container.Name) == o.name && aws.StringValue(container.RuntimeId) != "" {
+			return aws.StringValue(container.RuntimeId), nil
+		}
+	}
+	return "", fmt.Errorf("container %s of task %s is not running", o.name, aws.StringValue(o.task.TaskArn))
+}
+
+func (o *svcPortForwardOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	return o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+}
+
+// userSession returns the default session in the region of the environment.
+// The environment manager role isn't allowed to start Session Manager sessions, so the port is forwarded with the credentials of the user.
+func (o *svcPortForwardOpts) userSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	return o.sessProvider.DefaultWithRegion(env.Region)
+}
+
+// parsePortMapping returns the local and remote ports of a "<local>:<remote>" mapping.
+// A single port is used as both the local and the remote port.
+func parsePortMapping(mapping string) (local, remote string) {
+	ports := strings.SplitN(mapping, portMappingSeparator, 2)
+	if len(ports) == 1 {
+		return ports[0], ports[0]
+	}
+	return ports[0], ports[1]
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a running task of a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a running task part of a service.",
+		Long: `Forward a local port to a running task part of a service, or to a host reachable from the task, through Session Manager.
+The session is closed on interrupt.`,
+		Example: `
+  Forward local port 8080 to port 80 of a task part of the "api" service.
+  /code $ copilot svc port-forward -a my-app -e test -n api --port 8080:80
+  Forward local port 5432 to a database reachable from a task of the "api" service.
+  /code $ copilot svc port-forward -e test -n api --port 5432 --remote-host db.internal`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.ports, svcPortFlag, "", portForwardPortFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", portForwardRemoteHostFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,266 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcPortForwardMocks struct {
+	store         *mocks.Mockstore
+	sel           *mocks.MockdeploySelector
+	prompter      *mocks.Mockprompter
+	taskSel       *mocks.MockrunningTaskSelector
+	portForwarder *mocks.MockssmPortForwarder
+	sessProvider  *mocks.MocksessionProvider
+}
+
+func TestSvcPortForward_Ask(t *testing.T) {
+	mockTask := &awsecs.Task{
+		TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/my-app-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"),
+	}
+	testCases := map[string]struct {
+		inApp   string
+		inEnv   string
+		inSvc   string
+		inPorts string
+		inTask  string
+
+		setupMocks func(m svcPortForwardMocks)
+
+		wantedPorts string
+		wantedTask  *awsecs.Task
+		wantedError error
+	}{
+		"returns an error if the service can't be selected": {
+			inApp: "my-app",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.sel.EXPECT().DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, "my-app", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed service for application my-app: some error"),
+		},
+		"prompts for the ports and selects a running task of the service": {
+			inApp:  "my-app",
+			inEnv:  "test",
+			inSvc:  "api",
+			inTask: "4082490e",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+					ManagerRoleARN: "mockRole",
+					Region:         "us-west-2",
+				}, nil).Times(2)
+				m.store.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, "my-app", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+				m.prompter.EXPECT().Get(svcPortForwardPortPrompt, svcPortForwardPortHelpPrompt, gomock.Any(), gomock.Any()).Return("8080:80", nil)
+				m.sessProvider.EXPECT().FromRole("mockRole", "us-west-2").Return(&session.Session{}, nil)
+				m.taskSel.EXPECT().RunningTask(svcPortForwardTaskPrompt, svcPortForwardTaskHelpPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockTask, nil)
+			},
+			wantedPorts: "8080:80",
+			wantedTask:  mockTask,
+		},
+		"returns an error if no task can be selected": {
+			inApp:   "my-app",
+			inEnv:   "test",
+			inSvc:   "api",
+			inPorts: "8080:80",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil).Times(2)
+				m.store.EXPECT().GetService("my-app", "api").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+				m.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+				m.taskSel.EXPECT().RunningTask(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("no running tasks found"))
+			},
+			wantedError: errors.New("select running task of service api in environment test: no running tasks found"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:        mocks.NewMockstore(ctrl),
+				sel:          mocks.NewMockdeploySelector(ctrl),
+				prompter:     mocks.NewMockprompter(ctrl),
+				taskSel:      mocks.NewMockrunningTaskSelector(ctrl),
+				sessProvider: mocks.NewMocksessionProvider(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					name:    tc.inSvc,
+					ports:   tc.inPorts,
+					taskID:  tc.inTask,
+				},
+				store:    m.store,
+				sel:      m.sel,
+				prompter: m.prompter,
+				newTaskSel: func(_ *session.Session) runningTaskSelector {
+					return m.taskSel
+				},
+				sessProvider: m.sessProvider,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPorts, opts.ports)
+			require.Equal(t, tc.wantedTask, opts.task)
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	mockTask := &awsecs.Task{
+		ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/my-app-test-Cluster"),
+		TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/my-app-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"),
+		Containers: []*ecs.Container{
+			{
+				Name:      aws.String("firelens_log_router"),
+				RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1111111111"),
+			},
+			{
+				Name:      aws.String("api"),
+				RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2222222222"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inPorts      string
+		inRemoteHost string
+		inTask       *awsecs.Task
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"forwards the local port to the first essential container of the task": {
+			inPorts: "8080:80",
+			inTask:  mockTask,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.portForwarder.EXPECT().PortForward(ssm.PortForwardInput{
+					Cluster:    "my-app-test-Cluster",
+					Task:       "4082490ee6c245e09d2145010aa1ba8d",
+					RuntimeID:  "4082490ee6c245e09d2145010aa1ba8d-2222222222",
+					LocalPort:  "8080",
+					RemotePort: "80",
+				}).Return(nil)
+			},
+		},
+		"forwards the same port to a remote host": {
+			inPorts:      "5432",
+			inRemoteHost: "db.internal",
+			inTask:       mockTask,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.portForwarder.EXPECT().PortForward(ssm.PortForwardInput{
+					Cluster:    "my-app-test-Cluster",
+					Task:       "4082490ee6c245e09d2145010aa1ba8d",
+					RuntimeID:  "4082490ee6c245e09d2145010aa1ba8d-2222222222",
+					LocalPort:  "5432",
+					RemotePort: "5432",
+					RemoteHost: "db.internal",
+				}).Return(nil)
+			},
+		},
+		"returns an error if the container isn't running": {
+			inPorts: "8080:80",
+			inTask: &awsecs.Task{
+				ClusterArn: mockTask.ClusterArn,
+				TaskArn:    mockTask.TaskArn,
+				Containers: []*ecs.Container{
+					{
+						Name: aws.String("api"),
+					},
+				},
+			},
+			setupMocks:  func(m svcPortForwardMocks) {},
+			wantedError: fmt.Errorf("container api of task %s is not running", aws.StringValue(mockTask.TaskArn)),
+		},
+		"wraps the error from forwarding the port": {
+			inPorts: "8080:80",
+			inTask:  mockTask,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.portForwarder.EXPECT().PortForward(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("forward local port 8080 to task 4082490ee6c245e09d2145010aa1ba8d: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:         mocks.NewMockstore(ctrl),
+				portForwarder: mocks.NewMockssmPortForwarder(ctrl),
+				sessProvider:  mocks.NewMocksessionProvider(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{
+				ManagerRoleARN: "mockRole",
+				Region:         "us-west-2",
+			}, nil)
+			m.sessProvider.EXPECT().DefaultWithRegion("us-west-2").Return(&session.Session{}, nil)
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:    "my-app",
+					envName:    "test",
+					name:       "api",
+					ports:      tc.inPorts,
+					remoteHost: tc.inRemoteHost,
+				},
+				store: m.store,
+				newPortForwarder: func(_ *session.Session) ssmPortForwarder {
+					return m.portForwarder
+				},
+				sessProvider: m.sessProvider,
+				task:         tc.inTask,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
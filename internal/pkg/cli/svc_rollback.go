@@ -0,0 +1,379 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRollbackAppNamePrompt     = "Which application is the service in?"
+	svcRollbackNamePrompt        = "Which service of %s would you like to roll back?"
+	svcRollbackSvcNameHelpPrompt = "The selected service will be redeployed with one of its previous revisions."
+
+	fmtSvcRollbackRevisionPrompt    = "Which revision of service %s would you like to roll back to?"
+	svcRollbackRevisionHelpPrompt   = "The template and the container image of the selected revision will be redeployed."
+	svcRollbackRevisionFinalMessage = "Revision:"
+
+	// maxSvcRollbackRevisions is the number of previous revisions offered to the user when --revision is not provided.
+	maxSvcRollbackRevisions = 10
+)
+
+var svcRollbackTypes = []string{
+	manifest.LoadBalancedWebServiceType,
+	manifest.BackendServiceType,
+	manifest.WorkerServiceType,
+}
+
+type svcRollbackVars struct {
+	appName  string
+	envName  string
+	name     string
+	revision int
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store  store
+	sel    deploySelector
+	prompt prompter
+	out    termprogress.FileWriter
+
+	// Clients initialized once the environment of the service is known.
+	initClients  func(env *config.Environment) error
+	appResources appResourcesGetter
+	taskDefs     taskDefRevisionDescriber
+	templates    templateDownloader
+	rollbacker   serviceRollbacker
+
+	// cached variables.
+	targetEnv *config.Environment
+}
+
+// svcRevision is a previous deployment of a service that can be rolled back to.
+type svcRevision struct {
+	number       int64
+	image        string
+	registeredAt time.Time
+	templateKey  string
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		sel:             selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		prompt:          prompt.New(),
+		out:             os.Stderr,
+		appResources:    cloudformation.New(defaultSess),
+	}
+	opts.initClients = func(env *config.Environment) error {
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		defaultSessEnvRegion, err := sessProvider.DefaultWithRegion(env.Region)
+		if err != nil {
+			return fmt.Errorf("create default session in region %s: %w", env.Region, err)
+		}
+		opts.taskDefs = ecs.New(envSess)
+		opts.templates = s3.New(defaultSessEnvRegion)
+		opts.rollbacker = cloudformation.New(envSess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcRollbackOpts) Validate() error {
+	if o.revision < 0 {
+		return fmt.Errorf("flag %s must be a positive integer", color.HighlightCode("--"+revisionFlag))
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+func (o *svcRollbackOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcRollbackAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.name != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcRollbackNamePrompt, color.HighlightUserInput(o.appName)),
+		svcRollbackSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.name),
+		selector.WithServiceTypesFilter(svcRollbackTypes),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute redeploys the service with the template and container image of a previous revision.
+func (o *svcRollbackOpts) Execute() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	env, err := o.getTargetEnv()
+	if err != nil {
+		return err
+	}
+	if err := o.initClients(env); err != nil {
+		return err
+	}
+	resources, err := o.appResources.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+	}
+	revisions, err := o.previousRevisions(resources.S3Bucket)
+	if err != nil {
+		return err
+	}
+	revision, err := o.selectRevision(revisions)
+	if err != nil {
+		return err
+	}
+	tmpl, err := o.templates.Download(resources.S3Bucket, revision.templateKey)
+	if err != nil {
+		return fmt.Errorf("download template of revision %d: %w", revision.number, err)
+	}
+	if err := o.rollbacker.RollbackService(o.out, &cloudformation.RollbackServiceInput{
+		StackName:   stack.NameForService(o.appName, o.envName, o.name),
+		Template:    string(tmpl),
+		TemplateURL: s3.URL(env.Region, resources.S3Bucket, revision.templateKey),
+		Parameters: map[string]string{
+			stack.WorkloadContainerImageParamKey: revision.image,
+		},
+		RoleARN: env.ExecutionRoleARN,
+		Bucket:  resources.S3Bucket,
+	}); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errEmptyCS) {
+			return fmt.Errorf("roll back service %s to revision %d: %w", o.name, revision.number, err)
+		}
+		log.Infof("Service %s in environment %s is already running revision %d.\n", o.name, o.envName, revision.number)
+		return nil
+	}
+	log.Successf("Rolled back service %s in environment %s to revision %d.\n",
+		color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName), revision.number)
+	return nil
+}
+
+// previousRevisions returns the revisions of the service preceding the one currently deployed, from the newest to the oldest.
+// A revision is only returned if the key of the template that deployed it was recorded in the bucket during the deployment.
+func (o *svcRollbackOpts) previousRevisions(bucket string) ([]svcRevision, error) {
+	stackName := stack.NameForService(o.appName, o.envName, o.name)
+	arns, err := o.taskDefs.TaskDefinitionRevisions(stackName)
+	if err != nil {
+		return nil, err
+	}
+	if len(arns) != 0 && o.revision != 0 && strings.HasSuffix(arns[0], ":"+strconv.Itoa(o.revision)) {
+		return nil, fmt.Errorf("revision %d is the revision currently deployed for service %s", o.revision, o.name)
+	}
+	if len(arns) < 2 {
+		return nil, fmt.Errorf("no previous revisions found for service %s in environment %s", o.name, o.envName)
+	}
+	candidates := arns[1:]
+	if o.revision != 0 {
+		candidates = nil
+		for _, arn := range arns[1:] {
+			if strings.HasSuffix(arn, ":"+strconv.Itoa(o.revision)) {
+				candidates = []string{arn}
+				break
+			}
+		}
+		if len(candidates) == 0 {
+			return nil, fmt.Errorf("revision %d not found for service %s in environment %s", o.revision, o.name, o.envName)
+		}
+	} else if len(candidates) > maxSvcRollbackRevisions {
+		candidates = candidates[:maxSvcRollbackRevisions]
+	}
+
+	records, err := o.templates.ListObjects(bucket, artifactpath.TaskDefRevisionTemplateDir(stackName))
+	if err != nil {
+		return nil, err
+	}
+	recorded := make(map[string]bool)
+	for _, record := range records {
+		recorded[record.Key] = true
+	}
+	var revisions []svcRevision
+	for _, arn := range candidates {
+		td, err := o.taskDefs.TaskDefinition(arn)
+		if err != nil {
+			return nil, err
+		}
+		revision := aws.Int64Value(td.Revision)
+		image, err := td.Image(o.name)
+		if err != nil {
+			return nil, fmt.Errorf("get image of revision %d: %w", revision, err)
+		}
+		record := artifactpath.TaskDefRevisionTemplate(stackName, revision)
+		if !recorded[record] {
+			log.Debugf("Skipping revision %d of service %s: the template that deployed it was not recorded.\n", revision, o.name)
+			continue
+		}
+		key, err := o.templates.Download(bucket, record)
+		if err != nil {
+			return nil, fmt.Errorf("get template key of revision %d: %w", revision, err)
+		}
+		revisions = append(revisions, svcRevision{
+			number:       revision,
+			image:        image,
+			registeredAt: aws.TimeValue(td.RegisteredAt),
+			templateKey:  string(key),
+		})
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("no templates found for the previous revisions of service %s in environment %s", o.name, o.envName)
+	}
+	return revisions, nil
+}
+
+func (o *svcRollbackOpts) selectRevision(revisions []svcRevision) (svcRevision, error) {
+	if o.revision != 0 || len(revisions) == 1 {
+		log.Infof("Rolling back service %s to revision %d.\n", color.HighlightUserInput(o.name), revisions[0].number)
+		return revisions[0], nil
+	}
+	options := make([]prompt.Option, len(revisions))
+	for i, rev := range revisions {
+		options[i] = prompt.Option{
+			Value: strconv.FormatInt(rev.number, 10),
+			Hint:  fmt.Sprintf("deployed %s, image %s", humanize.Time(rev.registeredAt), rev.image),
+		}
+	}
+	selected, err := o.prompt.SelectOption(fmt.Sprintf(fmtSvcRollbackRevisionPrompt, color.HighlightUserInput(o.name)),
+		svcRollbackRevisionHelpPrompt, options, prompt.WithFinalMessage(svcRollbackRevisionFinalMessage))
+	if err != nil {
+		return svcRevision{}, fmt.Errorf("select revision to roll back to: %w", err)
+	}
+	for _, rev := range revisions {
+		if strconv.FormatInt(rev.number, 10) == selected {
+			return rev, nil
+		}
+	}
+	return svcRevision{}, fmt.Errorf("revision %s not found", selected)
+}
+
+func (o *svcRollbackOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment: %w", err)
+	}
+	o.targetEnv = env
+	return o.targetEnv, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to check the health of the service.", color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.name, o.envName))),
+		fmt.Sprintf("Fix your manifest and run %s to deploy a new revision.", color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.envName))),
+	})
+	return nil
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to a previous revision.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back a service to a previous revision.",
+		Long: `Roll back a service to a previous revision.
+The service is redeployed with the CloudFormation template and the container image of the revision.`,
+
+		Example: `
+  Select a previous revision of service "my-svc" in environment "prod" to roll back to.
+  /code $ copilot svc rollback -n my-svc -e prod
+  Roll back service "my-svc" to task definition revision 12.
+  /code $ copilot svc rollback -n my-svc -e prod --revision 12`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.revision, revisionFlag, 0, revisionFlagDescription)
+	return cmd
+}
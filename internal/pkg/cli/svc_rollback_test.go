@@ -0,0 +1,352 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcRollback_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inRevision  int
+		wantedError error
+	}{
+		"valid revision": {
+			inRevision: 12,
+		},
+		"negative revision": {
+			inRevision:  -1,
+			wantedError: errors.New("flag `--revision` must be a positive integer"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					revision: tc.inRevision,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type svcRollbackMocks struct {
+	store        *mocks.Mockstore
+	sel          *mocks.MockdeploySelector
+	prompt       *mocks.Mockprompter
+	appResources *mocks.MockappResourcesGetter
+	taskDefs     *mocks.MocktaskDefRevisionDescriber
+	templates    *mocks.MocktemplateDownloader
+	rollbacker   *mocks.MockserviceRollbacker
+}
+
+func TestSvcRollback_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(m svcRollbackMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"validate app env and svc with all flags passed in": {
+			inApp: "phonetool",
+			inEnv: "test",
+			inSvc: "api",
+			setupMocks: func(m svcRollbackMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcRollbackNamePrompt, "phonetool"), svcRollbackSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"prompt for app, svc and env": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().Application(svcRollbackAppNamePrompt, svcAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcRollbackNamePrompt, "phonetool"), svcRollbackSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "api",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "api",
+		},
+		"errors if failed to select application": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.sel.EXPECT().Application(svcRollbackAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+		"errors if failed to select deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(m svcRollbackMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					name:    tc.inSvc,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.name)
+		})
+	}
+}
+
+func TestSvcRollback_Execute(t *testing.T) {
+	const (
+		mockBucket    = "stackset-bucket"
+		mockStack     = "phonetool-test-api"
+		mockRecordDir = "manual/templates/phonetool-test-api/revisions/"
+		mockRecord1   = "manual/templates/phonetool-test-api/revisions/1"
+		mockRecord2   = "manual/templates/phonetool-test-api/revisions/2"
+		mockRecord3   = "manual/templates/phonetool-test-api/revisions/3"
+		mockTmplKey1  = "manual/templates/phonetool-test-api/1.yml"
+		mockTmplKey2  = "manual/templates/phonetool-test-api/2.yml"
+		mockFamilyARN = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api"
+	)
+	mockTime := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockTaskDef := func(revision int64, registeredAt time.Time, image string) *ecs.TaskDefinition {
+		return &ecs.TaskDefinition{
+			Revision:     aws.Int64(revision),
+			RegisteredAt: aws.Time(registeredAt),
+			ContainerDefinitions: []*awsecs.ContainerDefinition{
+				{
+					Name:  aws.String("api"),
+					Image: aws.String(image),
+				},
+			},
+		}
+	}
+	mockRecords := []s3.Object{
+		{Key: mockRecord1, LastModified: mockTime},
+		{Key: mockRecord2, LastModified: mockTime.Add(time.Hour)},
+		{Key: mockRecord3, LastModified: mockTime.Add(2 * time.Hour)},
+	}
+	mockRevisions := []string{mockFamilyARN + ":3", mockFamilyARN + ":2", mockFamilyARN + ":1"}
+	mockEnv := &config.Environment{
+		Name:             "test",
+		Region:           "us-west-2",
+		ExecutionRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+	}
+	testCases := map[string]struct {
+		inRevision int
+
+		setupMocks func(m svcRollbackMocks)
+
+		wantedError error
+	}{
+		"errors if there are no previous revisions": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions[:1], nil)
+			},
+			wantedError: errors.New("no previous revisions found for service api in environment test"),
+		},
+		"errors if the revision is the one currently deployed": {
+			inRevision: 3,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+			},
+			wantedError: errors.New("revision 3 is the revision currently deployed for service api"),
+		},
+		"errors if the revision does not exist": {
+			inRevision: 7,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+			},
+			wantedError: errors.New("revision 7 not found for service api in environment test"),
+		},
+		"errors if the templates of the previous revisions were not recorded": {
+			inRevision: 1,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+				m.templates.EXPECT().ListObjects(mockBucket, mockRecordDir).Return(mockRecords[1:], nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":1").Return(mockTaskDef(1, mockTime.Add(time.Minute), "api@sha256:1"), nil)
+			},
+			wantedError: errors.New("no templates found for the previous revisions of service api in environment test"),
+		},
+		"rolls back with the template recorded for the revision even if it was uploaded again since": {
+			inRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+				m.templates.EXPECT().ListObjects(mockBucket, mockRecordDir).Return(mockRecords, nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":2").Return(mockTaskDef(2, mockTime.Add(time.Hour+time.Minute), "api@sha256:2"), nil)
+				// Revision 2 was an image-only redeploy of the template of revision 1.
+				m.templates.EXPECT().Download(mockBucket, mockRecord2).Return([]byte(mockTmplKey1), nil)
+				m.templates.EXPECT().Download(mockBucket, mockTmplKey1).Return([]byte("template 1"), nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, in *cloudformation.RollbackServiceInput) error {
+						require.Equal(t, "template 1", in.Template)
+						require.Equal(t, "api@sha256:2", in.Parameters[stack.WorkloadContainerImageParamKey])
+						return nil
+					})
+			},
+		},
+		"rolls back to the revision passed by flag": {
+			inRevision: 1,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+				m.templates.EXPECT().ListObjects(mockBucket, mockRecordDir).Return(mockRecords, nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":1").Return(mockTaskDef(1, mockTime.Add(time.Minute), "api@sha256:1"), nil)
+				m.templates.EXPECT().Download(mockBucket, mockRecord1).Return([]byte(mockTmplKey1), nil)
+				m.templates.EXPECT().Download(mockBucket, mockTmplKey1).Return([]byte("template 1"), nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), &cloudformation.RollbackServiceInput{
+					StackName:   mockStack,
+					Template:    "template 1",
+					TemplateURL: "https://stackset-bucket.s3.us-west-2.amazonaws.com/manual/templates/phonetool-test-api/1.yml",
+					Parameters: map[string]string{
+						stack.WorkloadContainerImageParamKey: "api@sha256:1",
+					},
+					RoleARN: "arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole",
+					Bucket:  mockBucket,
+				}).Return(nil)
+			},
+		},
+		"prompts for the revision to roll back to": {
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+				m.templates.EXPECT().ListObjects(mockBucket, mockRecordDir).Return(mockRecords, nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":2").Return(mockTaskDef(2, mockTime.Add(time.Hour+time.Minute), "api@sha256:2"), nil)
+				m.templates.EXPECT().Download(mockBucket, mockRecord2).Return([]byte(mockTmplKey2), nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":1").Return(mockTaskDef(1, mockTime.Add(time.Minute), "api@sha256:1"), nil)
+				m.templates.EXPECT().Download(mockBucket, mockRecord1).Return([]byte(mockTmplKey1), nil)
+				m.prompt.EXPECT().SelectOption(fmt.Sprintf(fmtSvcRollbackRevisionPrompt, "api"), svcRollbackRevisionHelpPrompt, gomock.Any(), gomock.Any()).
+					Return("2", nil)
+				m.templates.EXPECT().Download(mockBucket, mockTmplKey2).Return([]byte("template 2"), nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, in *cloudformation.RollbackServiceInput) error {
+						require.Equal(t, "template 2", in.Template)
+						require.Equal(t, "api@sha256:2", in.Parameters[stack.WorkloadContainerImageParamKey])
+						return nil
+					})
+			},
+		},
+		"errors if the rollback fails": {
+			inRevision: 2,
+			setupMocks: func(m svcRollbackMocks) {
+				m.taskDefs.EXPECT().TaskDefinitionRevisions(mockStack).Return(mockRevisions, nil)
+				m.templates.EXPECT().ListObjects(mockBucket, mockRecordDir).Return(mockRecords, nil)
+				m.taskDefs.EXPECT().TaskDefinition(mockFamilyARN+":2").Return(mockTaskDef(2, mockTime.Add(time.Hour+time.Minute), "api@sha256:2"), nil)
+				m.templates.EXPECT().Download(mockBucket, mockRecord2).Return([]byte(mockTmplKey2), nil)
+				m.templates.EXPECT().Download(mockBucket, mockTmplKey2).Return([]byte("template 2"), nil)
+				m.rollbacker.EXPECT().RollbackService(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back service api to revision 2: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackMocks{
+				store:        mocks.NewMockstore(ctrl),
+				prompt:       mocks.NewMockprompter(ctrl),
+				appResources: mocks.NewMockappResourcesGetter(ctrl),
+				taskDefs:     mocks.NewMocktaskDefRevisionDescriber(ctrl),
+				templates:    mocks.NewMocktemplateDownloader(ctrl),
+				rollbacker:   mocks.NewMockserviceRollbacker(ctrl),
+			}
+			m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+			m.appResources.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&stack.AppRegionalResources{S3Bucket: mockBucket}, nil)
+			tc.setupMocks(m)
+			opts := svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:  "phonetool",
+					envName:  "test",
+					name:     "api",
+					revision: tc.inRevision,
+				},
+				store:        m.store,
+				prompt:       m.prompt,
+				appResources: m.appResources,
+				initClients: func(env *config.Environment) error {
+					return nil
+				},
+				taskDefs:   m.taskDefs,
+				templates:  m.templates,
+				rollbacker: m.rollbacker,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
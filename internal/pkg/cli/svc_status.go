@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -66,6 +67,10 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 			if err != nil {
 				return fmt.Errorf("retrieve %s from application %s: %w", o.appName, o.svcName, err)
 			}
+			if wkld.Type == manifest.StaticSiteType {
+				return fmt.Errorf("%s %s does not run any tasks; run %s to see its details instead",
+					manifest.StaticSiteType, o.svcName, color.HighlightCode(fmt.Sprintf("copilot svc show -n %s", o.svcName)))
+			}
 			if wkld.Type == manifest.RequestDrivenWebServiceType {
 				d, err := describe.NewAppRunnerStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 
 	"github.com/spf13/pflag"
 
@@ -44,6 +45,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/dustin/go-humanize/english"
 	"github.com/google/shlex"
@@ -120,6 +122,7 @@ type runTaskVars struct {
 
 	follow                bool
 	generateCommandTarget string
+	fromManifest          string
 
 	os   string
 	arch string
@@ -129,6 +132,7 @@ type runTaskOpts struct {
 	runTaskVars
 	isDockerfileSet bool
 	nFlag           int
+	setFlags        map[string]bool // Flags explicitly set by the user, which take precedence over the manifest.
 
 	// Interfaces to interact with dependencies.
 	fs      afero.Fs
@@ -137,6 +141,11 @@ type runTaskOpts struct {
 	spinner progress
 	prompt  prompter
 
+	// Dependencies to read the manifest when the task is run from a workload manifest.
+	ws              wsWlDirReader
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	newInterpolator func(app, env string, opts ...manifest.InterpolatorOption) interpolator
+
 	// Fields below are configured at runtime.
 	deployer             taskDeployer
 	repository           repositoryService
@@ -167,6 +176,19 @@ type runTaskOpts struct {
 	// Cached variables to hold SSM Param and Secrets Manager Secrets
 	ssmParamSecrets       map[string]string
 	secretsManagerSecrets map[string]string
+
+	// Configuration read from the workload manifest when --from-manifest is specified.
+	mftConfig *taskManifestConfig
+}
+
+// taskManifestConfig holds the configuration from a workload manifest that can't be set with flags.
+type taskManifestConfig struct {
+	entryPoint []string
+	command    []string
+	dependsOn  manifest.DependsOn
+	sidecars   map[string]*manifest.SidecarConfig
+	storage    manifest.Storage
+	buildArgs  *manifest.DockerBuildArgs // Nil if the image isn't built from the manifest.
 }
 
 func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
@@ -176,6 +198,11 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+
 	prompter := prompt.New()
 	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 	opts := runTaskOpts{
@@ -187,6 +214,9 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 		sel:                   selector.NewAppEnvSelector(prompter, store),
 		spinner:               termprogress.NewSpinner(log.DiagnosticWriter),
 		provider:              sessProvider,
+		ws:                    ws,
+		unmarshal:             manifest.UnmarshalWorkload,
+		newInterpolator:       newManifestInterpolator,
 		secretsManagerSecrets: make(map[string]string),
 		ssmParamSecrets:       make(map[string]string),
 	}
@@ -382,6 +412,10 @@ func (o *runTaskOpts) Validate() error {
 		return err
 	}
 
+	if err := o.validateFlagsWithManifest(); err != nil {
+		return err
+	}
+
 	if o.appName != "" {
 		if err := o.validateAppName(); err != nil {
 			return err
@@ -563,6 +597,18 @@ func (o *runTaskOpts) validateFlagsWithWindows() error {
 	return nil
 }
 
+func (o *runTaskOpts) validateFlagsWithManifest() error {
+	if o.fromManifest == "" {
+		return nil
+	}
+
+	if o.env == "" {
+		return fmt.Errorf("must specify `--%s` with `--%s`", envFlag, fromManifestFlag)
+	}
+
+	return nil
+}
+
 func isWindowsOS(os string) bool {
 	return task.IsValidWindowsOS(os)
 }
@@ -605,6 +651,9 @@ func (o *runTaskOpts) Execute() error {
 		return o.generateCommand()
 	}
 
+	if o.groupName == "" && o.fromManifest != "" {
+		o.groupName = o.fromManifest
+	}
 	if o.groupName == "" {
 		dir, err := os.Getwd()
 		if err != nil {
@@ -619,6 +668,12 @@ func (o *runTaskOpts) Execute() error {
 		return err
 	}
 
+	if o.fromManifest != "" {
+		if err := o.applyManifest(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.configureRuntimeOpts(); err != nil {
 		return err
 	}
@@ -882,11 +937,17 @@ func (o *runTaskOpts) buildAndPushImage() error {
 	if o.dockerfileContextPath != "" {
 		ctx = o.dockerfileContextPath
 	}
-	if _, err := o.repository.BuildAndPush(dockerengine.New(exec.NewCmd()), &dockerengine.BuildArguments{
+	buildArgs := &dockerengine.BuildArguments{
 		Dockerfile: o.dockerfilePath,
 		Context:    ctx,
 		Tags:       append([]string{imageTagLatest}, additionalTags...),
-	}); err != nil {
+	}
+	if o.mftConfig != nil && o.mftConfig.buildArgs != nil {
+		buildArgs.Args = o.mftConfig.buildArgs.Args
+		buildArgs.Target = aws.StringValue(o.mftConfig.buildArgs.Target)
+		buildArgs.CacheFrom = o.mftConfig.buildArgs.CacheFrom
+	}
+	if _, err := o.repository.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArgs); err != nil {
 		return fmt.Errorf("build and push image: %w", err)
 	}
 	return nil
@@ -942,9 +1003,180 @@ func (o *runTaskOpts) deploy() error {
 		Env:                   o.env,
 		AdditionalTags:        o.resourceTags,
 	}
+	if o.mftConfig != nil {
+		if o.entrypoint == "" {
+			input.EntryPoint = o.mftConfig.entryPoint
+		}
+		if o.command == "" {
+			input.Command = o.mftConfig.command
+		}
+		input.DependsOn = o.mftConfig.dependsOn
+		input.Sidecars = o.mftConfig.sidecars
+		input.Storage = o.mftConfig.storage
+	}
 	return o.deployer.DeployTask(os.Stderr, input, deployOpts...)
 }
 
+// applyManifest configures the task from the workload manifest with the environment overrides applied.
+// Flags explicitly set by the user take precedence over the values in the manifest.
+func (o *runTaskOpts) applyManifest() error {
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.fromManifest,
+		appName:      o.appName,
+		envName:      o.env,
+		interpolator: o.newInterpolator(o.appName, o.env),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+	})
+	if err != nil {
+		return err
+	}
+	taskConfig, ok := ecsTaskConfig(mft)
+	if !ok {
+		return fmt.Errorf("cannot run a task from the manifest of %s: only services and jobs deployed to Amazon ECS are supported", o.fromManifest)
+	}
+	for name, vol := range taskConfig.Storage.Volumes {
+		if !vol.EmptyVolume() && vol.EFS.UseManagedFS() {
+			return fmt.Errorf("cannot run a task with the Copilot-managed EFS volume %q of %s", name, o.fromManifest)
+		}
+	}
+	conf, err := newLocalWorkloadConfig(mft)
+	if err != nil {
+		return err
+	}
+	o.mftConfig = &taskManifestConfig{
+		entryPoint: conf.entryPoint,
+		command:    conf.command,
+		dependsOn:  conf.image.DependsOn,
+		sidecars:   taskSidecars(conf.sidecars, o.fromManifest, o.groupName),
+		storage:    taskConfig.Storage,
+	}
+
+	if o.image == "" && !o.isDockerfileSet {
+		if err := o.applyManifestImage(mft, conf.image); err != nil {
+			return err
+		}
+	}
+	if !o.setFlags[cpuFlag] && taskConfig.CPU != nil {
+		o.cpu = aws.IntValue(taskConfig.CPU)
+	}
+	if !o.setFlags[memoryFlag] && taskConfig.Memory != nil {
+		o.memory = aws.IntValue(taskConfig.Memory)
+	}
+	if o.os == "" && !taskConfig.Platform.IsEmpty() {
+		o.os, o.arch = taskPlatform(taskConfig.Platform)
+	}
+	secrets, err := o.manifestSecrets(conf.secrets)
+	if err != nil {
+		return err
+	}
+	o.envVars = mergeVariables(conf.variables, o.envVars)
+	o.secrets = mergeVariables(secrets, o.secrets)
+	return nil
+}
+
+// taskSidecars returns the sidecars of the workload for a task whose main container is named after the task group.
+// The dependencies of the sidecars on the main container of the workload are renamed to refer to the main container of the task.
+func taskSidecars(sidecars map[string]*manifest.SidecarConfig, workload, taskGroup string) map[string]*manifest.SidecarConfig {
+	if workload == taskGroup {
+		return sidecars
+	}
+	renamed := make(map[string]*manifest.SidecarConfig, len(sidecars))
+	for name, sidecar := range sidecars {
+		if _, ok := sidecar.DependsOn[workload]; !ok {
+			renamed[name] = sidecar
+			continue
+		}
+		copied := *sidecar
+		copied.DependsOn = make(manifest.DependsOn, len(sidecar.DependsOn))
+		for container, condition := range sidecar.DependsOn {
+			if container == workload {
+				container = taskGroup
+			}
+			copied.DependsOn[container] = condition
+		}
+		renamed[name] = &copied
+	}
+	return renamed
+}
+
+func (o *runTaskOpts) applyManifestImage(mft manifest.WorkloadManifest, image manifest.Image) error {
+	required, err := manifest.DockerfileBuildRequired(mft)
+	if err != nil {
+		return err
+	}
+	if !required {
+		o.image = aws.StringValue(image.Location)
+		return nil
+	}
+	mf, ok := mft.(interface {
+		BuildArgs(rootDirectory string) *manifest.DockerBuildArgs
+	})
+	if !ok {
+		return fmt.Errorf("%s does not have required method BuildArgs()", o.fromManifest)
+	}
+	wsPath, err := o.ws.Path()
+	if err != nil {
+		return fmt.Errorf("get workspace path: %w", err)
+	}
+	args := mf.BuildArgs(wsPath)
+	o.dockerfilePath = aws.StringValue(args.Dockerfile)
+	if o.dockerfileContextPath == "" {
+		o.dockerfileContextPath = aws.StringValue(args.Context)
+	}
+	o.mftConfig.buildArgs = args
+	return nil
+}
+
+// manifestSecrets returns the secrets of the manifest keyed by environment variable.
+// Secrets Manager secrets referred by name are converted to ARNs in the region of the environment.
+func (o *runTaskOpts) manifestSecrets(secrets map[string]manifest.Secret) (map[string]string, error) {
+	out := make(map[string]string, len(secrets))
+	for name, secret := range secrets {
+		if !secret.IsSecretsManagerName() {
+			out[name] = secret.Value()
+			continue
+		}
+		partition, err := partitions.Region(o.targetEnvironment.Region).Partition()
+		if err != nil {
+			return nil, err
+		}
+		out[name] = fmt.Sprintf("arn:%s:secretsmanager:%s:%s:secret:%s", partition.ID(), o.targetEnvironment.Region, o.targetEnvironment.AccountID, secret.Value())
+	}
+	return out, nil
+}
+
+// ecsTaskConfig returns the task configuration of a workload deployed to Amazon ECS.
+func ecsTaskConfig(mft manifest.WorkloadManifest) (manifest.TaskConfig, bool) {
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		return t.TaskConfig, true
+	case *manifest.BackendService:
+		return t.TaskConfig, true
+	case *manifest.WorkerService:
+		return t.TaskConfig, true
+	case *manifest.ScheduledJob:
+		return t.TaskConfig, true
+	}
+	return manifest.TaskConfig{}, false
+}
+
+// taskPlatform converts the platform of a manifest to the values accepted by the task stack.
+func taskPlatform(platform manifest.PlatformArgsOrString) (taskOS, taskArch string) {
+	taskOS = template.OSLinux
+	switch platform.OS() {
+	case manifest.OSWindows, manifest.OSWindowsServer2019Core:
+		taskOS = template.OSWindowsServerCore
+	case manifest.OSWindowsServer2019Full:
+		taskOS = template.OSWindowsServerFull
+	}
+	taskArch = template.ArchX86
+	if manifest.IsArmArch(platform.Arch()) {
+		taskArch = template.ArchARM64
+	}
+	return taskOS, taskArch
+}
+
 func (o *runTaskOpts) validateAppName() error {
 	if _, err := o.store.GetApplication(o.appName); err != nil {
 		return fmt.Errorf("get application: %w", err)
@@ -1033,7 +1265,9 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Run a task from the manifest of the "api" service with the overrides of the "test" environment.
+  /code $ copilot task run --from-manifest api --env test --command "python migrate-script.py"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -1043,6 +1277,13 @@ func BuildTaskRunCmd() *cobra.Command {
 			if cmd.Flags().Changed(dockerFileFlag) {
 				opts.isDockerfileSet = true
 			}
+			opts.setFlags = make(map[string]bool)
+			cmd.Flags().Visit(func(f *pflag.Flag) {
+				opts.setFlags[f.Name] = true
+			})
+			if opts.fromManifest != "" && opts.appName == "" {
+				opts.appName = tryReadingAppName()
+			}
 			return run(opts)
 		}),
 	}
@@ -1078,6 +1319,7 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
+	cmd.Flags().StringVar(&vars.fromManifest, fromManifestFlag, "", fromManifestFlagDescription)
 
 	// group flags.
 	nameFlags := pflag.NewFlagSet("Name", pflag.ContinueOnError)
@@ -1098,6 +1340,7 @@ func BuildTaskRunCmd() *cobra.Command {
 	placementFlags.AddFlag(cmd.Flags().Lookup(taskDefaultFlag))
 
 	taskFlags := pflag.NewFlagSet("Task", pflag.ContinueOnError)
+	taskFlags.AddFlag(cmd.Flags().Lookup(fromManifestFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(countFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(cpuFlag))
 	taskFlags.AddFlag(cmd.Flags().Lookup(memoryFlag))
@@ -18,6 +18,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/task"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -70,6 +71,7 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFromManifest          string
 
 		appName         string
 		isDockerfileSet bool
@@ -363,6 +365,17 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: errors.New("cannot specify `--generate-cmd` with any other flag"),
 		},
+		"from-manifest specified without environment": {
+			basicOpts: defaultOpts,
+
+			inFromManifest: "api",
+			appName:        "my-app",
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil).AnyTimes()
+			},
+
+			wantedError: errors.New("must specify `--env` with `--from-manifest`"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -393,6 +406,7 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					fromManifest:                tc.inFromManifest,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
 				},
@@ -1276,3 +1290,195 @@ func TestTaskRunOpts_runTaskCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskRunOpts_applyManifest(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+image:
+  location: public.ecr.aws/my/api:latest
+  depends_on:
+    nginx: start
+cpu: 256
+memory: 512
+command: ["./start.sh"]
+variables:
+  LOG_LEVEL: info
+  PORT: "8080"
+secrets:
+  DB_PASSWORD: /copilot/demo/test/secrets/db_password
+  API_KEY:
+    secretsmanager: demo/test/api_key
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs:
+        id: fs-1234
+sidecars:
+  nginx:
+    image: public.ecr.aws/nginx/nginx:latest
+  logger:
+    image: public.ecr.aws/my/logger:latest
+    depends_on:
+      api: start
+environments:
+  test:
+    cpu: 1024
+    variables:
+      LOG_LEVEL: debug
+`
+	testCases := map[string]struct {
+		inMft       string
+		inGroupName string
+		inImage     string
+		inCPU       int
+		inMemory    int
+		inEnvVars   map[string]string
+		inSetFlags  map[string]bool
+
+		wantedImage   string
+		wantedCPU     int
+		wantedMemory  int
+		wantedEnvVars map[string]string
+		wantedSecrets map[string]string
+		wantedError   error
+
+		wantedLoggerDependsOn manifest.DependsOn
+	}{
+		"applies the manifest with the environment overrides": {
+			inMft:       mft,
+			inGroupName: "api",
+			inCPU:       256,
+			inMemory:    512,
+
+			wantedImage:  "public.ecr.aws/my/api:latest",
+			wantedCPU:    1024,
+			wantedMemory: 512,
+			wantedEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+				"PORT":      "8080",
+			},
+			wantedSecrets: map[string]string{
+				"DB_PASSWORD": "/copilot/demo/test/secrets/db_password",
+				"API_KEY":     "arn:aws:secretsmanager:us-west-2:123456789012:secret:demo/test/api_key",
+			},
+			wantedLoggerDependsOn: manifest.DependsOn{"api": "start"},
+		},
+		"flags override the values of the manifest": {
+			inMft:       mft,
+			inGroupName: "api",
+			inImage:     "my/image",
+			inCPU:       2048,
+			inMemory:    512,
+			inEnvVars:   map[string]string{"PORT": "80"},
+			inSetFlags:  map[string]bool{cpuFlag: true, imageFlag: true, envVarsFlag: true},
+
+			wantedImage:  "my/image",
+			wantedCPU:    2048,
+			wantedMemory: 512,
+			wantedEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+				"PORT":      "80",
+			},
+			wantedSecrets: map[string]string{
+				"DB_PASSWORD": "/copilot/demo/test/secrets/db_password",
+				"API_KEY":     "arn:aws:secretsmanager:us-west-2:123456789012:secret:demo/test/api_key",
+			},
+			wantedLoggerDependsOn: manifest.DependsOn{"api": "start"},
+		},
+		"sidecars depend on the main container named after the task group": {
+			inMft:       mft,
+			inGroupName: "api-migration",
+			inCPU:       256,
+			inMemory:    512,
+
+			wantedImage:  "public.ecr.aws/my/api:latest",
+			wantedCPU:    1024,
+			wantedMemory: 512,
+			wantedEnvVars: map[string]string{
+				"LOG_LEVEL": "debug",
+				"PORT":      "8080",
+			},
+			wantedSecrets: map[string]string{
+				"DB_PASSWORD": "/copilot/demo/test/secrets/db_password",
+				"API_KEY":     "arn:aws:secretsmanager:us-west-2:123456789012:secret:demo/test/api_key",
+			},
+			wantedLoggerDependsOn: manifest.DependsOn{"api-migration": "start"},
+		},
+		"errors if the workload is not deployed to Amazon ECS": {
+			inMft: `name: api
+type: Request-Driven Web Service
+image:
+  location: public.ecr.aws/my/api:latest
+  port: 8080
+`,
+
+			wantedError: errors.New("cannot run a task from the manifest of api: only services and jobs deployed to Amazon ECS are supported"),
+		},
+		"errors if the manifest uses a Copilot-managed EFS volume": {
+			inMft: `name: api
+type: Backend Service
+image:
+  location: public.ecr.aws/my/api:latest
+storage:
+  volumes:
+    data:
+      path: /var/data
+      efs: true
+`,
+
+			wantedError: errors.New(`cannot run a task with the Copilot-managed EFS volume "data" of api`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(tc.inMft), nil)
+			opts := &runTaskOpts{
+				runTaskVars: runTaskVars{
+					appName:      "demo",
+					env:          "test",
+					fromManifest: "api",
+					groupName:    tc.inGroupName,
+					image:        tc.inImage,
+					cpu:          tc.inCPU,
+					memory:       tc.inMemory,
+					envVars:      tc.inEnvVars,
+				},
+				setFlags:  tc.inSetFlags,
+				ws:        ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newInterpolator: func(_, _ string, _ ...manifest.InterpolatorOption) interpolator {
+					return manifest.NewInterpolator("demo", "test")
+				},
+				targetEnvironment: &config.Environment{
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				},
+			}
+
+			// WHEN
+			err := opts.applyManifest()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedImage, opts.image)
+			require.Equal(t, tc.wantedCPU, opts.cpu)
+			require.Equal(t, tc.wantedMemory, opts.memory)
+			require.Equal(t, tc.wantedEnvVars, opts.envVars)
+			require.Equal(t, tc.wantedSecrets, opts.secrets)
+			require.Equal(t, []string{"./start.sh"}, opts.mftConfig.command)
+			require.Equal(t, manifest.DependsOn{"nginx": "start"}, opts.mftConfig.dependsOn)
+			require.Contains(t, opts.mftConfig.sidecars, "nginx")
+			require.Equal(t, tc.wantedLoggerDependsOn, opts.mftConfig.sidecars["logger"].DependsOn)
+			require.Contains(t, opts.mftConfig.storage.Volumes, "data")
+		})
+	}
+}
@@ -65,6 +65,17 @@ var (
 	fmtErrInvalidEngineType        = "invalid engine type %s: must be one of %s"
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
+	fmtErrInvalidCapacityMode      = "invalid capacity mode %s: must be one of %s"
+	fmtErrInvalidDeletionPolicy    = "invalid deletion policy %s: must be one of %s"
+	fmtErrInvalidInstanceClass     = "invalid instance class %s: must start with %q"
+	errInvalidCapacityUnits        = fmt.Errorf("capacity must be between %v and %v ACUs, in increments of %v", rdsMinCapacityUnits, rdsMaxCapacityUnits, rdsMinCapacityUnits)
+	errInvalidReaders              = fmt.Errorf("number of readers must be between 0 and %d", rdsMaxReaders)
+	errInvalidBackupRetention      = fmt.Errorf("backup retention must be between %d and %d days", rdsMinBackupRetentionDays, rdsMaxBackupRetentionDays)
+
+	// ElastiCache Redis and OpenSearch errors.
+	fmtErrInvalidEngineVersion = "invalid engine version %s: must be one of %s"
+	fmtErrInvalidInstanceType  = "invalid instance type %s: must end with %q"
+	fmtErrInvalidRedisNodeType = "invalid node type %s: must start with %q"
 
 	// Topic subscription errors.
 	errMissingPublishTopicField = errors.New("field `publish.topics[].name` cannot be empty")
@@ -213,6 +224,21 @@ func validateSvcPort(val interface{}) error {
 	return nil
 }
 
+// validatePortMapping returns an error if the value is not a port or a "<local>:<remote>" pair of ports.
+func validatePortMapping(val interface{}) error {
+	mapping, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	local, remote := parsePortMapping(mapping)
+	for _, port := range []string{local, remote} {
+		if err := stringPortValidation(port); err != nil {
+			return fmt.Errorf("port mapping %s is invalid: %w", mapping, err)
+		}
+	}
+	return nil
+}
+
 func validateSvcType(val interface{}) error {
 	svcType, ok := val.(string)
 	if !ok {
@@ -346,8 +372,30 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
+	switch storageType {
+	case rdsStorageType:
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	case redisStorageType, openSearchStorageType:
+		return validateVPCOnlyStorageType(opts.ws, opts.workloadName, storageType)
+	}
+	return nil
+}
+
+// validateVPCOnlyStorageType returns an error if the storage type can't be accessed by the workload.
+func validateVPCOnlyStorageType(ws manifestReader, workloadName, storageType string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", storageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", storageType, workloadName, err)
+	}
+	if mftType == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("invalid storage type %s: not supported by %s", storageType, manifest.RequestDrivenWebServiceType)
 	}
 	return nil
 }
@@ -452,6 +500,109 @@ func validateEngine(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidEngineType, engine, prettify(engineTypes))
 }
 
+func validateRedisEngineVersion(val interface{}) error {
+	return validateEngineVersion(val, addon.RedisEngineVersions)
+}
+
+func validateOpenSearchEngineVersion(val interface{}) error {
+	return validateEngineVersion(val, addon.OpenSearchEngineVersions)
+}
+
+func validateEngineVersion(val interface{}, versions []string) error {
+	version, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !contains(version, versions) {
+		return fmt.Errorf(fmtErrInvalidEngineVersion, version, prettify(versions))
+	}
+	return nil
+}
+
+func validateRedisNodeType(val interface{}) error {
+	nodeType, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasPrefix(nodeType, redisNodeTypePrefix) {
+		return fmt.Errorf(fmtErrInvalidRedisNodeType, nodeType, redisNodeTypePrefix)
+	}
+	return nil
+}
+
+func validateOpenSearchInstanceType(val interface{}) error {
+	instanceType, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasSuffix(instanceType, openSearchInstanceTypeSuffix) {
+		return fmt.Errorf(fmtErrInvalidInstanceType, instanceType, openSearchInstanceTypeSuffix)
+	}
+	return nil
+}
+
+func validateRDSCapacityMode(mode string) error {
+	if !contains(mode, addon.RDSCapacityModes) {
+		return fmt.Errorf(fmtErrInvalidCapacityMode, mode, prettify(addon.RDSCapacityModes))
+	}
+	return nil
+}
+
+func validateRDSDeletionPolicy(policy string) error {
+	if !contains(policy, addon.RDSDeletionPolicies) {
+		return fmt.Errorf(fmtErrInvalidDeletionPolicy, policy, prettify(addon.RDSDeletionPolicies))
+	}
+	return nil
+}
+
+// validateRDSCapacity validates the number of Aurora capacity units (ACUs) entered in a prompt.
+func validateRDSCapacity(val interface{}) error {
+	in, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	acu, err := strconv.ParseFloat(in, 64)
+	if err != nil {
+		return errInvalidCapacityUnits
+	}
+	return validateRDSCapacityUnits(acu)
+}
+
+func validateRDSCapacityUnits(acu float64) error {
+	if acu < rdsMinCapacityUnits || acu > rdsMaxCapacityUnits {
+		return errInvalidCapacityUnits
+	}
+	// Capacity units are a multiple of the minimum capacity.
+	if acu/rdsMinCapacityUnits != float64(int(acu/rdsMinCapacityUnits)) {
+		return errInvalidCapacityUnits
+	}
+	return nil
+}
+
+func validateRDSInstanceClass(val interface{}) error {
+	class, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !strings.HasPrefix(class, rdsInstanceClassPrefix) {
+		return fmt.Errorf(fmtErrInvalidInstanceClass, class, rdsInstanceClassPrefix)
+	}
+	return nil
+}
+
+// validateRDSReaders validates the number of reader DB instances entered in a prompt.
+func validateRDSReaders(val interface{}) error {
+	in, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	readers, err := strconv.Atoi(in)
+	if err != nil || readers < 0 || readers > rdsMaxReaders {
+		return errInvalidReaders
+	}
+	return nil
+}
+
 func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
@@ -839,6 +839,44 @@ func TestValidateSecretName(t *testing.T) {
 	}
 }
 
+func Test_validatePortMapping(t *testing.T) {
+	testCases := map[string]testCase{
+		"single port": {
+			input: "80",
+		},
+		"local and remote ports": {
+			input: "8080:80",
+		},
+		"not a string": {
+			input: 80,
+			want:  errValueNotAString,
+		},
+		"invalid local port": {
+			input: "0:80",
+			want:  fmt.Errorf("port mapping 0:80 is invalid: %w", errPortInvalid),
+		},
+		"invalid remote port": {
+			input: "8080:http",
+			want:  fmt.Errorf("port mapping 8080:http is invalid: %w", errPortInvalid),
+		},
+		"too many ports": {
+			input: "8080:80:90",
+			want:  fmt.Errorf("port mapping 8080:80:90 is invalid: %w", errPortInvalid),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validatePortMapping(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func Test_validatePubSubTopicName(t *testing.T) {
 	testCases := map[string]struct {
 		inName string
@@ -24,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -36,8 +37,14 @@ const (
 	waitForStackTimeout = 1*time.Hour + 30*time.Minute
 
 	// CloudFormation resource types.
-	ecsServiceResourceType    = "AWS::ECS::Service"
-	envControllerResourceType = "Custom::EnvControllerFunction"
+	ecsServiceResourceType        = "AWS::ECS::Service"
+	ecsTaskDefinitionResourceType = "AWS::ECS::TaskDefinition"
+	envControllerResourceType     = "Custom::EnvControllerFunction"
+
+	// Logical IDs of the resources needed to shift traffic between target groups during deployments.
+	alternateTargetGroupLogicalID = "AlternateTargetGroup"
+	httpsListenerRuleLogicalID    = "HTTPSListenerRule"
+	httpListenerRuleLogicalID     = "HTTPListenerRule"
 )
 
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
@@ -87,7 +94,7 @@ type codePipelineClient interface {
 }
 
 type s3Client interface {
-	Upload(bucket, fileName string, data io.Reader) (string, error)
+	Upload(bucket, fileName string, data io.Reader, opts ...s3.UploadOption) (string, error)
 }
 
 type stackSetClient interface {
@@ -106,6 +113,7 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	elbv2Client    stream.ListenerRuleDescriber
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -119,6 +127,7 @@ func New(sess *session.Session) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		elbv2Client:    elbv2.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -292,11 +301,18 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 			}
 			renderer = r
 		case aws.StringValue(change.ResourceChange.ResourceType) == ecsServiceResourceType:
-			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, progress.ECSServiceRendererOpts{
+			opts := progress.ECSServiceRendererOpts{
 				Group:      in.g,
 				Ctx:        in.ctx,
 				RenderOpts: in.opts,
-			})
+			}
+			if _, ok := in.descriptions[alternateTargetGroupLogicalID]; ok {
+				if ruleARN := cf.productionListenerRule(in.stackName); ruleARN != "" {
+					opts.ListenerRules = cf.elbv2Client
+					opts.ProductionListenerRuleARN = ruleARN
+				}
+			}
+			renderer = progress.ListeningECSServiceResourceRenderer(in.stackStreamer, cf.ecsClient, logicalID, description, opts)
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
 			changeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
@@ -317,6 +333,23 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 	return resources, nil
 }
 
+// productionListenerRule returns the ARN of the listener rule that routes production traffic to the service.
+// The traffic shift is only displayed on a best-effort basis, so an empty string is returned if the rule can't be found.
+func (cf CloudFormation) productionListenerRule(stackName string) string {
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return ""
+	}
+	ids := make(map[string]string)
+	for _, r := range resources {
+		ids[aws.StringValue(r.LogicalResourceId)] = aws.StringValue(r.PhysicalResourceId)
+	}
+	if arn, ok := ids[httpsListenerRuleLogicalID]; ok {
+		return arn
+	}
+	return ids[httpListenerRuleLogicalID]
+}
+
 type envControllerRendererInput struct {
 	g                 *errgroup.Group
 	ctx               context.Context
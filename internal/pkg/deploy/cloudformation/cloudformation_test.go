@@ -16,6 +16,7 @@ import (
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/golang/mock/gomock"
@@ -186,7 +187,14 @@ func testDeployWorkload_RenderNewlyCreatedStackWithECSService(t *testing.T, stac
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mS3Client := mocks.NewMocks3Client(ctrl)
-	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
+	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("https://mockBucket.s3.us-west-2.amazonaws.com/manual/templates/mockStack/mockSHA.yml", nil)
+	mS3Client.EXPECT().Upload("mockBucket", fmt.Sprintf("manual/templates/%s/revisions/10", stackName), gomock.Any()).
+		DoAndReturn(func(_, _ string, data io.Reader, _ ...s3.UploadOption) (string, error) {
+			key, err := io.ReadAll(data)
+			require.NoError(t, err)
+			require.Equal(t, "manual/templates/mockStack/mockSHA.yml", string(key))
+			return "mockRecordURL", nil
+		})
 	mockCFN := mocks.NewMockcfnClient(ctrl)
 	mockECS := mocks.NewMockecsClient(ctrl)
 	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
@@ -251,6 +259,18 @@ Resources:
 	mockCFN.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_COMPLETE"),
 	}, nil)
+	mockCFN.EXPECT().StackResources(stackName).Return([]*cloudformation.StackResource{
+		{
+			LogicalResourceId:  aws.String("Service"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:service/cluster/service"),
+			ResourceType:       aws.String("AWS::ECS::Service"),
+		},
+		{
+			LogicalResourceId:  aws.String("TaskDefinition"),
+			PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/hello:10"),
+			ResourceType:       aws.String("AWS::ECS::TaskDefinition"),
+		},
+	}, nil)
 	client := CloudFormation{cfnClient: mockCFN, ecsClient: mockECS, s3Client: mS3Client}
 	buf := new(strings.Builder)
 
@@ -269,7 +289,7 @@ func testDeployWorkload_WithEnvControllerRenderer_NoStackUpdates(t *testing.T, s
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	mS3Client := mocks.NewMocks3Client(ctrl)
-	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("mockURL", nil)
+	mS3Client.EXPECT().Upload("mockBucket", gomock.Any(), gomock.Any()).Return("https://mockBucket.s3.us-west-2.amazonaws.com/manual/templates/mockStack/mockSHA.yml", nil)
 	mockCFN := mocks.NewMockcfnClient(ctrl)
 	deploymentTime := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
 
@@ -331,6 +351,7 @@ Resources:
 	mockCFN.EXPECT().Describe(svcStackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_COMPLETE"),
 	}, nil)
+	mockCFN.EXPECT().StackResources(svcStackName).Return(nil, nil)
 	client := CloudFormation{cfnClient: mockCFN, s3Client: mS3Client}
 	buf := new(strings.Builder)
 
@@ -347,7 +368,8 @@ func testDeployWorkload_RenderNewlyCreatedStackWithAddons(t *testing.T, stackNam
 	defer ctrl.Finish()
 	m := mocks.NewMockcfnClient(ctrl)
 	mS3Client := mocks.NewMocks3Client(ctrl)
-	mS3Client.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", gomock.Any()).Return("mockURL", nil)
+	mS3Client.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", gomock.Any()).
+		Return("https://mockBucket.s3.us-west-2.amazonaws.com/manual/templates/myapp-myenv-mysvc/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", nil)
 
 	// Mocks for the parent stack.
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
@@ -412,6 +434,7 @@ Resources:
 	m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_COMPLETE"),
 	}, nil)
+	m.EXPECT().StackResources(stackName).Return(nil, nil).AnyTimes() // Only services record the template of their task definition.
 
 	// Mocks for the addons stack.
 	m.EXPECT().DescribeChangeSet("5678", "my-nested-stack").Return(&cloudformation.ChangeSetDescription{
@@ -676,6 +699,7 @@ Resources:
 	m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
 		StackStatus: aws.String("CREATE_COMPLETE"),
 	}, nil)
+	m.EXPECT().StackResources(stackName).Return(nil, nil).AnyTimes() // Only services record the template of their task definition.
 
 	// Mocks for the addons stack.
 	m.EXPECT().DescribeChangeSet("5678", "my-nested-stack").Return(&cloudformation.ChangeSetDescription{
@@ -728,3 +752,59 @@ Resources:
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
 	require.Contains(t, buf.String(), "A DynamoDB table to store data")
 }
+
+func TestCloudFormation_productionListenerRule(t *testing.T) {
+	testCases := map[string]struct {
+		mockCFN func(m *mocks.MockcfnClient)
+		wanted  string
+	}{
+		"returns an empty string if the stack resources can't be retrieved": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-fe").Return(nil, errors.New("some error"))
+			},
+		},
+		"prefers the HTTPS listener rule": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-fe").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("HTTPListenerRule"),
+						PhysicalResourceId: aws.String("http-rule"),
+					},
+					{
+						LogicalResourceId:  aws.String("HTTPSListenerRule"),
+						PhysicalResourceId: aws.String("https-rule"),
+					},
+				}, nil)
+			},
+			wanted: "https-rule",
+		},
+		"falls back to the HTTP listener rule": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().StackResources("phonetool-test-fe").Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("HTTPListenerRule"),
+						PhysicalResourceId: aws.String("http-rule"),
+					},
+				}, nil)
+			},
+			wanted: "http-rule",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCFN(m)
+			client := CloudFormation{cfnClient: m}
+
+			// WHEN
+			got := client.productionListenerRule("phonetool-test-fe")
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -108,14 +109,14 @@ func TestCloudFormation_UpgradeEnvironment(t *testing.T) {
 						},
 					})
 				})
-				s3 := mocks.NewMocks3Client(ctrl)
-				s3.EXPECT().Upload("mockbucket", gomock.Any(), gomock.Any()).DoAndReturn(func(bucket, key string, data io.Reader) (string, error) {
+				mockS3 := mocks.NewMocks3Client(ctrl)
+				mockS3.EXPECT().Upload("mockbucket", gomock.Any(), gomock.Any()).DoAndReturn(func(bucket, key string, data io.Reader, _ ...s3.UploadOption) (string, error) {
 					require.Contains(t, key, "manual/templates/phonetool-test/")
 					return "url", nil
 				})
 				return &CloudFormation{
 					cfnClient: m,
-					s3Client:  s3,
+					s3Client:  mockS3,
 				}
 			},
 		},
@@ -161,6 +161,7 @@ func (s *BackendService) Template() (string, error) {
 		UseImportedCerts:         s.certImported,
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		EnvAddons:                convertEnvAddons(s.manifest.EnvAddons),
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
@@ -198,7 +199,11 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	overriddenTpl, err := applyOverrides(s.manifest.Overrides, string(overridenTpl))
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 func (s *BackendService) httpLoadBalancerTarget() (targetContainer *string, targetPort *string) {
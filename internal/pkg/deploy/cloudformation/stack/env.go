@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"gopkg.in/yaml.v3"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -91,6 +92,10 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	addons, err := e.addons()
+	if err != nil {
+		return "", err
+	}
 	var mft string
 	if e.in.Mft != nil {
 		out, err := yaml.Marshal(e.in.Mft)
@@ -116,6 +121,7 @@ func (e *EnvStackConfig) Template() (string, error) {
 		AllowVPCIngress:          e.in.AllowVPCIngress, // TODO(jwh): fetch AllowVPCIngress from Manifest or SSM.
 		Telemetry:                e.telemetryConfig(),
 		CDNConfig:                e.cdnConfig(),
+		Addons:                   addons,
 
 		Version:            e.in.Version,
 		LatestVersion:      deploy.LatestEnvTemplateVersion,
@@ -127,7 +133,14 @@ func (e *EnvStackConfig) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	if e.in.Mft == nil {
+		return content.String(), nil
+	}
+	overriddenTpl, err := applyOverrides(e.in.Mft.Overrides, content.String())
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 // Parameters returns the parameters to be passed into an environment CloudFormation template.
@@ -297,8 +310,66 @@ func (e *BootstrapEnvStackConfig) ToEnv(stack *cloudformation.Stack) (*config.En
 	}, nil
 }
 
+func (e *EnvStackConfig) addons() (*template.EnvAddons, error) {
+	if e.in.Addons == nil {
+		return nil, nil
+	}
+	outputs, err := addon.Outputs(e.in.Addons.Template)
+	if err != nil {
+		return nil, fmt.Errorf("get addons outputs for environment %s: %w", e.in.Name, err)
+	}
+	var names []string
+	for _, out := range outputs {
+		names = append(names, out.Name)
+	}
+	var tpl struct {
+		Parameters yaml.Node `yaml:"Parameters"`
+	}
+	if err := yaml.Unmarshal([]byte(e.in.Addons.Template), &tpl); err != nil {
+		return nil, fmt.Errorf("get addons parameters for environment %s: %w", e.in.Name, err)
+	}
+	var params []string
+	for i := 0; i+1 < len(tpl.Parameters.Content); i += 2 {
+		params = append(params, tpl.Parameters.Content[i].Value)
+	}
+	return &template.EnvAddons{
+		URL:         e.in.Addons.S3ObjectURL,
+		ExtraParams: e.in.Addons.ExtraParams,
+		Params:      params,
+		Outputs:     names,
+	}, nil
+}
+
 func (e *EnvStackConfig) cdnConfig() *template.CDNConfig {
-	return nil // no-op - return &template.CDNConfig{} when feature is ready
+	if e.in.Mft == nil || !e.in.Mft.CDNConfig.CDNEnabled() {
+		return nil
+	}
+	mftConfig := e.in.Mft.CDNConfig.CDNConfig
+	config := &template.CDNConfig{
+		Certificate:           aws.StringValue(mftConfig.Certificate),
+		Aliases:               mftConfig.Aliases,
+		PriceClass:            aws.StringValue(mftConfig.PriceClass),
+		WebACL:                aws.StringValue(mftConfig.WebACL),
+		OriginRequestPolicy:   aws.StringValue(mftConfig.HeaderPolicies.OriginRequestPolicy),
+		ResponseHeadersPolicy: aws.StringValue(mftConfig.HeaderPolicies.ResponseHeadersPolicy),
+	}
+	for _, behavior := range mftConfig.CacheBehaviors {
+		config.CacheBehaviors = append(config.CacheBehaviors, template.CDNCacheBehavior{
+			PathPattern:           aws.StringValue(behavior.Path),
+			CachePolicy:           aws.StringValue(behavior.CachePolicy),
+			OriginRequestPolicy:   aws.StringValue(behavior.HeaderPolicies.OriginRequestPolicy),
+			ResponseHeadersPolicy: aws.StringValue(behavior.HeaderPolicies.ResponseHeadersPolicy),
+		})
+	}
+	for _, assets := range mftConfig.StaticAssets {
+		config.StaticAssets = append(config.StaticAssets, template.CDNStaticAssets{
+			Location:    aws.StringValue(assets.Location),
+			PathPattern: aws.StringValue(assets.Path),
+		})
+	}
+	// A bucket can only have one policy, so assets stored in the same bucket share it.
+	config.StaticAssetsBuckets = mftConfig.StaticAssetsBuckets()
+	return config
 }
 
 func (e *EnvStackConfig) vpcConfig() template.VPCConfig {
@@ -6,6 +6,7 @@
 package stack_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -63,6 +64,49 @@ observability:
 			}(),
 			wantedFileName: "template-with-imported-certs-observability.yml",
 		},
+		"generate template with advanced content delivery network configuration": {
+			input: func() *deploy.CreateEnvironmentInput {
+				var mft manifest.Environment
+				err := yaml.Unmarshal([]byte(`
+name: test
+type: Environment
+cdn:
+  certificate: arn:aws:acm:us-east-1:000000000:certificate/cdn-cert
+  aliases:
+    - example.com
+    - www.example.com
+  price_class: PriceClass_100
+  web_acl: arn:aws:wafv2:us-east-1:000000000:global/webacl/mock-acl/1234
+  response_headers_policy: 67f7725c-6f97-4210-82d7-5512b31e9d03
+  cache_behaviors:
+    - path: /api/*
+      origin_request_policy: 216adef6-5c7f-47e4-b989-5492eafa07d3
+  static_assets:
+    - location: mockbucket.s3.us-west-2.amazonaws.com
+      path: static/*
+    - location: mockbucket.s3.us-west-2.amazonaws.com
+      path: images/*
+`), &mft)
+				require.NoError(t, err)
+				return &deploy.CreateEnvironmentInput{
+					Version: "1.x",
+					App: deploy.AppInformation{
+						AccountPrincipalARN: "arn:aws:iam::000000000:root",
+						Name:                "demo",
+					},
+					Name:                 "test",
+					ArtifactBucketARN:    "arn:aws:s3:::mockbucket",
+					ArtifactBucketKeyARN: "arn:aws:kms:us-west-2:000000000:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					CustomResourcesURLs: map[string]string{
+						template.DNSCertValidatorFileName: "https://mockbucket.s3-us-west-2.amazonaws.com/dns-cert-validator",
+						template.DNSDelegationFileName:    "https://mockbucket.s3-us-west-2.amazonaws.com/dns-delegation",
+						template.CustomDomainFileName:     "https://mockbucket.s3-us-west-2.amazonaws.com/custom-domain",
+					},
+					Mft: &mft,
+				}
+			}(),
+			wantedFileName: "template-with-cdn.yml",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -86,3 +130,152 @@ observability:
 		})
 	}
 }
+
+func TestEnvStack_CDNTemplate(t *testing.T) {
+	render := func(t *testing.T, cdn string) map[string]any {
+		var mft manifest.Environment
+		require.NoError(t, yaml.Unmarshal([]byte("name: test\ntype: Environment\n"+cdn), &mft))
+		envStack := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+			Version: "1.x",
+			App: deploy.AppInformation{
+				AccountPrincipalARN: "arn:aws:iam::000000000:root",
+				Name:                "demo",
+			},
+			Name:                 "test",
+			ArtifactBucketARN:    "arn:aws:s3:::mockbucket",
+			ArtifactBucketKeyARN: "arn:aws:kms:us-west-2:000000000:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			CustomResourcesURLs: map[string]string{
+				template.DNSCertValidatorFileName: "https://mockbucket.s3-us-west-2.amazonaws.com/dns-cert-validator",
+				template.DNSDelegationFileName:    "https://mockbucket.s3-us-west-2.amazonaws.com/dns-delegation",
+				template.CustomDomainFileName:     "https://mockbucket.s3-us-west-2.amazonaws.com/custom-domain",
+			},
+			Mft: &mft,
+		})
+		tpl, err := envStack.Template()
+		require.NoError(t, err, "serialize template")
+		var obj struct {
+			Resources map[string]any `yaml:"Resources"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(tpl), &obj))
+		return obj.Resources
+	}
+	distributionConfig := func(resources map[string]any) map[string]any {
+		return resources["CloudFrontDistribution"].(map[string]any)["Properties"].(map[string]any)["DistributionConfig"].(map[string]any)
+	}
+
+	t.Run("serves the aliases covered by the certificate", func(t *testing.T) {
+		resources := render(t, `cdn:
+  certificate: arn:aws:acm:us-east-1:000000000:certificate/cdn-cert
+  aliases: [example.com, www.example.com]
+`)
+		config := distributionConfig(resources)
+		require.Equal(t, []any{"example.com", "www.example.com"}, config["Aliases"])
+		require.Equal(t, "arn:aws:acm:us-east-1:000000000:certificate/cdn-cert", config["ViewerCertificate"].(map[string]any)["AcmCertificateArn"])
+	})
+	t.Run("connects to the load balancer over https when it has an https listener", func(t *testing.T) {
+		resources := render(t, `cdn:
+  certificate: arn:aws:acm:us-east-1:000000000:certificate/cdn-cert
+  aliases: [example.com]
+`)
+		config := distributionConfig(resources)
+		origin := config["Origins"].([]any)[0].(map[string]any)
+		require.Contains(t, fmt.Sprint(origin["CustomOriginConfig"]), "ExportHTTPSListener https-only http-only")
+		require.Equal(t, "216adef6-5c7f-47e4-b989-5492eafa07d3", config["DefaultCacheBehavior"].(map[string]any)["OriginRequestPolicyId"],
+			"the Host header must be forwarded to match the certificate of the load balancer")
+	})
+	t.Run("lets the distribution read the static assets of each bucket", func(t *testing.T) {
+		resources := render(t, `cdn:
+  static_assets:
+    - location: assets.s3.us-west-2.amazonaws.com
+      path: static/*
+    - location: assets.s3.us-west-2.amazonaws.com
+      path: images/*
+    - location: media.s3.us-west-2.amazonaws.com
+      path: media/*
+`)
+		for ind, bucket := range []string{"assets", "media"} {
+			policy, ok := resources[fmt.Sprintf("CloudFrontStaticAssetsBucketPolicy%d", ind)].(map[string]any)
+			require.True(t, ok, "bucket policy for %s should be rendered", bucket)
+			props := policy["Properties"].(map[string]any)
+			require.Equal(t, bucket, props["Bucket"])
+			statement := props["PolicyDocument"].(map[string]any)["Statement"].([]any)[0].(map[string]any)
+			require.Equal(t, map[string]any{"Service": "cloudfront.amazonaws.com"}, statement["Principal"])
+			require.Equal(t, "s3:GetObject", statement["Action"])
+			require.Contains(t, statement["Condition"].(map[string]any)["StringEquals"], "AWS:SourceArn")
+		}
+		require.NotContains(t, resources, "CloudFrontStaticAssetsBucketPolicy2")
+	})
+}
+
+func TestEnvStack_AddonsTemplate(t *testing.T) {
+	render := func(t *testing.T, addonsTpl string) map[string]any {
+		var mft manifest.Environment
+		require.NoError(t, yaml.Unmarshal([]byte("name: test\ntype: Environment\n"), &mft))
+		envStack := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+			Version: "1.x",
+			App: deploy.AppInformation{
+				AccountPrincipalARN: "arn:aws:iam::000000000:root",
+				Name:                "demo",
+			},
+			Name:                 "test",
+			ArtifactBucketARN:    "arn:aws:s3:::mockbucket",
+			ArtifactBucketKeyARN: "arn:aws:kms:us-west-2:000000000:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			CustomResourcesURLs: map[string]string{
+				template.DNSCertValidatorFileName: "https://mockbucket.s3-us-west-2.amazonaws.com/dns-cert-validator",
+				template.DNSDelegationFileName:    "https://mockbucket.s3-us-west-2.amazonaws.com/dns-delegation",
+				template.CustomDomainFileName:     "https://mockbucket.s3-us-west-2.amazonaws.com/custom-domain",
+			},
+			Addons: &deploy.Addons{
+				S3ObjectURL: "https://mockbucket.s3-us-west-2.amazonaws.com/addons",
+				Template:    addonsTpl,
+			},
+			Mft: &mft,
+		})
+		tpl, err := envStack.Template()
+		require.NoError(t, err, "serialize template")
+		var obj struct {
+			Resources map[string]any `yaml:"Resources"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(tpl), &obj))
+		return obj.Resources["AddonsStack"].(map[string]any)["Properties"].(map[string]any)["Parameters"].(map[string]any)
+	}
+
+	t.Run("passes the network of the environment to the parameters declared by the addons", func(t *testing.T) {
+		params := render(t, `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+  VpcId:
+    Type: String
+  PrivateSubnets:
+    Type: CommaDelimitedList
+  EnvironmentSecurityGroup:
+    Type: String
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`)
+		require.Equal(t, "VPC", fmt.Sprint(params["VpcId"]))
+		require.Equal(t, "[, [PrivateSubnet1 PrivateSubnet2]]", fmt.Sprint(params["PrivateSubnets"]))
+		require.Equal(t, "EnvironmentSecurityGroup", fmt.Sprint(params["EnvironmentSecurityGroup"]))
+	})
+	t.Run("does not pass the network of the environment to addons that don't declare it", func(t *testing.T) {
+		params := render(t, `Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  Name:
+    Type: String
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+`)
+		require.NotContains(t, params, "VpcId")
+		require.NotContains(t, params, "PrivateSubnets")
+		require.NotContains(t, params, "EnvironmentSecurityGroup")
+	})
+}
@@ -92,6 +92,52 @@ func TestEnv_Template(t *testing.T) {
 	}
 }
 
+func TestEnv_Template_Addons(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	in := mockDeployEnvironmentInput()
+	in.Addons = &deploy.Addons{
+		S3ObjectURL: "https://mockbucket.s3-us-west-2.amazonaws.com/addons",
+		Template: `Parameters:
+  App:
+    Type: String
+  VpcId:
+    Type: String
+Resources:
+  Cluster:
+    Type: AWS::RDS::DBCluster
+Outputs:
+  ClusterEndpoint:
+    Value: !GetAtt Cluster.Endpoint.Address
+  ClusterSecret:
+    Value: !Ref ClusterSecret
+`,
+		ExtraParams: "InstanceType: db.r5.large\n",
+	}
+	m := mocks.NewMockenvReadParser(ctrl)
+	m.EXPECT().ParseEnv(gomock.Any(), gomock.Any()).DoAndReturn(func(data *template.EnvOpts, options ...template.ParseOption) (*template.Content, error) {
+		require.Equal(t, &template.EnvAddons{
+			URL:         "https://mockbucket.s3-us-west-2.amazonaws.com/addons",
+			ExtraParams: "InstanceType: db.r5.large\n",
+			Params:      []string{"App", "VpcId"},
+			Outputs:     []string{"ClusterEndpoint", "ClusterSecret"},
+		}, data.Addons)
+		return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+	})
+	envStack := &EnvStackConfig{
+		in:     in,
+		parser: m,
+	}
+
+	// WHEN
+	got, err := envStack.Template()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, mockTemplate, got)
+}
+
 func TestEnv_Parameters(t *testing.T) {
 	deploymentInput := mockDeployEnvironmentInput()
 	deploymentInputWithDNS := mockDeployEnvironmentInput()
@@ -179,6 +179,19 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	additionalRules, err := s.convertAdditionalRoutingRules()
+	if err != nil {
+		return "", err
+	}
+	for i, rule := range s.manifest.RoutingRule.AdditionalRoutingRules {
+		ruleAliasesFor, err := convertHostedZone(rule)
+		if err != nil {
+			return "", fmt.Errorf(`convert hosted zones of "http.additional_rules[%d]": %w`, i, err)
+		}
+		for hostedZone, aliases := range ruleAliasesFor {
+			aliasesFor[hostedZone] = append(aliasesFor[hostedZone], aliases...)
+		}
+	}
 	if len(aliasesFor) != 0 && !s.certImported {
 		return "", fmt.Errorf("cannot specify alias hosted zones when env certificates are managed by Copilot")
 	}
@@ -210,6 +223,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		UseImportedCerts:         s.certImported,
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		EnvAddons:                convertEnvAddons(s.manifest.EnvAddons),
 		Sidecars:                 sidecars,
 		LogConfig:                convertLogging(s.manifest.Logging),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
@@ -241,7 +255,8 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		Observability: template.ObservabilityOpts{
 			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
 		},
-		HostedZoneAliases: aliasesFor,
+		HostedZoneAliases:      aliasesFor,
+		AdditionalRoutingRules: additionalRules,
 	})
 	if err != nil {
 		return "", err
@@ -250,7 +265,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	overriddenTpl, err := applyOverrides(s.manifest.Overrides, string(overridenTpl))
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 func (s *LoadBalancedWebService) httpLoadBalancerTarget() (targetContainer *string, targetPort *string) {
@@ -267,6 +286,45 @@ func (s *LoadBalancedWebService) httpLoadBalancerTarget() (targetContainer *stri
 	return
 }
 
+// convertAdditionalRoutingRules converts the additional routing rules of the service, each with its own target group.
+func (s *LoadBalancedWebService) convertAdditionalRoutingRules() ([]template.AdditionalRoutingRuleOpts, error) {
+	if s.manifest.RoutingRule.Disabled() {
+		return nil, nil
+	}
+	var rules []template.AdditionalRoutingRuleOpts
+	for i, rule := range s.manifest.RoutingRule.AdditionalRoutingRules {
+		opts := template.AdditionalRoutingRuleOpts{
+			Index:               i + 1,
+			Path:                aws.StringValue(rule.Path),
+			TargetContainer:     s.name,
+			TargetPort:          s.containerPort(),
+			HTTPHealthCheck:     convertHTTPHealthCheck(&rule.HealthCheck),
+			HTTPVersion:         convertHTTPVersion(rule.ProtocolVersion),
+			Stickiness:          aws.BoolValue(rule.Stickiness),
+			DeregistrationDelay: aws.Int64(60),
+		}
+		if target := rule.GetTargetContainer(); target != nil && aws.StringValue(target) != s.name {
+			opts.TargetContainer = aws.StringValue(target)
+			opts.TargetPort = aws.StringValue(s.manifest.Sidecars[opts.TargetContainer].Port)
+		}
+		if s.httpsEnabled {
+			aliases, err := convertAlias(rule.Alias)
+			if err != nil {
+				return nil, fmt.Errorf(`convert "http.additional_rules[%d]": %w`, i, err)
+			}
+			opts.Aliases = aliases
+		}
+		if rule.DeregistrationDelay != nil {
+			opts.DeregistrationDelay = aws.Int64(int64(rule.DeregistrationDelay.Seconds()))
+		}
+		for _, ipNet := range rule.AllowedSourceIps {
+			opts.AllowedSourceIps = append(opts.AllowedSourceIps, string(ipNet))
+		}
+		rules = append(rules, opts)
+	}
+	return rules, nil
+}
+
 func (s *LoadBalancedWebService) containerPort() string {
 	return strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
 }
@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/override"
+	"gopkg.in/yaml.v3"
+)
+
+// applyOverrides validates the paths of the override rules against the rendered template,
+// and then applies the rules to the template.
+// If there are no rules, the template is returned unchanged.
+func applyOverrides(rules []manifest.OverrideRule, tpl string) (string, error) {
+	if len(rules) == 0 {
+		return tpl, nil
+	}
+	if err := validateOverridePaths(rules, tpl); err != nil {
+		return "", err
+	}
+	out, err := override.CloudFormationTemplate(convertOverrideRules(rules), []byte(tpl))
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// validateOverridePaths returns an error if a rule refers to the properties of a logical ID that doesn't exist in the template.
+// The sections of the paths are validated with the manifest.
+// New logical IDs can be added as long as the rule's value holds the entire definition.
+func validateOverridePaths(rules []manifest.OverrideRule, tpl string) error {
+	var content struct {
+		Sections map[string]yaml.Node `yaml:",inline"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &content); err != nil {
+		return fmt.Errorf("unmarshal template to validate override paths: %w", err)
+	}
+	for i, rule := range rules {
+		segments, err := override.SplitPath(rule.Path)
+		if err != nil {
+			return fmt.Errorf(`validate "overrides[%d]": %w`, i, err)
+		}
+		if len(segments) < 3 {
+			continue
+		}
+		section, logicalID := override.UnquoteKey(segments[0]), override.UnquoteKey(segments[1])
+		node, ok := content.Sections[section]
+		if !ok || !hasMappingKey(&node, logicalID) {
+			return fmt.Errorf(`validate "overrides[%d]": logical ID "%s" does not exist under "%s" in the template`, i, logicalID, section)
+		}
+	}
+	return nil
+}
+
+func hasMappingKey(node *yaml.Node, key string) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return true
+		}
+	}
+	return false
+}
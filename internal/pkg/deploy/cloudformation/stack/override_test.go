@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_applyOverrides(t *testing.T) {
+	const tpl = `Resources:
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      HealthCheckTimeoutSeconds: 5
+      TargetGroupAttributes:
+        - Key: stickiness.enabled
+          Value: false
+Outputs:
+  DiscoveryServiceARN:
+    Value: !GetAtt DiscoveryService.Arn
+`
+	testCases := map[string]struct {
+		inRules []manifest.OverrideRule
+
+		wanted      string
+		wantedError string
+	}{
+		"returns the template as is if there are no rules": {
+			wanted: tpl,
+		},
+		"error if the logical ID doesn't exist": {
+			inRules: []manifest.OverrideRule{
+				{
+					Path: "Outputs.DiscoveryServiceARN.Value",
+				},
+				{
+					Path: "Resources.LoadBalancer.Properties.Scheme",
+				},
+			},
+			wantedError: `validate "overrides[1]": logical ID "LoadBalancer" does not exist under "Resources" in the template`,
+		},
+		"error if a rule can't be applied": {
+			inRules: []manifest.OverrideRule{
+				{
+					Op:   "remove",
+					Path: "Resources.TargetGroup.Properties.HealthCheckPath",
+				},
+			},
+			wantedError: `apply override rule with path "Resources.TargetGroup.Properties.HealthCheckPath": cannot remove HealthCheckPath because it does not exist`,
+		},
+		"applies the rules to a quoted logical ID": {
+			inRules: []manifest.OverrideRule{
+				{
+					Path: `Resources."TargetGroup".Properties.HealthCheckTimeoutSeconds`,
+					Value: yaml.Node{
+						Kind:  yaml.ScalarNode,
+						Value: "10",
+					},
+				},
+			},
+			wanted: `Resources:
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      HealthCheckTimeoutSeconds: 10
+      TargetGroupAttributes:
+        - Key: stickiness.enabled
+          Value: false
+Outputs:
+  DiscoveryServiceARN:
+    Value: !GetAtt DiscoveryService.Arn
+`,
+		},
+		"applies the rules to any logical ID": {
+			inRules: []manifest.OverrideRule{
+				{
+					Path: "Resources.TargetGroup.Properties.HealthCheckTimeoutSeconds",
+					Value: yaml.Node{
+						Kind:  yaml.ScalarNode,
+						Value: "10",
+					},
+				},
+				{
+					Op:   "remove",
+					Path: "Resources.TargetGroup.Properties.TargetGroupAttributes[0]",
+				},
+				{
+					Path: "Resources.Bucket",
+					Value: yaml.Node{
+						Kind: yaml.MappingNode,
+						Content: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "Type"},
+							{Kind: yaml.ScalarNode, Value: "AWS::S3::Bucket"},
+						},
+					},
+				},
+			},
+			wanted: `Resources:
+  TargetGroup:
+    Type: AWS::ElasticLoadBalancingV2::TargetGroup
+    Properties:
+      HealthCheckTimeoutSeconds: 10
+      TargetGroupAttributes: []
+  Bucket:
+    Type: AWS::S3::Bucket
+Outputs:
+  DiscoveryServiceARN:
+    Value: !GetAtt DiscoveryService.Arn
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := applyOverrides(tc.inRules, tpl)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
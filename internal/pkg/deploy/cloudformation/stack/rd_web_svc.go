@@ -146,7 +146,11 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return content.String(), nil
+	overriddenTpl, err := applyOverrides(s.manifest.Overrides, content.String())
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 // SerializedParameters returns the CloudFormation stack's parameters serialized
@@ -25,6 +25,9 @@ const (
 	ScheduledJobScheduleParamKey = "Schedule"
 )
 
+// noScheduleParamValue is the value of the schedule parameter of a job that is not triggered by any schedule.
+const noScheduleParamValue = "none"
+
 type scheduledJobReadParser interface {
 	template.ReadParser
 	ParseScheduledJob(template.WorkloadOpts) (*template.Content, error)
@@ -43,7 +46,7 @@ var (
 	fmtRateScheduleExpression = "rate(%d %s)" // rate({duration} {units})
 	fmtCronScheduleExpression = "cron(%s)"
 
-	awsScheduleRegexp = regexp.MustCompile(`((?:rate|cron)\(.*\)|none)`) // Validates that an expression is of the form rate(xyz) or cron(abc) or value 'none'
+	awsScheduleRegexp = regexp.MustCompile(`(?:rate|cron)\(.*\)`) // Validates that an expression is of the form rate(xyz) or cron(abc).
 )
 
 const (
@@ -145,10 +148,14 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf(`convert "publish" field for job %s: %w`, j.name, err)
 	}
-	schedule, err := j.awsSchedule()
+	schedules, err := j.awsSchedules()
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
+	eventRules, err := convertJobEventTriggers(j.manifest.On.Events)
+	if err != nil {
+		return "", fmt.Errorf("convert event triggers for job %s: %w", j.name, err)
+	}
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
@@ -173,8 +180,10 @@ func (j *ScheduledJob) Template() (string, error) {
 		WorkloadType:             manifest.ScheduledJobType,
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		EnvAddons:                convertEnvAddons(j.manifest.EnvAddons),
 		Sidecars:                 sidecars,
-		ScheduleExpression:       schedule,
+		ScheduleExpressions:      schedules,
+		EventRules:               eventRules,
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(j.manifest.Logging),
@@ -198,7 +207,11 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	overriddenTpl, err := applyOverrides(j.manifest.Overrides, string(overridenTpl))
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -207,10 +220,14 @@ func (j *ScheduledJob) Parameters() ([]*cloudformation.Parameter, error) {
 	if err != nil {
 		return nil, err
 	}
-	schedule, err := j.awsSchedule()
+	schedules, err := j.awsSchedules()
 	if err != nil {
 		return nil, err
 	}
+	schedule := noScheduleParamValue
+	if len(schedules) > 0 {
+		schedule = schedules[0]
+	}
 	return append(wkldParams, []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
@@ -229,6 +246,20 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 	return j.templateConfiguration(j)
 }
 
+// awsSchedules converts the schedules of the job to the format required by Cloudwatch Events.
+// A job without any schedule, or with the legacy "none" schedule, is not converted to any expression.
+func (j *ScheduledJob) awsSchedules() ([]string, error) {
+	var expressions []string
+	for _, schedule := range j.manifest.On.ScheduleExpressions() {
+		expression, err := awsSchedule(schedule)
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+	}
+	return expressions, nil
+}
+
 // awsSchedule converts the Schedule string to the format required by Cloudwatch Events
 // https://docs.aws.amazon.com/lambda/latest/dg/services-cloudwatchevents-expressions.html
 // Cron expressions must have an sixth "year" field, and must contain at least one ? (either-or)
@@ -238,14 +269,13 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // All others become cron expressions.
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
-func (j *ScheduledJob) awsSchedule() (string, error) {
-	schedule := aws.StringValue(j.manifest.On.Schedule)
+func awsSchedule(schedule string) (string, error) {
 	if schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+		return "", errors.New("schedule cannot be empty")
 	}
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -264,8 +294,6 @@ func (j *ScheduledJob) awsSchedule() (string, error) {
 		if err != nil {
 			return "", fmt.Errorf("parse preset schedule: %w", err)
 		}
-	case schedule == "none":
-		scheduleExpression = schedule // Keep expression as "none" when the job is disabled.
 	default:
 		scheduleExpression, err = toAWSCron(schedule)
 		if err != nil {
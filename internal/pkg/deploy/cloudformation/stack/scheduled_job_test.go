@@ -57,8 +57,8 @@ func TestScheduledJob_Template(t *testing.T) {
 				m := mocks.NewMockscheduledJobReadParser(ctrl)
 				m.EXPECT().ParseScheduledJob(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
 					require.Equal(t, template.WorkloadOpts{
-						WorkloadType:       manifest.ScheduledJobType,
-						ScheduleExpression: "cron(0 0 * * ? *)",
+						WorkloadType:        manifest.ScheduledJobType,
+						ScheduleExpressions: []string{"cron(0 0 * * ? *)"},
 						StateMachine: &template.StateMachineOpts{
 							Timeout: aws.Int(5400),
 							Retries: aws.Int(3),
@@ -93,7 +93,7 @@ func TestScheduledJob_Template(t *testing.T) {
 						},
 						AddonsExtraParams: `ServiceName: !GetAtt Service.Name
 DiscoveryServiceArn: !GetAtt DiscoveryService.Arn`,
-						ScheduleExpression: "cron(0 0 * * ? *)",
+						ScheduleExpressions: []string{"cron(0 0 * * ? *)"},
 						StateMachine: &template.StateMachineOpts{
 							Timeout: aws.Int(5400),
 							Retries: aws.Int(3),
@@ -213,7 +213,7 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 		},
 		"missing schedule": {
 			inputSchedule: "",
-			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
+			wantedError:   errors.New("schedule cannot be empty"),
 		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
@@ -311,30 +311,11 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule:  "rate(5 minutes)",
 			wantedSchedule: "rate(5 minutes)",
 		},
-		"passthrough 'none' case": {
-			inputSchedule:  "none",
-			wantedSchedule: "none",
-		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			// GIVEN
-			job := &ScheduledJob{
-				ecsWkld: &ecsWkld{
-					wkld: &wkld{
-						name: "mailer",
-					},
-				},
-				manifest: &manifest.ScheduledJob{
-					ScheduledJobConfig: manifest.ScheduledJobConfig{
-						On: manifest.JobTriggerConfig{
-							Schedule: aws.String(tc.inputSchedule),
-						},
-					},
-				},
-			}
 			// WHEN
-			parsedSchedule, err := job.awsSchedule()
+			parsedSchedule, err := awsSchedule(tc.inputSchedule)
 
 			// THEN
 			if tc.wantedErrorType != nil {
@@ -351,6 +332,66 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_awsSchedules(t *testing.T) {
+	testCases := map[string]struct {
+		inTrigger manifest.JobTriggerConfig
+
+		wantedSchedules []string
+		wantedError     error
+	}{
+		"manual-only job without any trigger": {
+			wantedSchedules: nil,
+		},
+		"legacy 'none' schedule": {
+			inTrigger: manifest.JobTriggerConfig{
+				Schedule: aws.String("none"),
+			},
+			wantedSchedules: nil,
+		},
+		"single schedule": {
+			inTrigger: manifest.JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+			wantedSchedules: []string{"cron(0 0 * * ? *)"},
+		},
+		"multiple schedules": {
+			inTrigger: manifest.JobTriggerConfig{
+				Schedules: []string{"@every 30m", "0 9 * * 1-5", "rate(1 day)"},
+			},
+			wantedSchedules: []string{"rate(30 minutes)", "cron(0 9 ? * 2-6 *)", "rate(1 day)"},
+		},
+		"error if a schedule cannot be converted": {
+			inTrigger: manifest.JobTriggerConfig{
+				Schedules: []string{"@daily", ""},
+			},
+			wantedError: errors.New("schedule cannot be empty"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: tc.inTrigger,
+					},
+				},
+			}
+
+			// WHEN
+			schedules, err := job.awsSchedules()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSchedules, schedules)
+		})
+	}
+}
+
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
 		inputTimeout    string
@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Output keys of a static site stack.
+const (
+	StaticSiteOutputBucketName             = "BucketName"
+	StaticSiteOutputDistributionID         = "DistributionID"
+	StaticSiteOutputDistributionDomainName = "DistributionDomainName"
+	StaticSiteOutputAlias                  = "Alias"
+)
+
+type staticSiteReadParser interface {
+	template.ReadParser
+	ParseStaticSite(template.WorkloadOpts) (*template.Content, error)
+}
+
+// StaticSite represents the configuration needed to create a CloudFormation stack from a static site manifest.
+type StaticSite struct {
+	*wkld
+	manifest *manifest.StaticSite
+
+	parser staticSiteReadParser
+}
+
+// StaticSiteConfig contains data required to initialize a static site stack.
+type StaticSiteConfig struct {
+	App           string
+	Env           string
+	Manifest      *manifest.StaticSite
+	RawManifest   []byte
+	RuntimeConfig RuntimeConfig
+}
+
+// NewStaticSite creates a new StaticSite stack from a manifest file.
+func NewStaticSite(cfg StaticSiteConfig) *StaticSite {
+	parser := template.New()
+	return &StaticSite{
+		wkld: &wkld{
+			name:        aws.StringValue(cfg.Manifest.Name),
+			env:         cfg.Env,
+			app:         cfg.App,
+			rc:          cfg.RuntimeConfig,
+			rawManifest: cfg.RawManifest,
+			parser:      parser,
+		},
+		manifest: cfg.Manifest,
+
+		parser: parser,
+	}
+}
+
+// Template returns the CloudFormation template for the static site.
+func (s *StaticSite) Template() (string, error) {
+	content, err := s.parser.ParseStaticSite(template.WorkloadOpts{
+		AppName:            s.app,
+		EnvName:            s.env,
+		WorkloadName:       s.name,
+		SerializedManifest: string(s.rawManifest),
+		WorkloadType:       manifest.StaticSiteType,
+
+		StaticSite: &template.StaticSiteOpts{
+			IndexDocument:  aws.StringValue(s.manifest.IndexDocument),
+			ErrorDocument:  aws.StringValue(s.manifest.ErrorDocument),
+			Alias:          aws.StringValue(s.manifest.HTTP.Alias),
+			CertificateARN: aws.StringValue(s.manifest.HTTP.Certificate),
+			HostedZoneID:   aws.StringValue(s.manifest.HTTP.HostedZone),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse static site template: %w", err)
+	}
+	overriddenTpl, err := applyOverrides(s.manifest.Overrides, content.String())
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (s *StaticSite) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(WorkloadAppNameParamKey),
+			ParameterValue: aws.String(s.app),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadEnvNameParamKey),
+			ParameterValue: aws.String(s.env),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadNameParamKey),
+			ParameterValue: aws.String(s.name),
+		},
+	}, nil
+}
+
+// Tags returns the list of tags to apply to the CloudFormation stack.
+// The tags in the manifest are applied in addition to the application's tags.
+func (s *StaticSite) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(tags.Merge(s.rc.AdditionalTags, s.manifest.Tags), map[string]string{
+		deploy.AppTagKey:     s.app,
+		deploy.EnvTagKey:     s.env,
+		deploy.ServiceTagKey: s.name,
+	})
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (s *StaticSite) SerializedParameters() (string, error) {
+	return s.templateConfiguration(s)
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestStaticSite_Template(t *testing.T) {
+	testCases := map[string]struct {
+		setUpManifest func(mft *manifest.StaticSite)
+
+		wantedDistributionConfig map[string]interface{}
+		wantedAliasRecord        bool
+		wantedAliasOutput        bool
+	}{
+		"serves the index document from the bucket": {
+			setUpManifest: func(mft *manifest.StaticSite) {},
+
+			wantedDistributionConfig: map[string]interface{}{
+				"DefaultRootObject": "index.html",
+			},
+		},
+		"serves the error document and the alias": {
+			setUpManifest: func(mft *manifest.StaticSite) {
+				mft.ErrorDocument = aws.String("404.html")
+				mft.HTTP = manifest.StaticSiteHTTP{
+					Alias:       aws.String("www.example.com"),
+					Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					HostedZone:  aws.String("Z0123456789"),
+				}
+			},
+
+			wantedDistributionConfig: map[string]interface{}{
+				"DefaultRootObject": "index.html",
+				"Aliases":           []interface{}{"www.example.com"},
+				"ViewerCertificate": map[string]interface{}{
+					"AcmCertificateArn":      "arn:aws:acm:us-east-1:123456789012:certificate/abc",
+					"MinimumProtocolVersion": "TLSv1.2_2021",
+					"SslSupportMethod":       "sni-only",
+				},
+				"CustomErrorResponses": []interface{}{
+					map[string]interface{}{
+						"ErrorCode":        403,
+						"ResponseCode":     404,
+						"ResponsePagePath": "/404.html",
+					},
+					map[string]interface{}{
+						"ErrorCode":        404,
+						"ResponseCode":     404,
+						"ResponsePagePath": "/404.html",
+					},
+				},
+			},
+			wantedAliasRecord: true,
+			wantedAliasOutput: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := manifest.NewStaticSite(manifest.StaticSiteProps{
+				Name: "frontend",
+				Path: "public",
+			})
+			tc.setUpManifest(mft)
+			site := NewStaticSite(StaticSiteConfig{
+				App:      "phonetool",
+				Env:      "test",
+				Manifest: mft,
+			})
+
+			// WHEN
+			tpl, err := site.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var actual struct {
+				Resources map[string]struct {
+					Properties map[string]interface{} `yaml:"Properties"`
+				} `yaml:"Resources"`
+				Outputs map[string]interface{} `yaml:"Outputs"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &actual))
+			distributionConfig := actual.Resources["Distribution"].Properties["DistributionConfig"].(map[string]interface{})
+			for k, v := range tc.wantedDistributionConfig {
+				require.Equal(t, v, distributionConfig[k], "unexpected value for %s", k)
+			}
+			_, ok := actual.Resources["AliasRecord"]
+			require.Equal(t, tc.wantedAliasRecord, ok)
+			require.Contains(t, actual.Outputs, StaticSiteOutputBucketName)
+			require.Contains(t, actual.Outputs, StaticSiteOutputDistributionID)
+			require.Contains(t, actual.Outputs, StaticSiteOutputDistributionDomainName)
+			_, ok = actual.Outputs[StaticSiteOutputAlias]
+			require.Equal(t, tc.wantedAliasOutput, ok)
+		})
+	}
+}
+
+func TestStaticSite_Parameters(t *testing.T) {
+	// GIVEN
+	site := NewStaticSite(StaticSiteConfig{
+		App: "phonetool",
+		Env: "test",
+		Manifest: manifest.NewStaticSite(manifest.StaticSiteProps{
+			Name: "frontend",
+		}),
+	})
+
+	// WHEN
+	params, err := site.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(WorkloadAppNameParamKey),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadEnvNameParamKey),
+			ParameterValue: aws.String("test"),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadNameParamKey),
+			ParameterValue: aws.String("frontend"),
+		},
+	}, params)
+}
+
+func TestStaticSite_Tags(t *testing.T) {
+	// GIVEN
+	mft := manifest.NewStaticSite(manifest.StaticSiteProps{
+		Name: "frontend",
+	})
+	mft.Tags = map[string]string{
+		"team":                "web",
+		"copilot-application": "overridden",
+	}
+	site := NewStaticSite(StaticSiteConfig{
+		App:      "phonetool",
+		Env:      "test",
+		Manifest: mft,
+		RuntimeConfig: RuntimeConfig{
+			AdditionalTags: map[string]string{
+				"owner": "admin",
+				"team":  "platform",
+			},
+		},
+	})
+
+	// WHEN
+	tags := site.Tags()
+
+	// THEN
+	require.Equal(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String("copilot-environment"),
+			Value: aws.String("test"),
+		},
+		{
+			Key:   aws.String("copilot-service"),
+			Value: aws.String("frontend"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("admin"),
+		},
+		{
+			Key:   aws.String("team"),
+			Value: aws.String("web"),
+		},
+	}, tags)
+}
@@ -55,14 +55,24 @@ func (t *taskStackConfig) StackName() string {
 var cfnFuntion = map[string]interface{}{
 	"isARN":           template.IsARNFunc,
 	"trimSlashPrefix": template.TrimSlashPrefix,
+	"fmtSlice":        template.FmtSliceFunc,
+	"quoteSlice":      template.QuoteSliceFunc,
 }
 
 // Template returns the task CloudFormation template.
 func (t *taskStackConfig) Template() (string, error) {
+	sidecars, err := convertSidecar(t.Sidecars)
+	if err != nil {
+		return "", fmt.Errorf("convert the sidecar configuration for task %s: %w", t.Name, err)
+	}
 	content, err := t.parser.Parse(taskTemplatePath, struct {
 		EnvVars               map[string]string
 		SSMParamSecrets       map[string]string
 		SecretsManagerSecrets map[string]string
+		HasSecrets            bool
+		DependsOn             map[string]string
+		Sidecars              []*template.SidecarOpts
+		Storage               *template.StorageOpts
 		App                   string
 		Env                   string
 		ExecutionRole         string
@@ -70,6 +80,10 @@ func (t *taskStackConfig) Template() (string, error) {
 		EnvVars:               t.EnvVars,
 		SSMParamSecrets:       t.SSMParamSecrets,
 		SecretsManagerSecrets: t.SecretsManagerSecrets,
+		HasSecrets:            t.hasSecrets(),
+		DependsOn:             convertDependsOn(t.DependsOn),
+		Sidecars:              sidecars,
+		Storage:               convertStorageOpts(aws.String(t.Name), t.Storage),
 		App:                   t.App,
 		Env:                   t.Env,
 		ExecutionRole:         t.ExecutionRole,
@@ -80,6 +94,19 @@ func (t *taskStackConfig) Template() (string, error) {
 	return content.String(), nil
 }
 
+// hasSecrets returns true if any container of the task pulls secrets.
+func (t *taskStackConfig) hasSecrets() bool {
+	if len(t.SSMParamSecrets) > 0 || len(t.SecretsManagerSecrets) > 0 {
+		return true
+	}
+	for _, sidecar := range t.Sidecars {
+		if len(sidecar.Secrets) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Parameters returns the parameter values to be passed to the task CloudFormation template.
 func (t *taskStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 	return []*cloudformation.Parameter{
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -73,6 +75,89 @@ func TestTaskStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestTaskStackConfig_TemplateWithManifestConfig(t *testing.T) {
+	// GIVEN
+	taskStackConfig := NewTaskStackConfig(&deploy.CreateTaskResourcesInput{
+		Name:   "api",
+		CPU:    256,
+		Memory: 512,
+		Image:  "7456.dkr.ecr.us-east-2.amazonaws.com/api:0.1",
+		App:    "my-app",
+		Env:    "test",
+		Sidecars: map[string]*manifest.SidecarConfig{
+			"nginx": {
+				Image: aws.String("public.ecr.aws/nginx/nginx"),
+				Secrets: map[string]manifest.Secret{
+					"TOKEN": {},
+				},
+				MountPoints: []manifest.SidecarMountPoint{
+					{
+						SourceVolume: aws.String("data"),
+						MountPointOpts: manifest.MountPointOpts{
+							ContainerPath: aws.String("/var/data"),
+						},
+					},
+				},
+			},
+		},
+		Storage: manifest.Storage{
+			Volumes: map[string]*manifest.Volume{
+				"data": {
+					EFS: manifest.EFSConfigOrBool{
+						Advanced: manifest.EFSVolumeConfiguration{
+							FileSystemID: aws.String("fs-1234"),
+						},
+					},
+					MountPointOpts: manifest.MountPointOpts{
+						ContainerPath: aws.String("/var/data"),
+						ReadOnly:      aws.Bool(false),
+					},
+				},
+			},
+		},
+	})
+
+	// WHEN
+	got, err := taskStackConfig.Template()
+
+	// THEN
+	require.NoError(t, err)
+	var tpl struct {
+		Resources struct {
+			TaskDefinition struct {
+				Properties struct {
+					ContainerDefinitions []map[string]interface{} `yaml:"ContainerDefinitions"`
+					Volumes              []map[string]interface{} `yaml:"Volumes"`
+				} `yaml:"Properties"`
+			} `yaml:"TaskDefinition"`
+			DefaultExecutionRole struct {
+				Properties struct {
+					Policies []map[string]interface{} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"DefaultExecutionRole"`
+			DefaultTaskRole struct {
+				Properties struct {
+					Policies []map[string]interface{} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"DefaultTaskRole"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(got), &tpl))
+	props := tpl.Resources.TaskDefinition.Properties
+	require.Len(t, props.ContainerDefinitions, 2)
+	require.Equal(t, "nginx", props.ContainerDefinitions[1]["Name"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"ContainerPath": "/var/data",
+			"ReadOnly":      false,
+			"SourceVolume":  "data",
+		},
+	}, props.ContainerDefinitions[0]["MountPoints"])
+	require.Equal(t, "data", props.Volumes[0]["Name"])
+	require.Len(t, tpl.Resources.DefaultExecutionRole.Properties.Policies, 1, "should grant access to the secrets of the sidecar")
+	require.Equal(t, "GrantEFSAccessfs-1234", tpl.Resources.DefaultTaskRole.Properties.Policies[1]["PolicyName"])
+}
+
 func TestTaskStackConfig_Parameters(t *testing.T) {
 	expectedParams := []*cloudformation.Parameter{
 		{
@@ -52,6 +52,20 @@ const (
 	maxPercentDefault         = 200
 )
 
+// Default traffic shifting steps for canary and linear deployments.
+const (
+	defaultCanaryTrafficShiftPercent  = 10
+	defaultCanaryTrafficShiftInterval = 5 * time.Minute
+	defaultLinearTrafficShiftPercent  = 10
+	defaultLinearTrafficShiftInterval = time.Minute
+)
+
+var ecsDeploymentStrategies = map[string]string{
+	manifest.ECSCanaryDeploymentStrategy:    "CANARY",
+	manifest.ECSLinearDeploymentStrategy:    "LINEAR",
+	manifest.ECSBlueGreenDeploymentStrategy: "BLUE_GREEN",
+}
+
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
@@ -441,6 +455,17 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+func convertEnvAddons(in manifest.EnvAddons) *template.WorkloadEnvAddonsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	return &template.WorkloadEnvAddonsOpts{
+		Variables: in.Variables,
+		Secrets:   in.Secrets,
+		Policies:  in.Policies,
+	}
+}
+
 func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
@@ -461,6 +486,7 @@ func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rul
 	suffixStr := strings.Join(taskDefOverrideRulePrefixes, override.PathSegmentSeparator)
 	for _, r := range inRules {
 		res = append(res, override.Rule{
+			Op:    r.Op,
 			Path:  strings.Join([]string{suffixStr, r.Path}, override.PathSegmentSeparator),
 			Value: r.Value,
 		})
@@ -468,6 +494,18 @@ func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rul
 	return res
 }
 
+func convertOverrideRules(inRules []manifest.OverrideRule) []override.Rule {
+	var res []override.Rule
+	for _, r := range inRules {
+		res = append(res, override.Rule{
+			Op:    r.Op,
+			Path:  r.Path,
+			Value: r.Value,
+		})
+	}
+	return res
+}
+
 // convertStorageOpts converts a manifest Storage field into template data structures which can be used
 // to execute CFN templates
 func convertStorageOpts(wlName *string, in manifest.Storage) *template.StorageOpts {
@@ -725,6 +763,32 @@ func convertDeploymentConfig(deploymentConfig manifest.DeploymentConfiguration)
 		deployConfigs.MinHealthyPercent = minHealthyPercentDefault
 		deployConfigs.MaxPercent = maxPercentDefault
 	}
+	deployConfigs.RollbackAlarms = deploymentConfig.RollbackAlarms
+	strategy := strings.ToLower(aws.StringValue(deploymentConfig.Strategy))
+	if strategy == "" {
+		return deployConfigs
+	}
+	deployConfigs.Strategy = ecsDeploymentStrategies[strategy]
+	if deploymentConfig.BakeTime != nil {
+		deployConfigs.BakeTimeInMinutes = aws.Int(int(deploymentConfig.BakeTime.Minutes()))
+	}
+	var percent, interval = defaultCanaryTrafficShiftPercent, defaultCanaryTrafficShiftInterval
+	switch strategy {
+	case manifest.ECSLinearDeploymentStrategy:
+		percent, interval = defaultLinearTrafficShiftPercent, defaultLinearTrafficShiftInterval
+	case manifest.ECSBlueGreenDeploymentStrategy:
+		return deployConfigs
+	}
+	if deploymentConfig.TrafficShift.Percent != nil {
+		percent = aws.IntValue(deploymentConfig.TrafficShift.Percent)
+	}
+	if deploymentConfig.TrafficShift.Interval != nil {
+		interval = *deploymentConfig.TrafficShift.Interval
+	}
+	deployConfigs.TrafficShift = &template.TrafficShiftOpts{
+		Percent:           percent,
+		IntervalInMinutes: int(interval.Minutes()),
+	}
 	return deployConfigs
 }
 
@@ -810,6 +874,21 @@ func convertFilterPolicy(filterPolicy map[string]interface{}) (*string, error) {
 	return aws.String(string(bytes)), nil
 }
 
+func convertJobEventTriggers(events []manifest.JobEventTrigger) ([]template.EventRuleOpts, error) {
+	var rules []template.EventRuleOpts
+	for i, event := range events {
+		pattern, err := json.Marshal(event.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "events[%d].pattern" to a JSON string: %w`, i, err)
+		}
+		rules = append(rules, template.EventRuleOpts{
+			EventBus: event.Bus,
+			Pattern:  string(pattern),
+		})
+	}
+	return rules, nil
+}
+
 func convertQueue(q manifest.SQSQueue) *template.SQSQueue {
 	if q.IsEmpty() {
 		return nil
@@ -6,6 +6,7 @@ package stack
 import (
 	"errors"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -623,6 +624,20 @@ func Test_convertTaskDefOverrideRules(t *testing.T) {
 				},
 			},
 		},
+		"should keep the operation": {
+			inRule: []manifest.OverrideRule{
+				{
+					Op:   "remove",
+					Path: "ContainerDefinitions[Name=nginx].Ulimits",
+				},
+			},
+			wanted: []override.Rule{
+				{
+					Op:   "remove",
+					Path: "Resources.TaskDefinition.Properties.ContainerDefinitions[Name=nginx].Ulimits",
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1349,6 +1364,81 @@ func Test_convertEphemeral(t *testing.T) {
 	}
 }
 
+func Test_convertDeploymentConfig(t *testing.T) {
+	bakeTime, interval := 15*time.Minute, 2*time.Minute
+	testCases := map[string]struct {
+		in     manifest.DeploymentConfiguration
+		wanted template.DeploymentConfigurationOpts
+	}{
+		"default rolling update": {
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+			},
+		},
+		"recreate rolling update with rollback alarms": {
+			in: manifest.DeploymentConfiguration{
+				Rolling:        aws.String("recreate"),
+				RollbackAlarms: []string{"HighLatency"},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 0,
+				MaxPercent:        100,
+				RollbackAlarms:    []string{"HighLatency"},
+			},
+		},
+		"blue/green deployment": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("bluegreen"),
+				BakeTime: &bakeTime,
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				Strategy:          "BLUE_GREEN",
+				BakeTimeInMinutes: aws.Int(15),
+			},
+		},
+		"canary deployment with default traffic shift": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("canary"),
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				Strategy:          "CANARY",
+				TrafficShift: &template.TrafficShiftOpts{
+					Percent:           10,
+					IntervalInMinutes: 5,
+				},
+			},
+		},
+		"linear deployment with custom traffic shift": {
+			in: manifest.DeploymentConfiguration{
+				Strategy: aws.String("Linear"),
+				TrafficShift: manifest.TrafficShiftConfig{
+					Percent:  aws.Int(25),
+					Interval: &interval,
+				},
+			},
+			wanted: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 100,
+				MaxPercent:        200,
+				Strategy:          "LINEAR",
+				TrafficShift: &template.TrafficShiftOpts{
+					Percent:           25,
+					IntervalInMinutes: 2,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertDeploymentConfig(tc.in))
+		})
+	}
+}
+
 func Test_convertPublish(t *testing.T) {
 	accountId := "123456789123"
 	partition := "aws"
@@ -1500,6 +1590,72 @@ func Test_convertSubscribe(t *testing.T) {
 	}
 }
 
+func Test_convertJobEventTriggers(t *testing.T) {
+	testCases := map[string]struct {
+		inEvents []manifest.JobEventTrigger
+
+		wanted      []template.EventRuleOpts
+		wantedError error
+	}{
+		"no events": {
+			wanted: nil,
+		},
+		"events on the default and a custom bus": {
+			inEvents: []manifest.JobEventTrigger{
+				{
+					Pattern: map[string]interface{}{
+						"source":      []interface{}{"aws.s3"},
+						"detail-type": []interface{}{"Object Created"},
+						"detail": map[string]interface{}{
+							"bucket": map[string]interface{}{
+								"name": []interface{}{"my-bucket"},
+							},
+						},
+					},
+				},
+				{
+					Bus: aws.String("orders"),
+					Pattern: map[string]interface{}{
+						"source": []interface{}{"com.example.orders"},
+					},
+				},
+			},
+			wanted: []template.EventRuleOpts{
+				{
+					Pattern: `{"detail":{"bucket":{"name":["my-bucket"]}},"detail-type":["Object Created"],"source":["aws.s3"]}`,
+				},
+				{
+					EventBus: aws.String("orders"),
+					Pattern:  `{"source":["com.example.orders"]}`,
+				},
+			},
+		},
+		"error if the pattern cannot be marshaled": {
+			inEvents: []manifest.JobEventTrigger{
+				{
+					Pattern: map[string]interface{}{
+						"detail": map[string]interface{}{
+							"size": []interface{}{math.Inf(1)},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`convert "events[0].pattern" to a JSON string: json: unsupported value: +Inf`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertJobEventTriggers(tc.inEvents)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertPlatform(t *testing.T) {
 	testCases := map[string]struct {
 		in  manifest.PlatformArgsOrString
@@ -125,6 +125,7 @@ func (s *WorkerService) Template() (string, error) {
 		Secrets:                  convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
+		EnvAddons:                convertEnvAddons(s.manifest.EnvAddons),
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
@@ -157,7 +158,11 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("apply task definition overrides: %w", err)
 	}
-	return string(overridenTpl), nil
+	overriddenTpl, err := applyOverrides(s.manifest.Overrides, string(overridenTpl))
+	if err != nil {
+		return "", fmt.Errorf("apply overrides: %w", err)
+	}
+	return overriddenTpl, nil
 }
 
 // Parameters returns the list of CloudFormation parameters used by the template.
@@ -4,13 +4,21 @@
 package cloudformation
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"golang.org/x/sync/errgroup"
 )
 
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
@@ -28,7 +36,120 @@ func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfig
 	for _, opt := range opts {
 		opt(stack)
 	}
-	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+	if err := cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack)); err != nil {
+		return err
+	}
+	return cf.recordTaskDefRevisionTemplate(bucketName, stack.Name, templateURL)
+}
+
+// RollbackServiceInput holds the fields required to redeploy a previous template of a service stack.
+type RollbackServiceInput struct {
+	StackName   string
+	Template    string            // Body of the template to redeploy.
+	TemplateURL string            // S3 URL of the template to redeploy.
+	Parameters  map[string]string // Parameter values that replace the ones of the deployed stack.
+	RoleARN     string
+	Bucket      string // Bucket where the template key of the task definition revision is recorded.
+}
+
+// RollbackService redeploys a previous template of a service stack with the parameters of the deployed stack,
+// and renders progress updates to out until the stack is updated.
+func (cf CloudFormation) RollbackService(out progress.FileWriter, in *RollbackServiceInput) error {
+	descr, err := cf.cfnClient.Describe(in.StackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", in.StackName, err)
+	}
+	declared, err := cloudformation.ParseTemplateParameters(in.Template)
+	if err != nil {
+		return fmt.Errorf("parse parameters of the template to roll back to: %w", err)
+	}
+	params := make(map[string]string)
+	for _, param := range descr.Parameters {
+		// Parameters added to the stack since the template was deployed can't be passed to it.
+		if key := aws.StringValue(param.ParameterKey); declared[key] {
+			params[key] = aws.StringValue(param.ParameterValue)
+		}
+	}
+	for key, value := range in.Parameters {
+		params[key] = value
+	}
+	tags := make(map[string]string)
+	for _, tag := range descr.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	stack := cloudformation.NewStackWithURL(in.StackName, in.TemplateURL,
+		cloudformation.WithParameters(params), cloudformation.WithTags(tags), cloudformation.WithRoleARN(in.RoleARN))
+
+	spinner := progress.NewSpinner(out)
+	label := fmt.Sprintf("Proposing infrastructure changes for stack %s", in.StackName)
+	spinner.Start(label)
+	changeSetID, err := cf.cfnClient.Update(stack)
+	if err != nil {
+		msg := log.Serrorf("%s\n", label)
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errChangeSetEmpty) {
+			msg = fmt.Sprintf("- No new infrastructure changes for stack %s\n", in.StackName)
+		}
+		spinner.Stop(msg)
+		return cf.handleStackError(in.StackName, err)
+	}
+	spinner.Stop(log.Ssuccessf("%s\n", label))
+
+	changeSet, err := cf.cfnClient.DescribeChangeSet(changeSetID, in.StackName)
+	if err != nil {
+		return err
+	}
+	descriptions, err := cloudformation.ParseTemplateDescriptions(in.Template)
+	if err != nil {
+		return fmt.Errorf("parse cloudformation template for resource descriptions: %w", err)
+	}
+	waitCtx, cancelWait := context.WithTimeout(context.Background(), waitForStackTimeout)
+	defer cancelWait()
+	g, ctx := errgroup.WithContext(waitCtx)
+	streamer := stream.NewStackStreamer(cf.cfnClient, in.StackName, changeSet.CreationTime)
+	renderer := progress.ListeningStackRenderer(streamer, in.StackName,
+		fmt.Sprintf("Rolling back the infrastructure for stack %s", in.StackName), descriptions, progress.RenderOptions{})
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		return progress.Render(ctx, progress.NewTabbedFileWriter(out), renderer)
+	})
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if err := cf.errOnFailedStack(in.StackName); err != nil {
+		return err
+	}
+	return cf.recordTaskDefRevisionTemplate(in.Bucket, in.StackName, in.TemplateURL)
+}
+
+// recordTaskDefRevisionTemplate stores the key of the template in templateURL next to the templates of the stack,
+// under the revision of the task definition deployed by the stack, so that the revision can be rolled back to.
+// Stacks without a task definition are not recorded.
+func (cf CloudFormation) recordTaskDefRevisionTemplate(bucket, stackName, templateURL string) error {
+	_, templateKey, err := s3.ParseURL(templateURL)
+	if err != nil {
+		return err
+	}
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return err
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) != ecsTaskDefinitionResourceType {
+			continue
+		}
+		arn := aws.StringValue(resource.PhysicalResourceId)
+		revision, err := strconv.ParseInt(arn[strings.LastIndex(arn, ":")+1:], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse revision of task definition %s: %w", arn, err)
+		}
+		if _, err := cf.s3Client.Upload(bucket, artifactpath.TaskDefRevisionTemplate(stackName, revision), strings.NewReader(templateKey)); err != nil {
+			return fmt.Errorf("record template of task definition %s: %w", arn, err)
+		}
+	}
+	return nil
 }
 
 type uploadableStack interface {
@@ -4,10 +4,16 @@
 package cloudformation
 
 import (
+	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -104,6 +110,155 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_RollbackService(t *testing.T) {
+	const stackName = "myapp-myenv-mysvc"
+	rollbackTemplate := `
+Parameters:
+  AppName:
+    Type: String
+  ContainerImage:
+    Type: String
+Resources:
+  Service:
+    Metadata:
+      'aws:copilot:description': 'An ECS service to run and maintain your tasks'
+    Type: AWS::ECS::Service
+`
+	deployedStack := &cloudformation.StackDescription{
+		Parameters: []*sdkcloudformation.Parameter{
+			{
+				ParameterKey:   aws.String("AppName"),
+				ParameterValue: aws.String("myapp"),
+			},
+			{
+				ParameterKey:   aws.String("ContainerImage"),
+				ParameterValue: aws.String("mockRepo@sha256:bad"),
+			},
+			{
+				ParameterKey:   aws.String("AddedAfterRollbackTemplate"),
+				ParameterValue: aws.String("true"),
+			},
+		},
+		Tags: []*sdkcloudformation.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String("myapp"),
+			},
+		},
+	}
+	const templateURL = "https://mockBucket.s3.us-west-2.amazonaws.com/manual/templates/myapp-myenv-mysvc/mockSHA.yml"
+	testCases := map[string]struct {
+		mockCFN func(m *mocks.MockcfnClient)
+		mockS3  func(m *mocks.Mocks3Client)
+
+		wantedErr error
+	}{
+		"returns a wrapped error if the deployed stack cannot be described": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe stack myapp-myenv-mysvc: some error"),
+		},
+		"returns the error with its reason if the change set cannot be created": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(deployedStack, nil)
+				m.EXPECT().Update(gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().ErrorEvents(stackName).Return(nil, nil)
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"redeploys the template with the parameters of the deployed stack that it declares": {
+			mockCFN: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Describe(stackName).Return(deployedStack, nil)
+				m.EXPECT().Update(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (string, error) {
+					require.Equal(t, templateURL, stack.TemplateURL)
+					require.Equal(t, "mockRoleARN", aws.StringValue(stack.RoleARN))
+					require.ElementsMatch(t, []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("AppName"),
+							ParameterValue: aws.String("myapp"),
+						},
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("mockRepo@sha256:good"),
+						},
+					}, stack.Parameters)
+					require.Equal(t, deployedStack.Tags, stack.Tags)
+					return "1234", nil
+				})
+				m.EXPECT().DescribeChangeSet("1234", stackName).Return(&cloudformation.ChangeSetDescription{}, nil)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						{
+							EventId:            aws.String("1"),
+							LogicalResourceId:  aws.String(stackName),
+							PhysicalResourceId: aws.String("AWS::CloudFormation::Stack"),
+							ResourceStatus:     aws.String("UPDATE_COMPLETE"),
+							Timestamp:          aws.Time(time.Now()),
+						},
+					},
+				}, nil).AnyTimes()
+				m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+					StackStatus: aws.String("UPDATE_COMPLETE"),
+				}, nil)
+				m.EXPECT().StackResources(stackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("TaskDefinition"),
+						PhysicalResourceId: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-myenv-mysvc:13"),
+						ResourceType:       aws.String("AWS::ECS::TaskDefinition"),
+					},
+				}, nil)
+			},
+			mockS3: func(m *mocks.Mocks3Client) {
+				m.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/revisions/13", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader, _ ...s3.UploadOption) (string, error) {
+						key, err := io.ReadAll(data)
+						require.NoError(t, err)
+						require.Equal(t, "manual/templates/myapp-myenv-mysvc/mockSHA.yml", string(key))
+						return "mockRecordURL", nil
+					})
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			tc.mockCFN(m)
+			mS3 := mocks.NewMocks3Client(ctrl)
+			if tc.mockS3 != nil {
+				tc.mockS3(mS3)
+			}
+			c := CloudFormation{
+				cfnClient: m,
+				s3Client:  mS3,
+			}
+
+			// WHEN
+			err := c.RollbackService(mockFileWriter{Writer: new(strings.Builder)}, &RollbackServiceInput{
+				StackName:   stackName,
+				Template:    rollbackTemplate,
+				TemplateURL: templateURL,
+				Parameters: map[string]string{
+					"ContainerImage": "mockRepo@sha256:good",
+				},
+				RoleARN: "mockRoleARN",
+				Bucket:  "mockBucket",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
 	testCases := map[string]struct {
 		in         deploy.DeleteWorkloadInput
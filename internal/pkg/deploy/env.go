@@ -14,7 +14,7 @@ const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.12.0"
+	LatestEnvTemplateVersion = "v1.14.0"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -41,10 +41,18 @@ type CreateEnvironmentInput struct {
 	AllowVPCIngress    bool              // Optional configuration to allow access to internal ALB from ports 80/443.
 	Telemetry          *config.Telemetry // Optional observability and monitoring configuration.
 	Mft                *manifest.Environment
+	Addons             *Addons // Optional configuration if users have addons shared by the workloads of the environment.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
 }
 
+// Addons holds the merged template of the environment addons and where it is uploaded.
+type Addons struct {
+	S3ObjectURL string // S3 object URL of the merged addons template.
+	Template    string // The merged addons template.
+	ExtraParams string // Additional user defined Parameters for the addons stack.
+}
+
 // CreateEnvironmentResponse holds the created environment on successful deployment.
 // Otherwise, the environment is set to nil and a descriptive error is returned.
 type CreateEnvironmentResponse struct {
@@ -8,6 +8,8 @@ package deploy
 import (
 	"fmt"
 	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
 // FmtTaskECRRepoName is the pattern used to generate the ECR repository's name
@@ -28,6 +30,11 @@ type CreateTaskResourcesInput struct {
 	SSMParamSecrets       map[string]string
 	SecretsManagerSecrets map[string]string
 
+	// Optional configuration when the task is run from a workload manifest.
+	DependsOn manifest.DependsOn
+	Sidecars  map[string]*manifest.SidecarConfig
+	Storage   manifest.Storage
+
 	OS   string
 	Arch string
 
@@ -0,0 +1,317 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	// DefaultAppStatusMaxConcurrency is the default number of service statuses described at the same time.
+	DefaultAppStatusMaxConcurrency = 5
+
+	alarmStateOK    = "OK"
+	alarmStateAlarm = "ALARM"
+	notDeployedRep  = "-"
+)
+
+// ServiceStatusDescriber describes the status of a service deployed in an environment.
+type ServiceStatusDescriber interface {
+	Describe() (HumanJSONStringer, error)
+}
+
+// NewAppStatusConfig contains fields that initiates an AppStatusDescriber.
+type NewAppStatusConfig struct {
+	App            string
+	ConfigStore    AppStatusConfigStore
+	DeployStore    DeployedEnvServicesLister
+	MaxConcurrency int // Maximum number of service statuses described at the same time. Defaults to DefaultAppStatusMaxConcurrency.
+}
+
+// AppStatusDescriber retrieves the status of every service deployed in an application.
+type AppStatusDescriber struct {
+	app            string
+	configStore    AppStatusConfigStore
+	deployStore    DeployedEnvServicesLister
+	maxConcurrency int
+
+	newStatusDescriber func(svc *config.Workload, env string) (ServiceStatusDescriber, error)
+}
+
+// AppStatus contains the status of every service in every environment it is deployed to.
+type AppStatus struct {
+	App          string              `json:"application"`
+	Services     []string            `json:"services"`
+	Environments []string            `json:"environments"`
+	Statuses     []*ServiceEnvStatus `json:"statuses"`
+}
+
+// ServiceEnvStatus is a summary of the status of a service deployed in an environment.
+type ServiceEnvStatus struct {
+	Service          string      `json:"service"`
+	Environment      string      `json:"environment"`
+	Type             string      `json:"type"`
+	Status           string      `json:"status,omitempty"`
+	Tasks            *TaskCounts `json:"tasks,omitempty"` // Tasks is nil for services that are not deployed on ECS.
+	Alarms           int         `json:"alarms"`
+	AlarmsInAlarm    int         `json:"alarmsInAlarm"`
+	LastDeploymentAt time.Time   `json:"lastDeploymentAt"`
+	Error            string      `json:"error,omitempty"`
+}
+
+// TaskCounts holds the number of running and desired tasks of an ECS service.
+type TaskCounts struct {
+	Running int64 `json:"running"`
+	Desired int64 `json:"desired"`
+}
+
+// healthSummarizer is implemented by service statuses that can be summarized in an application status.
+type healthSummarizer interface {
+	summary() ServiceEnvStatus
+}
+
+func (s *ecsServiceStatus) summary() ServiceEnvStatus {
+	out := ServiceEnvStatus{
+		Status: s.Service.Status,
+		Tasks: &TaskCounts{
+			Running: s.Service.RunningCount,
+			Desired: s.Service.DesiredCount,
+		},
+		Alarms:           len(s.Alarms),
+		LastDeploymentAt: s.Service.LastDeploymentAt,
+	}
+	for _, alarm := range s.Alarms {
+		if alarm.Status == alarmStateAlarm {
+			out.AlarmsInAlarm++
+		}
+	}
+	return out
+}
+
+func (a *appRunnerServiceStatus) summary() ServiceEnvStatus {
+	return ServiceEnvStatus{
+		Status:           a.Service.Status,
+		LastDeploymentAt: a.Service.DateUpdated,
+	}
+}
+
+// NewAppStatusDescriber instantiates a new AppStatusDescriber struct.
+func NewAppStatusDescriber(opt *NewAppStatusConfig) *AppStatusDescriber {
+	maxConcurrency := opt.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = DefaultAppStatusMaxConcurrency
+	}
+	return &AppStatusDescriber{
+		app:            opt.App,
+		configStore:    opt.ConfigStore,
+		deployStore:    opt.DeployStore,
+		maxConcurrency: maxConcurrency,
+		newStatusDescriber: func(svc *config.Workload, env string) (ServiceStatusDescriber, error) {
+			cfg := &NewServiceStatusConfig{
+				App:         opt.App,
+				Env:         env,
+				Svc:         svc.Name,
+				ConfigStore: opt.ConfigStore,
+			}
+			if svc.Type == manifest.RequestDrivenWebServiceType {
+				return NewAppRunnerStatusDescriber(cfg)
+			}
+			return NewECSStatusDescriber(cfg)
+		},
+	}
+}
+
+// Describe returns the status of every service deployed in the application.
+// Failing to describe a service in an environment doesn't fail the whole description,
+// instead the error is reported in the status of the service in that environment.
+func (d *AppStatusDescriber) Describe() (*AppStatus, error) {
+	wklds, err := d.configStore.ListWorkloads(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads in application %s: %w", d.app, err)
+	}
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", d.app, err)
+	}
+	status := &AppStatus{
+		App: d.app,
+	}
+	for _, env := range envs {
+		status.Environments = append(status.Environments, env.Name)
+	}
+
+	type target struct {
+		svc *config.Workload
+		env string
+	}
+	var targets []target
+	for _, wkld := range wklds {
+		if !isService(wkld.Type) || wkld.Type == manifest.StaticSiteType {
+			// Static sites don't run any tasks, so there is no health to report.
+			continue
+		}
+		status.Services = append(status.Services, wkld.Name)
+		deployedEnvs, err := d.deployStore.ListEnvironmentsDeployedTo(d.app, wkld.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list environments that service %s is deployed to: %w", wkld.Name, err)
+		}
+		for _, env := range deployedEnvs {
+			targets = append(targets, target{svc: wkld, env: env})
+		}
+	}
+
+	// Describe the services with a bounded number of workers so that we don't exceed API rate limits.
+	statuses := make([]*ServiceEnvStatus, len(targets))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.maxConcurrency && w < len(targets); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				statuses[i] = d.describeServiceInEnv(targets[i].svc, targets[i].env)
+			}
+		}()
+	}
+	for i := range targets {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Service == statuses[j].Service {
+			return statuses[i].Environment < statuses[j].Environment
+		}
+		return statuses[i].Service < statuses[j].Service
+	})
+	sort.Strings(status.Services)
+	status.Statuses = statuses
+	return status, nil
+}
+
+func (d *AppStatusDescriber) describeServiceInEnv(svc *config.Workload, env string) *ServiceEnvStatus {
+	out := &ServiceEnvStatus{
+		Service:     svc.Name,
+		Environment: env,
+		Type:        svc.Type,
+	}
+	describer, err := d.newStatusDescriber(svc, env)
+	if err != nil {
+		out.Error = fmt.Sprintf("create status describer: %v", err)
+		return out
+	}
+	svcStatus, err := describer.Describe()
+	if err != nil {
+		out.Error = err.Error()
+		return out
+	}
+	summarizer, ok := svcStatus.(healthSummarizer)
+	if !ok {
+		return out
+	}
+	summary := summarizer.summary()
+	summary.Service, summary.Environment, summary.Type = out.Service, out.Environment, out.Type
+	return &summary
+}
+
+func isService(wkldType string) bool {
+	for _, svcType := range manifest.ServiceTypes() {
+		if wkldType == svcType {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified AppStatus struct with json format.
+func (s *AppStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal application status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppStatus struct as a matrix of services by environments.
+// Each cell shows the running and desired number of tasks, the state of the alarms and the time of the last deployment.
+func (s *AppStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprintf("Services in application %s\n\n", s.App))
+	writer.Flush()
+	if len(s.Services) == 0 {
+		fmt.Fprintln(writer, "  No services found.")
+		writer.Flush()
+		return b.String()
+	}
+
+	byKey := make(map[string]*ServiceEnvStatus, len(s.Statuses))
+	for _, status := range s.Statuses {
+		byKey[status.Service+"/"+status.Environment] = status
+	}
+	headers := append([]string{"Name"}, s.Environments...)
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	var errs []*ServiceEnvStatus
+	for _, svc := range s.Services {
+		row := []string{svc}
+		for _, env := range s.Environments {
+			status, ok := byKey[svc+"/"+env]
+			if !ok {
+				row = append(row, notDeployedRep)
+				continue
+			}
+			if status.Error != "" {
+				errs = append(errs, status)
+			}
+			row = append(row, status.cell())
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+
+	if len(errs) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nErrors\n\n"))
+		writer.Flush()
+		for _, status := range errs {
+			fmt.Fprintf(writer, "  %s (%s): %s\n", status.Service, status.Environment, status.Error)
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
+
+func (s *ServiceEnvStatus) cell() string {
+	if s.Error != "" {
+		return "error"
+	}
+	var parts []string
+	if s.Tasks != nil {
+		parts = append(parts, fmt.Sprintf("%d/%d running", s.Tasks.Running, s.Tasks.Desired))
+	} else if s.Status != "" {
+		parts = append(parts, s.Status)
+	}
+	switch {
+	case s.AlarmsInAlarm > 0:
+		parts = append(parts, fmt.Sprintf("%d %s", s.AlarmsInAlarm, alarmStateAlarm))
+	case s.Alarms > 0:
+		parts = append(parts, alarmStateOK)
+	}
+	if !s.LastDeploymentAt.IsZero() {
+		parts = append(parts, fmt.Sprintf("deployed %s", humanizeTime(s.LastDeploymentAt)))
+	}
+	return strings.Join(parts, ", ")
+}
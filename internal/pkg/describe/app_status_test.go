@@ -0,0 +1,252 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type stubServiceStatusDescriber struct {
+	status HumanJSONStringer
+	err    error
+}
+
+func (s stubServiceStatusDescriber) Describe() (HumanJSONStringer, error) {
+	return s.status, s.err
+}
+
+type appStatusDescriberMocks struct {
+	configStore *mocks.MockAppStatusConfigStore
+	deployStore *mocks.MockDeployedEnvServicesLister
+}
+
+func TestAppStatusDescriber_Describe(t *testing.T) {
+	deployedAt := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks    func(m appStatusDescriberMocks)
+		svcDescribers map[string]ServiceStatusDescriber
+		wantedStatus  *AppStatus
+		wantedErr     error
+	}{
+		"return wrapped error if fail to list workloads": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.configStore.EXPECT().ListWorkloads("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list workloads in application phonetool: some error"),
+		},
+		"return wrapped error if fail to list the environments a service is deployed to": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.configStore.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{Name: "api", Type: manifest.LoadBalancedWebServiceType},
+				}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("list environments that service api is deployed to: some error"),
+		},
+		"summarizes the status of every deployed service and skips jobs": {
+			setupMocks: func(m appStatusDescriberMocks) {
+				m.configStore.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{Name: "web", Type: manifest.RequestDrivenWebServiceType},
+					{Name: "api", Type: manifest.LoadBalancedWebServiceType},
+					{Name: "report", Type: manifest.ScheduledJobType},
+				}, nil)
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "web").Return([]string{"test"}, nil)
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test", "prod"}, nil)
+			},
+			svcDescribers: map[string]ServiceStatusDescriber{
+				"api/test": stubServiceStatusDescriber{
+					status: &ecsServiceStatus{
+						Service: awsecs.ServiceStatus{
+							Status:           "ACTIVE",
+							RunningCount:     1,
+							DesiredCount:     2,
+							LastDeploymentAt: deployedAt,
+						},
+						Alarms: []cloudwatch.AlarmStatus{
+							{Name: "cpu", Status: "ALARM"},
+							{Name: "memory", Status: "OK"},
+						},
+					},
+				},
+				"api/prod": stubServiceStatusDescriber{
+					err: errors.New("some error"),
+				},
+				"web/test": stubServiceStatusDescriber{
+					status: &appRunnerServiceStatus{
+						Service: apprunner.Service{
+							Status:      "RUNNING",
+							DateUpdated: deployedAt,
+						},
+					},
+				},
+			},
+			wantedStatus: &AppStatus{
+				App:          "phonetool",
+				Services:     []string{"api", "web"},
+				Environments: []string{"test", "prod"},
+				Statuses: []*ServiceEnvStatus{
+					{
+						Service:     "api",
+						Environment: "prod",
+						Type:        manifest.LoadBalancedWebServiceType,
+						Error:       "some error",
+					},
+					{
+						Service:     "api",
+						Environment: "test",
+						Type:        manifest.LoadBalancedWebServiceType,
+						Status:      "ACTIVE",
+						Tasks: &TaskCounts{
+							Running: 1,
+							Desired: 2,
+						},
+						Alarms:           2,
+						AlarmsInAlarm:    1,
+						LastDeploymentAt: deployedAt,
+					},
+					{
+						Service:          "web",
+						Environment:      "test",
+						Type:             manifest.RequestDrivenWebServiceType,
+						Status:           "RUNNING",
+						LastDeploymentAt: deployedAt,
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appStatusDescriberMocks{
+				configStore: mocks.NewMockAppStatusConfigStore(ctrl),
+				deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &AppStatusDescriber{
+				app:            "phonetool",
+				configStore:    m.configStore,
+				deployStore:    m.deployStore,
+				maxConcurrency: 2,
+				newStatusDescriber: func(svc *config.Workload, env string) (ServiceStatusDescriber, error) {
+					return tc.svcDescribers[svc.Name+"/"+env], nil
+				},
+			}
+
+			// WHEN
+			status, err := d.Describe()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, status)
+		})
+	}
+}
+
+func TestAppStatus_HumanString(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		return "2 hours ago"
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	deployedAt := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		status      *AppStatus
+		wantedHuman string
+		wantedJSON  string
+	}{
+		"no services": {
+			status: &AppStatus{
+				App:          "phonetool",
+				Environments: []string{"test"},
+			},
+			wantedHuman: `Services in application phonetool
+
+  No services found.
+`,
+			wantedJSON: `{"application":"phonetool","services":null,"environments":["test"],"statuses":null}
+`,
+		},
+		"matrix of services by environments": {
+			status: &AppStatus{
+				App:          "phonetool",
+				Services:     []string{"api", "web"},
+				Environments: []string{"test", "prod"},
+				Statuses: []*ServiceEnvStatus{
+					{
+						Service:     "api",
+						Environment: "prod",
+						Type:        manifest.LoadBalancedWebServiceType,
+						Error:       "some error",
+					},
+					{
+						Service:     "api",
+						Environment: "test",
+						Type:        manifest.LoadBalancedWebServiceType,
+						Status:      "ACTIVE",
+						Tasks: &TaskCounts{
+							Running: 1,
+							Desired: 2,
+						},
+						Alarms:           2,
+						AlarmsInAlarm:    1,
+						LastDeploymentAt: deployedAt,
+					},
+					{
+						Service:          "web",
+						Environment:      "test",
+						Type:             manifest.RequestDrivenWebServiceType,
+						Status:           "RUNNING",
+						LastDeploymentAt: deployedAt,
+					},
+				},
+			},
+			wantedHuman: `Services in application phonetool
+
+  Name    test                                        prod
+  ----    ----                                        ----
+  api     1/2 running, 1 ALARM, deployed 2 hours ago  error
+  web     RUNNING, deployed 2 hours ago               -
+
+Errors
+
+  api (prod): some error
+`,
+			wantedJSON: `{"application":"phonetool","services":["api","web"],"environments":["test","prod"],"statuses":[{"service":"api","environment":"prod","type":"Load Balanced Web Service","alarms":0,"alarmsInAlarm":0,"lastDeploymentAt":"0001-01-01T00:00:00Z","error":"some error"},{"service":"api","environment":"test","type":"Load Balanced Web Service","status":"ACTIVE","tasks":{"running":1,"desired":2},"alarms":2,"alarmsInAlarm":1,"lastDeploymentAt":"2022-09-01T12:00:00Z"},{"service":"web","environment":"test","type":"Request-Driven Web Service","status":"RUNNING","alarms":0,"alarmsInAlarm":0,"lastDeploymentAt":"2022-09-01T12:00:00Z"}]}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			human := tc.status.HumanString()
+			json, err := tc.status.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHuman, human)
+			require.Equal(t, tc.wantedJSON, json)
+		})
+	}
+}
@@ -112,6 +112,119 @@ func (mr *MockConfigStoreSvcMockRecorder) ListServices(appName interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockConfigStoreSvc)(nil).ListServices), appName)
 }
 
+// MockAppStatusConfigStore is a mock of AppStatusConfigStore interface.
+type MockAppStatusConfigStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppStatusConfigStoreMockRecorder
+}
+
+// MockAppStatusConfigStoreMockRecorder is the mock recorder for MockAppStatusConfigStore.
+type MockAppStatusConfigStoreMockRecorder struct {
+	mock *MockAppStatusConfigStore
+}
+
+// NewMockAppStatusConfigStore creates a new mock instance.
+func NewMockAppStatusConfigStore(ctrl *gomock.Controller) *MockAppStatusConfigStore {
+	mock := &MockAppStatusConfigStore{ctrl: ctrl}
+	mock.recorder = &MockAppStatusConfigStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppStatusConfigStore) EXPECT() *MockAppStatusConfigStoreMockRecorder {
+	return m.recorder
+}
+
+// GetEnvironment mocks base method.
+func (m *MockAppStatusConfigStore) GetEnvironment(appName, environmentName string) (*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnvironment", appName, environmentName)
+	ret0, _ := ret[0].(*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnvironment indicates an expected call of GetEnvironment.
+func (mr *MockAppStatusConfigStoreMockRecorder) GetEnvironment(appName, environmentName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironment", reflect.TypeOf((*MockAppStatusConfigStore)(nil).GetEnvironment), appName, environmentName)
+}
+
+// GetWorkload mocks base method.
+func (m *MockAppStatusConfigStore) GetWorkload(appName, name string) (*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkload", appName, name)
+	ret0, _ := ret[0].(*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkload indicates an expected call of GetWorkload.
+func (mr *MockAppStatusConfigStoreMockRecorder) GetWorkload(appName, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkload", reflect.TypeOf((*MockAppStatusConfigStore)(nil).GetWorkload), appName, name)
+}
+
+// ListEnvironments mocks base method.
+func (m *MockAppStatusConfigStore) ListEnvironments(appName string) ([]*config.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments", appName)
+	ret0, _ := ret[0].([]*config.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockAppStatusConfigStoreMockRecorder) ListEnvironments(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockAppStatusConfigStore)(nil).ListEnvironments), appName)
+}
+
+// ListJobs mocks base method.
+func (m *MockAppStatusConfigStore) ListJobs(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockAppStatusConfigStoreMockRecorder) ListJobs(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockAppStatusConfigStore)(nil).ListJobs), appName)
+}
+
+// ListServices mocks base method.
+func (m *MockAppStatusConfigStore) ListServices(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockAppStatusConfigStoreMockRecorder) ListServices(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockAppStatusConfigStore)(nil).ListServices), appName)
+}
+
+// ListWorkloads mocks base method.
+func (m *MockAppStatusConfigStore) ListWorkloads(appName string) ([]*config.Workload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads", appName)
+	ret0, _ := ret[0].([]*config.Workload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockAppStatusConfigStoreMockRecorder) ListWorkloads(appName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockAppStatusConfigStore)(nil).ListWorkloads), appName)
+}
+
 // MockDeployedEnvServicesLister is a mock of DeployedEnvServicesLister interface.
 type MockDeployedEnvServicesLister struct {
 	ctrl     *gomock.Controller
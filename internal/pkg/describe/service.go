@@ -39,6 +39,12 @@ type ConfigStoreSvc interface {
 	ListJobs(appName string) ([]*config.Workload, error)
 }
 
+// AppStatusConfigStore lists and retrieves the workloads and environments of an application.
+type AppStatusConfigStore interface {
+	ConfigStoreSvc
+	ListWorkloads(appName string) ([]*config.Workload, error)
+}
+
 // DeployedEnvServicesLister wraps methods of deploy store.
 type DeployedEnvServicesLister interface {
 	ListEnvironmentsDeployedTo(appName string, svcName string) ([]string, error)
@@ -112,6 +118,22 @@ func newServiceStackDescriber(opt NewServiceConfig, env string) (*serviceStackDe
 	}, nil
 }
 
+// WorkloadStackDescriber retrieves information about the stack of a workload deployed in an environment.
+type WorkloadStackDescriber struct {
+	*serviceStackDescriber
+}
+
+// NewWorkloadStackDescriber instantiates a describer for the stack of a workload deployed in an environment.
+func NewWorkloadStackDescriber(opt NewServiceConfig, env string) (*WorkloadStackDescriber, error) {
+	d, err := newServiceStackDescriber(opt, env)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkloadStackDescriber{
+		serviceStackDescriber: d,
+	}, nil
+}
+
 // Params returns the parameters of the service stack.
 func (d *serviceStackDescriber) Params() (map[string]string, error) {
 	if d.params != nil {
@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// StaticSiteDescriber retrieves information about a static site service.
+type StaticSiteDescriber struct {
+	app             string
+	svc             string
+	enableResources bool
+
+	store                  DeployedEnvServicesLister
+	initWkldStackDescriber func(string) (workloadStackDescriber, error)
+	wkldStackDescribers    map[string]workloadStackDescriber
+}
+
+// NewStaticSiteDescriber instantiates a static site service describer.
+func NewStaticSiteDescriber(opt NewServiceConfig) (*StaticSiteDescriber, error) {
+	describer := &StaticSiteDescriber{
+		app:             opt.App,
+		svc:             opt.Svc,
+		enableResources: opt.EnableResources,
+		store:           opt.DeployStore,
+
+		wkldStackDescribers: make(map[string]workloadStackDescriber),
+	}
+	describer.initWkldStackDescriber = func(env string) (workloadStackDescriber, error) {
+		if describer, ok := describer.wkldStackDescribers[env]; ok {
+			return describer, nil
+		}
+		d, err := newServiceStackDescriber(NewServiceConfig{
+			App:         opt.App,
+			Svc:         opt.Svc,
+			ConfigStore: opt.ConfigStore,
+		}, env)
+		if err != nil {
+			return nil, err
+		}
+		describer.wkldStackDescribers[env] = d
+		return d, nil
+	}
+	return describer, nil
+}
+
+// URI returns the public URL of the static site in the environment.
+func (d *StaticSiteDescriber) URI(env string) (URI, error) {
+	wkldDescr, err := d.initWkldStackDescriber(env)
+	if err != nil {
+		return URI{}, err
+	}
+	outputs, err := wkldDescr.Outputs()
+	if err != nil {
+		return URI{}, fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+	}
+	return URI{
+		URI:        staticSiteURL(outputs),
+		AccessType: URIAccessTypeInternet,
+	}, nil
+}
+
+// Describe returns info of a static site.
+func (d *StaticSiteDescriber) Describe() (HumanJSONStringer, error) {
+	environments, err := d.store.ListEnvironmentsDeployedTo(d.app, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for application %s: %w", d.app, err)
+	}
+
+	var routes []*WebServiceRoute
+	var buckets []*staticSiteBucket
+	for _, env := range environments {
+		wkldDescr, err := d.initWkldStackDescriber(env)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := wkldDescr.Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for environment %s: %w", env, err)
+		}
+		routes = append(routes, &WebServiceRoute{
+			Environment: env,
+			URL:         staticSiteURL(outputs),
+		})
+		buckets = append(buckets, &staticSiteBucket{
+			Environment: env,
+			Name:        outputs[cfnstack.StaticSiteOutputBucketName],
+		})
+	}
+
+	resources := make(map[string][]*stack.Resource)
+	if d.enableResources {
+		for _, env := range environments {
+			wkldDescr, err := d.initWkldStackDescriber(env)
+			if err != nil {
+				return nil, err
+			}
+			stackResources, err := wkldDescr.ServiceStackResources()
+			if err != nil {
+				return nil, fmt.Errorf("retrieve service resources: %w", err)
+			}
+			resources[env] = stackResources
+		}
+	}
+
+	return &staticSiteDesc{
+		Service:   d.svc,
+		Type:      manifest.StaticSiteType,
+		App:       d.app,
+		Routes:    routes,
+		Buckets:   buckets,
+		Resources: resources,
+
+		environments: environments,
+	}, nil
+}
+
+// Manifest returns the contents of the manifest used to deploy a static site stack.
+// If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
+func (d *StaticSiteDescriber) Manifest(env string) ([]byte, error) {
+	cfn, err := d.initWkldStackDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.Manifest()
+}
+
+// staticSiteURL returns the URL of the site from the outputs of its stack.
+func staticSiteURL(outputs map[string]string) string {
+	if alias, ok := outputs[cfnstack.StaticSiteOutputAlias]; ok && alias != "" {
+		return "https://" + alias
+	}
+	return "https://" + outputs[cfnstack.StaticSiteOutputDistributionDomainName]
+}
+
+type staticSiteBucket struct {
+	Environment string `json:"environment"`
+	Name        string `json:"name"`
+}
+
+// staticSiteDesc contains serialized parameters for a static site.
+type staticSiteDesc struct {
+	Service   string               `json:"service"`
+	Type      string               `json:"type"`
+	App       string               `json:"application"`
+	Routes    []*WebServiceRoute   `json:"routes"`
+	Buckets   []*staticSiteBucket  `json:"buckets"`
+	Resources deployedSvcResources `json:"resources,omitempty"`
+
+	environments []string `json:"-"`
+}
+
+// JSONString returns the stringified staticSiteDesc struct with json format.
+func (s *staticSiteDesc) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal static site description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified staticSiteDesc struct with human readable format.
+func (s *staticSiteDesc) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", s.App)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.Service)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Type", s.Type)
+	fmt.Fprint(writer, color.Bold.Sprint("\nRoutes\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "URL"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, route := range s.Routes {
+		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nBuckets\n\n"))
+	writer.Flush()
+	headers = []string{"Environment", "Name"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, bucket := range s.Buckets {
+		fmt.Fprintf(writer, "  %s\t%s\n", bucket.Environment, bucket.Name)
+	}
+	if len(s.Resources) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nResources\n"))
+		writer.Flush()
+
+		s.Resources.humanStringByEnv(writer, s.environments)
+	}
+	writer.Flush()
+	return b.String()
+}
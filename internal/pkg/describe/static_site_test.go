@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticSiteDescriber_Describe(t *testing.T) {
+	const (
+		testApp = "phonetool"
+		testSvc = "website"
+	)
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		shouldOutputResources bool
+
+		setupMocks func(store *mocks.MockDeployedEnvServicesLister, wkld *mocks.MockworkloadStackDescriber)
+
+		wantedSite  *staticSiteDesc
+		wantedError error
+	}{
+		"return error if fail to list environment": {
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, wkld *mocks.MockworkloadStackDescriber) {
+				store.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("list deployed environments for application phonetool: some error"),
+		},
+		"return error if fail to retrieve stack outputs": {
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, wkld *mocks.MockworkloadStackDescriber) {
+				store.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{"test"}, nil)
+				wkld.EXPECT().Outputs().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("get stack outputs for environment test: some error"),
+		},
+		"return error if fail to retrieve stack resources": {
+			shouldOutputResources: true,
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, wkld *mocks.MockworkloadStackDescriber) {
+				store.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{"test"}, nil)
+				wkld.EXPECT().Outputs().Return(map[string]string{}, nil)
+				wkld.EXPECT().ServiceStackResources().Return(nil, mockErr)
+			},
+			wantedError: fmt.Errorf("retrieve service resources: some error"),
+		},
+		"success": {
+			shouldOutputResources: true,
+			setupMocks: func(store *mocks.MockDeployedEnvServicesLister, wkld *mocks.MockworkloadStackDescriber) {
+				store.EXPECT().ListEnvironmentsDeployedTo(testApp, testSvc).Return([]string{"test", "prod"}, nil)
+				gomock.InOrder(
+					wkld.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.StaticSiteOutputBucketName:             "test-bucket",
+						cfnstack.StaticSiteOutputDistributionDomainName: "d111111abcdef8.cloudfront.net",
+					}, nil),
+					wkld.EXPECT().Outputs().Return(map[string]string{
+						cfnstack.StaticSiteOutputBucketName:             "prod-bucket",
+						cfnstack.StaticSiteOutputDistributionDomainName: "d222222abcdef8.cloudfront.net",
+						cfnstack.StaticSiteOutputAlias:                  "www.example.com",
+					}, nil),
+					wkld.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::S3::Bucket",
+							PhysicalID: "test-bucket",
+						},
+					}, nil),
+					wkld.EXPECT().ServiceStackResources().Return([]*stack.Resource{
+						{
+							Type:       "AWS::S3::Bucket",
+							PhysicalID: "prod-bucket",
+						},
+					}, nil),
+				)
+			},
+			wantedSite: &staticSiteDesc{
+				Service: testSvc,
+				Type:    "Static Site",
+				App:     testApp,
+				Routes: []*WebServiceRoute{
+					{
+						Environment: "test",
+						URL:         "https://d111111abcdef8.cloudfront.net",
+					},
+					{
+						Environment: "prod",
+						URL:         "https://www.example.com",
+					},
+				},
+				Buckets: []*staticSiteBucket{
+					{
+						Environment: "test",
+						Name:        "test-bucket",
+					},
+					{
+						Environment: "prod",
+						Name:        "prod-bucket",
+					},
+				},
+				Resources: map[string][]*stack.Resource{
+					"test": {
+						{
+							Type:       "AWS::S3::Bucket",
+							PhysicalID: "test-bucket",
+						},
+					},
+					"prod": {
+						{
+							Type:       "AWS::S3::Bucket",
+							PhysicalID: "prod-bucket",
+						},
+					},
+				},
+				environments: []string{"test", "prod"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockDeployedEnvServicesLister(ctrl)
+			mockWkldDescriber := mocks.NewMockworkloadStackDescriber(ctrl)
+			tc.setupMocks(mockStore, mockWkldDescriber)
+
+			d := &StaticSiteDescriber{
+				app:             testApp,
+				svc:             testSvc,
+				enableResources: tc.shouldOutputResources,
+				store:           mockStore,
+				initWkldStackDescriber: func(string) (workloadStackDescriber, error) {
+					return mockWkldDescriber, nil
+				},
+			}
+
+			// WHEN
+			site, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSite, site)
+			}
+		})
+	}
+}
+
+func TestStaticSiteDesc_String(t *testing.T) {
+	// GIVEN
+	site := &staticSiteDesc{
+		Service: "website",
+		Type:    "Static Site",
+		App:     "phonetool",
+		Routes: []*WebServiceRoute{
+			{
+				Environment: "test",
+				URL:         "https://d111111abcdef8.cloudfront.net",
+			},
+		},
+		Buckets: []*staticSiteBucket{
+			{
+				Environment: "test",
+				Name:        "test-bucket",
+			},
+		},
+		Resources: map[string][]*stack.Resource{
+			"test": {
+				{
+					Type:       "AWS::S3::Bucket",
+					PhysicalID: "test-bucket",
+				},
+			},
+		},
+		environments: []string{"test"},
+	}
+	wantedHumanString := `About
+
+  Application  phonetool
+  Name         website
+  Type         Static Site
+
+Routes
+
+  Environment  URL
+  -----------  ---
+  test         https://d111111abcdef8.cloudfront.net
+
+Buckets
+
+  Environment  Name
+  -----------  ----
+  test         test-bucket
+
+Resources
+
+  test
+    AWS::S3::Bucket  test-bucket
+`
+	wantedJSONString := "{\"service\":\"website\",\"type\":\"Static Site\",\"application\":\"phonetool\",\"routes\":[{\"environment\":\"test\",\"url\":\"https://d111111abcdef8.cloudfront.net\"}],\"buckets\":[{\"environment\":\"test\",\"name\":\"test-bucket\"}],\"resources\":{\"test\":[{\"type\":\"AWS::S3::Bucket\",\"physicalID\":\"test-bucket\"}]}}\n"
+
+	// WHEN
+	human := site.HumanString()
+	json, err := site.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, wantedHumanString, human)
+	require.Equal(t, wantedJSONString, json)
+}
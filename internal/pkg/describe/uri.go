@@ -54,6 +54,8 @@ func NewReachableService(app, svc string, store ConfigStoreSvc) (ReachableServic
 		return NewRDWebServiceDescriber(in)
 	case manifest.BackendServiceType:
 		return NewBackendServiceDescriber(in)
+	case manifest.StaticSiteType:
+		return NewStaticSiteDescriber(in)
 	default:
 		return nil, fmt.Errorf("service %s is of type %s which cannot be reached over the network", svc, cfg.Type)
 	}
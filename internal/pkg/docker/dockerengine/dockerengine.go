@@ -8,12 +8,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -225,6 +228,195 @@ func (c CmdClient) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+// HealthCheck holds the configuration of the command that Docker runs to determine if a container is healthy.
+type HealthCheck struct {
+	Command     []string      // Required. The health check command in the ECS format, such as ["CMD-SHELL", "curl -f http://localhost/"].
+	Interval    time.Duration // Optional. Time between running the check.
+	Timeout     time.Duration // Optional. Maximum time to allow one check to run.
+	StartPeriod time.Duration // Optional. Start period for the container to initialize before counting retries towards unstable.
+	Retries     int           // Optional. Consecutive failures needed to report unhealthy.
+}
+
+// RunOptions holds the options to start a container in the background.
+type RunOptions struct {
+	ImageURI      string            // Required. The image to start the container from.
+	ContainerName string            // Required. The name of the container.
+	Network       string            // Optional. The network to connect the container to.
+	NetworkAlias  string            // Optional. The hostname of the container in the network.
+	EnvVars       map[string]string // Optional. Environment variables to set in the container.
+	Secrets       map[string]string // Optional. Sensitive environment variables, kept out of the command line arguments.
+	EnvFile       string            // Optional. Path to a file of environment variables to set in the container.
+	EntryPoint    []string          // Optional. Overrides the default entrypoint of the image.
+	Command       []string          // Optional. Overrides the default command of the image.
+	Ports         []string          // Optional. Container ports, such as "80" or "80/tcp", to publish on the same port of the host.
+	HealthCheck   *HealthCheck      // Optional. Overrides the health check of the image.
+}
+
+// Container statuses and health statuses reported by `docker inspect`.
+const (
+	ContainerStatusRunning = "running"
+	ContainerStatusExited  = "exited"
+	ContainerStatusDead    = "dead"
+
+	ContainerHealthHealthy   = "healthy"
+	ContainerHealthUnhealthy = "unhealthy"
+)
+
+// ContainerState represents the state of a container.
+type ContainerState struct {
+	Status   string           `json:"Status"`
+	ExitCode int              `json:"ExitCode"`
+	Health   *ContainerHealth `json:"Health,omitempty"`
+}
+
+// ContainerHealth represents the result of the health checks of a container.
+type ContainerHealth struct {
+	Status string `json:"Status"`
+}
+
+// HealthStatus returns the health status of the container, or an empty string if the container has no health check.
+func (s *ContainerState) HealthStatus() string {
+	if s.Health == nil {
+		return ""
+	}
+	return s.Health.Status
+}
+
+// IsStopped returns true if the container is no longer running.
+func (s *ContainerState) IsStopped() bool {
+	return s.Status == ContainerStatusExited || s.Status == ContainerStatusDead
+}
+
+// CreateNetwork will run a `docker network create` command to create a bridge network.
+func (c CmdClient) CreateNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "create", name}, exec.Stdout(io.Discard)); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveNetwork will run a `docker network rm` command to remove the network.
+func (c CmdClient) RemoveNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "rm", name}, exec.Stdout(io.Discard)); err != nil {
+		return fmt.Errorf("remove network %s: %w", name, err)
+	}
+	return nil
+}
+
+// Run will run a `docker run` command to start a container in the background.
+func (c CmdClient) Run(in *RunOptions) error {
+	args := []string{"run", "--detach", "--name", in.ContainerName}
+	if in.Network != "" {
+		args = append(args, "--network", in.Network)
+	}
+	if in.NetworkAlias != "" {
+		args = append(args, "--network-alias", in.NetworkAlias)
+	}
+	for _, port := range in.Ports {
+		hostPort := strings.Split(port, "/")[0]
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, port))
+	}
+	if in.EnvFile != "" {
+		args = append(args, "--env-file", in.EnvFile)
+	}
+	// Sort the keys for test stability.
+	for _, k := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+	// Secrets are passed without a value so that Docker reads them from the environment of the command.
+	var secrets []string
+	for _, k := range sortedKeys(in.Secrets) {
+		args = append(args, "--env", k)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", k, in.Secrets[k]))
+	}
+	args = append(args, in.HealthCheck.args()...)
+	command := in.Command
+	if len(in.EntryPoint) > 0 {
+		// The "--entrypoint" flag only accepts the executable, its arguments are prepended to the command instead.
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		command = append(append([]string{}, in.EntryPoint[1:]...), in.Command...)
+	}
+	args = append(args, in.ImageURI)
+	args = append(args, command...)
+
+	opts := []exec.CmdOption{exec.Stdout(io.Discard)}
+	if len(secrets) > 0 {
+		opts = append(opts, exec.Env(secrets...))
+	}
+	if err := c.runner.Run("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ContainerName, err)
+	}
+	return nil
+}
+
+func (hc *HealthCheck) args() []string {
+	if hc == nil || len(hc.Command) == 0 {
+		return nil
+	}
+	var cmd string
+	switch hc.Command[0] {
+	case "NONE":
+		return []string{"--no-healthcheck"}
+	case "CMD", "CMD-SHELL":
+		cmd = strings.Join(hc.Command[1:], " ")
+	default:
+		cmd = strings.Join(hc.Command, " ")
+	}
+	args := []string{"--health-cmd", cmd}
+	if hc.Interval != 0 {
+		args = append(args, "--health-interval", hc.Interval.String())
+	}
+	if hc.Timeout != 0 {
+		args = append(args, "--health-timeout", hc.Timeout.String())
+	}
+	if hc.StartPeriod != 0 {
+		args = append(args, "--health-start-period", hc.StartPeriod.String())
+	}
+	if hc.Retries != 0 {
+		args = append(args, "--health-retries", strconv.Itoa(hc.Retries))
+	}
+	return args
+}
+
+// ContainerState will run a `docker inspect` command to retrieve the state of the container.
+func (c CmdClient) ContainerState(name string) (*ContainerState, error) {
+	buf := &bytes.Buffer{}
+	if err := c.runner.Run("docker", []string{"inspect", "--format", "'{{json .State}}'", name}, exec.Stdout(buf)); err != nil {
+		return nil, fmt.Errorf("inspect container %s: %w", name, err)
+	}
+	out := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(buf.String()), "'"), "'")
+	var state ContainerState
+	if err := json.Unmarshal([]byte(out), &state); err != nil {
+		return nil, fmt.Errorf("unmarshal state of container %s: %w", name, err)
+	}
+	return &state, nil
+}
+
+// Logs will run a `docker logs --follow` command that writes the logs of the container to w until the container stops.
+func (c CmdClient) Logs(name string, w io.Writer) error {
+	if err := c.runner.Run("docker", []string{"logs", "--follow", name}, exec.Stdout(w), exec.Stderr(w)); err != nil {
+		return fmt.Errorf("follow logs of container %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveContainer will run a `docker rm --force` command to stop and remove the container.
+func (c CmdClient) RemoveContainer(name string) error {
+	if err := c.runner.Run("docker", []string{"rm", "--force", name}, exec.Stdout(io.Discard)); err != nil {
+		return fmt.Errorf("remove container %s: %w", name, err)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
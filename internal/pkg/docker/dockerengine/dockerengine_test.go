@@ -10,6 +10,7 @@ import (
 	osexec "os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -577,3 +578,172 @@ func TestIsEcrCredentialHelperEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCommand_Run(t *testing.T) {
+	mockError := errors.New("some error")
+	var mockCmd *MockCmd
+
+	tests := map[string]struct {
+		in         *RunOptions
+		setupMocks func(controller *gomock.Controller)
+
+		wantedErr error
+	}{
+		"error running the container": {
+			in: &RunOptions{
+				ImageURI:      "nginx",
+				ContainerName: "nginx",
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--detach", "--name", "nginx", "nginx"}, gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("run container nginx: some error"),
+		},
+		"success with all the options": {
+			in: &RunOptions{
+				ImageURI:      "copilot-local/api",
+				ContainerName: "api",
+				Network:       "demo-api",
+				NetworkAlias:  "api",
+				EnvVars: map[string]string{
+					"LOG_LEVEL": "info",
+					"APP":       "demo",
+				},
+				Secrets: map[string]string{
+					"DB_PASSWORD": "hunter2",
+				},
+				EnvFile:    "/ws/api.env",
+				EntryPoint: []string{"/bin/sh", "-c"},
+				Command:    []string{"./start.sh"},
+				Ports:      []string{"8080", "9090/udp"},
+				HealthCheck: &HealthCheck{
+					Command:  []string{"CMD-SHELL", "curl -f http://localhost:8080/ || exit 1"},
+					Interval: 10 * time.Second,
+					Retries:  2,
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--detach", "--name", "api",
+					"--network", "demo-api",
+					"--network-alias", "api",
+					"--publish", "8080:8080",
+					"--publish", "9090:9090/udp",
+					"--env-file", "/ws/api.env",
+					"--env", "APP=demo",
+					"--env", "LOG_LEVEL=info",
+					"--env", "DB_PASSWORD",
+					"--health-cmd", "curl -f http://localhost:8080/ || exit 1",
+					"--health-interval", "10s",
+					"--health-retries", "2",
+					"--entrypoint", "/bin/sh",
+					"copilot-local/api",
+					"-c", "./start.sh"}, gomock.Any(), gomock.Any()).
+					Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						require.Contains(t, cmd.Env, "DB_PASSWORD=hunter2")
+					}).Return(nil)
+			},
+		},
+		"disables the health check of the image": {
+			in: &RunOptions{
+				ImageURI:      "nginx",
+				ContainerName: "nginx",
+				HealthCheck: &HealthCheck{
+					Command: []string{"NONE"},
+				},
+			},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"run", "--detach", "--name", "nginx", "--no-healthcheck", "nginx"}, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			tc.setupMocks(controller)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.Run(tc.in)
+			if tc.wantedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			}
+		})
+	}
+}
+
+func TestDockerCommand_ContainerState(t *testing.T) {
+	mockError := errors.New("some error")
+	var mockCmd *MockCmd
+
+	tests := map[string]struct {
+		setupMocks func(controller *gomock.Controller)
+
+		wantedStatus string
+		wantedHealth string
+		wantedErr    error
+	}{
+		"error running docker inspect": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json .State}}'", "api"}, gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("inspect container api: some error"),
+		},
+		"returns the health status of the container": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json .State}}'", "api"}, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`'{"Status":"running","Running":true,"ExitCode":0,"Health":{"Status":"healthy","FailingStreak":0}}'
+`))
+					}).Return(nil)
+			},
+			wantedStatus: ContainerStatusRunning,
+			wantedHealth: ContainerHealthHealthy,
+		},
+		"returns an empty health status if the container has no health check": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"inspect", "--format", "'{{json .State}}'", "api"}, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`'{"Status":"exited","Running":false,"ExitCode":1}'`))
+					}).Return(nil)
+			},
+			wantedStatus: ContainerStatusExited,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			tc.setupMocks(controller)
+			s := CmdClient{
+				runner: mockCmd,
+			}
+
+			state, err := s.ContainerState("api")
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStatus, state.Status)
+			require.Equal(t, tc.wantedHealth, state.HealthStatus())
+		})
+	}
+}
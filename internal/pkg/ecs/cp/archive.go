@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cp
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// create writes a tar archive of the file or directory at src, rooted at its base name.
+func create(w io.Writer, src string) error {
+	tw := tar.NewWriter(w)
+	src = filepath.Clean(src)
+	base := filepath.Base(src)
+	if err := filepath.WalkDir(src, func(fpath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(fpath); err != nil {
+				return err
+			}
+		}
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, fpath)
+		if err != nil {
+			return err
+		}
+		hdr.Name = path.Join(base, filepath.ToSlash(rel))
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(fpath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	}); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// extract writes the entries of the tar archive rooted at base to dst.
+// If dst is an existing directory, the entries are written inside it. Otherwise, base is renamed to dst.
+func extract(r io.Reader, base, dst string) error {
+	root := dst
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		root = filepath.Join(dst, base)
+	}
+	symlinks := make(map[string]bool)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive: %w", err)
+		}
+		rel, err := relativeName(hdr.Name, base)
+		if err != nil {
+			return err
+		}
+		if err := checkParents(rel, symlinks); err != nil {
+			return err
+		}
+		target := filepath.Join(root, filepath.FromSlash(rel))
+		mode := fs.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := writeFile(target, tr, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+			symlinks[rel] = true
+		}
+	}
+}
+
+// relativeName returns the name of the entry relative to base, or an error if the entry is outside of base.
+func relativeName(name, base string) (string, error) {
+	name = path.Clean(name)
+	if name == base {
+		return ".", nil
+	}
+	if !strings.HasPrefix(name, base+"/") {
+		return "", fmt.Errorf("archive entry %q is outside of %s", name, base)
+	}
+	return strings.TrimPrefix(name, base+"/"), nil
+}
+
+// checkParents returns an error if the entry would be written through a symbolic link of the archive.
+func checkParents(rel string, symlinks map[string]bool) error {
+	for dir := path.Dir(rel); dir != "."; dir = path.Dir(dir) {
+		if symlinks[dir] {
+			return fmt.Errorf("archive entry %q is inside of symbolic link %q", rel, dir)
+		}
+	}
+	return nil
+}
+
+func writeFile(name string, r io.Reader, mode fs.FileMode) error {
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cp
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testEntry struct {
+	name     string
+	typeflag byte
+	linkname string
+	content  string
+}
+
+func tarOf(t *testing.T, entries []testEntry) *bytes.Buffer {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+	for _, e := range entries {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Linkname: e.linkname,
+			Mode:     0644,
+			Size:     int64(len(e.content)),
+		}))
+		_, err := tw.Write([]byte(e.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf
+}
+
+func TestExtract(t *testing.T) {
+	testCases := map[string]struct {
+		inEntries []testEntry
+
+		wantedFiles map[string]string
+		wantedError error
+	}{
+		"extracts a single file as the destination": {
+			inEntries: []testEntry{
+				{name: "heap.hprof", typeflag: tar.TypeReg, content: "dump"},
+			},
+			wantedFiles: map[string]string{
+				"": "dump",
+			},
+		},
+		"extracts a directory as the destination": {
+			inEntries: []testEntry{
+				{name: "heap.hprof/", typeflag: tar.TypeDir},
+				{name: "heap.hprof/a/b", typeflag: tar.TypeReg, content: "b"},
+			},
+			wantedFiles: map[string]string{
+				"a/b": "b",
+			},
+		},
+		"returns an error if an entry is outside of the copied file": {
+			inEntries: []testEntry{
+				{name: "heap.hprof/../../evil", typeflag: tar.TypeReg, content: "evil"},
+			},
+			wantedError: errors.New(`archive entry "../evil" is outside of heap.hprof`),
+		},
+		"returns an error if an entry is written through a symbolic link": {
+			inEntries: []testEntry{
+				{name: "heap.hprof/", typeflag: tar.TypeDir},
+				{name: "heap.hprof/link", typeflag: tar.TypeSymlink, linkname: "/etc"},
+				{name: "heap.hprof/link/passwd", typeflag: tar.TypeReg, content: "evil"},
+			},
+			wantedError: errors.New(`archive entry "link/passwd" is inside of symbolic link "link"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dst := filepath.Join(t.TempDir(), "out")
+
+			// WHEN
+			err := extract(tarOf(t, tc.inEntries), "heap.hprof", dst)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			for name, content := range tc.wantedFiles {
+				got, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(name)))
+				require.NoError(t, err)
+				require.Equal(t, content, string(got))
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	// GIVEN
+	src := filepath.Join(t.TempDir(), "config")
+	writeTestTree(t, src)
+	buf := new(bytes.Buffer)
+
+	// WHEN
+	err := create(buf, src)
+
+	// THEN
+	require.NoError(t, err)
+	var names []string
+	tr := tar.NewReader(buf)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, hdr.Name)
+	}
+	require.Equal(t, []string{"config", "config/app.conf", "config/logs", "config/logs/heap.hprof"}, names)
+}
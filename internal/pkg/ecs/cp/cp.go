@@ -0,0 +1,398 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cp copies files into and out of running containers with ECS Exec.
+//
+// Files are transferred as a tar archive, encoded in base64 so that it survives the terminal of the exec session.
+// The container must have "sh", "tar", "base64", "sha256sum" and "mktemp" available.
+package cp
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+const (
+	beginMarker    = "COPILOT-CP-BEGIN"
+	endMarker      = "COPILOT-CP-END"
+	okMarker       = "COPILOT-CP-OK"
+	mismatchMarker = "COPILOT-CP-CHECKSUM-MISMATCH"
+
+	// The ssm plugin prints these lines around the output of the session.
+	sessionStartPrefix = "Starting session with SessionId"
+	sessionExitPrefix  = "Exiting session with sessionId"
+
+	endOfTransmission = "\x04" // Ends the input read from the terminal of the session.
+
+	// Each line of base64 decodes to 57 bytes, the same as the output of "base64".
+	base64LineLength    = 76
+	base64LineBytes     = base64LineLength / 4 * 3
+	uploadChunkSize     = base64LineBytes * 1024
+	maxDiagnosticsLines = 3
+)
+
+// Scripts run in the container. Paths are single-quoted by the caller.
+const (
+	fmtDownloadScript = `f=$(mktemp) || exit 1
+if tar -C %[1]s -cf "$f" %[2]s; then
+  echo %[3]s
+  echo "$(wc -c < "$f") $(sha256sum "$f" | cut -d ' ' -f 1)"
+  base64 "$f"
+  echo %[4]s
+fi
+rm -f "$f"
+`
+	fmtUploadScript = `f=$(mktemp) || exit 1
+stty -echo < /dev/tty 2> /dev/null
+base64 -d < /dev/tty > "$f"
+if echo "%[1]s  $f" | sha256sum -c - > /dev/null 2>&1; then
+  if [ -d %[2]s ]; then
+    tar -C %[2]s -xf "$f" && echo %[5]s
+  else
+    d=$(mktemp -d) && tar -C "$d" -xf "$f" && mkdir -p %[3]s && mv "$d"/%[4]s %[2]s && echo %[5]s
+    rm -rf "$d"
+  fi
+else
+  echo %[6]s
+fi
+rm -f "$f"
+`
+	// The script is decoded in the container so that it doesn't need to be escaped in the command.
+	fmtScriptCommand = `/bin/sh -c "echo %s | base64 -d | /bin/sh"`
+)
+
+var errChecksumMismatch = errors.New("checksum of the copied archive does not match")
+
+type commandExecutor interface {
+	ExecuteCommand(in ecs.ExecuteCommandInput) error
+}
+
+// Container identifies a running container of an ECS task.
+type Container struct {
+	Cluster string
+	Task    string
+	Name    string
+}
+
+// ProgressFunc is called with the number of bytes of the archive copied so far, out of its total size.
+type ProgressFunc func(copied, total int64)
+
+// Copier copies files into and out of a running container.
+type Copier struct {
+	exec      commandExecutor
+	container Container
+	progress  ProgressFunc
+}
+
+// New returns a Copier that copies files into and out of the container through ECS Exec.
+// The progress function is optional.
+func New(exec commandExecutor, container Container, progress ProgressFunc) *Copier {
+	if progress == nil {
+		progress = func(copied, total int64) {}
+	}
+	return &Copier{
+		exec:      exec,
+		container: container,
+		progress:  progress,
+	}
+}
+
+// Download copies the file or directory at src in the container to dst on the local file system.
+// If dst is an existing directory, src is copied inside it. Otherwise, src is copied as dst.
+func (c *Copier) Download(src, dst string) error {
+	src = path.Clean(src)
+	archive, err := os.CreateTemp("", "copilot-cp-*.tar")
+	if err != nil {
+		return fmt.Errorf("create temporary archive: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	receiver := newArchiveReceiver(archive, c.progress)
+	if err := c.exec.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   c.container.Cluster,
+		Task:      c.container.Task,
+		Container: c.container.Name,
+		Command:   scriptCommand(fmt.Sprintf(fmtDownloadScript, quote(path.Dir(src)), quote(path.Base(src)), beginMarker, endMarker)),
+		Stdout:    receiver,
+	}); err != nil {
+		return fmt.Errorf("copy %s from container %s: %w", src, c.container.Name, err)
+	}
+	if err := receiver.verify(); err != nil {
+		return fmt.Errorf("copy %s from container %s: %w", src, c.container.Name, err)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("read archive of %s: %w", src, err)
+	}
+	if err := extract(archive, path.Base(src), dst); err != nil {
+		return fmt.Errorf("extract %s to %s: %w", src, dst, err)
+	}
+	return nil
+}
+
+// Upload copies the file or directory at src on the local file system to dst in the container.
+// If dst is an existing directory in the container, src is copied inside it. Otherwise, src is copied as dst.
+func (c *Copier) Upload(src, dst string) error {
+	dst = path.Clean(dst)
+	archive, err := os.CreateTemp("", "copilot-cp-*.tar")
+	if err != nil {
+		return fmt.Errorf("create temporary archive: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	hash := sha256.New()
+	if err := create(io.MultiWriter(archive, hash), src); err != nil {
+		return fmt.Errorf("archive %s: %w", src, err)
+	}
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("get size of the archive of %s: %w", src, err)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("read archive of %s: %w", src, err)
+	}
+
+	stdin, stdinWriter := io.Pipe()
+	go func() {
+		stdinWriter.CloseWithError(sendArchive(stdinWriter, archive, size, c.progress))
+	}()
+	// Stop sending the archive if the session ends before reading all of it.
+	defer stdin.Close()
+
+	status := newStatusReceiver()
+	script := fmt.Sprintf(fmtUploadScript, hex.EncodeToString(hash.Sum(nil)), quote(dst), quote(path.Dir(dst)),
+		quote(filepath.Base(src)), okMarker, mismatchMarker)
+	if err := c.exec.ExecuteCommand(ecs.ExecuteCommandInput{
+		Cluster:   c.container.Cluster,
+		Task:      c.container.Task,
+		Container: c.container.Name,
+		Command:   scriptCommand(script),
+		Stdin:     stdin,
+		Stdout:    status,
+	}); err != nil {
+		return fmt.Errorf("copy %s to container %s: %w", src, c.container.Name, err)
+	}
+	if err := status.verify(); err != nil {
+		return fmt.Errorf("copy %s to container %s: %w", src, c.container.Name, err)
+	}
+	return nil
+}
+
+// sendArchive writes the archive as lines of base64 followed by an end of transmission.
+func sendArchive(w io.Writer, archive io.Reader, size int64, progress ProgressFunc) error {
+	buf := make([]byte, uploadChunkSize)
+	var sent int64
+	for {
+		n, err := io.ReadFull(archive, buf)
+		if n > 0 {
+			encoded := base64.StdEncoding.EncodeToString(buf[:n])
+			for len(encoded) > 0 {
+				line := encoded
+				if len(line) > base64LineLength {
+					line = line[:base64LineLength]
+				}
+				if _, err := io.WriteString(w, line+"\n"); err != nil {
+					return err
+				}
+				encoded = encoded[len(line):]
+			}
+			sent += int64(n)
+			progress(sent, size)
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, endOfTransmission)
+	return err
+}
+
+func scriptCommand(script string) string {
+	return fmt.Sprintf(fmtScriptCommand, base64.StdEncoding.EncodeToString([]byte(script)))
+}
+
+// quote returns the path single-quoted for sh.
+func quote(p string) string {
+	return "'" + strings.ReplaceAll(p, "'", `'\''`) + "'"
+}
+
+// lineWriter calls handle for each line written to it, without the trailing carriage return of the terminal.
+type lineWriter struct {
+	buf    []byte
+	handle func(line string) error
+}
+
+// Write buffers p until a full line is available.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		idx := strings.IndexByte(string(w.buf), '\n')
+		if idx == -1 {
+			return len(p), nil
+		}
+		line := strings.TrimRight(string(w.buf[:idx]), "\r")
+		w.buf = w.buf[idx+1:]
+		if err := w.handle(line); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// diagnostics holds the last lines of output that aren't part of the transfer, to explain a failure.
+type diagnostics []string
+
+func (d *diagnostics) add(line string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, sessionStartPrefix) || strings.HasPrefix(line, sessionExitPrefix) {
+		return
+	}
+	*d = append(*d, line)
+	if len(*d) > maxDiagnosticsLines {
+		*d = (*d)[1:]
+	}
+}
+
+func (d diagnostics) err(msg string) error {
+	if len(d) == 0 {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("%s: %s", msg, strings.Join(d, "; "))
+}
+
+type receiverState int
+
+const (
+	waitingForArchive receiverState = iota
+	receivingHeader
+	receivingArchive
+	receivedArchive
+)
+
+// archiveReceiver decodes the archive written by the download script.
+type archiveReceiver struct {
+	*lineWriter
+	archive  io.Writer
+	hash     hash.Hash
+	progress ProgressFunc
+
+	state    receiverState
+	size     int64
+	checksum string
+	received int64
+	diag     diagnostics
+}
+
+func newArchiveReceiver(archive io.Writer, progress ProgressFunc) *archiveReceiver {
+	r := &archiveReceiver{
+		hash:     sha256.New(),
+		progress: progress,
+	}
+	r.archive = io.MultiWriter(archive, r.hash)
+	r.lineWriter = &lineWriter{
+		handle: r.handle,
+	}
+	return r
+}
+
+func (r *archiveReceiver) handle(line string) error {
+	switch r.state {
+	case waitingForArchive:
+		if line == beginMarker {
+			r.state = receivingHeader
+			return nil
+		}
+		r.diag.add(line)
+	case receivingHeader:
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return fmt.Errorf("parse archive header %q", line)
+		}
+		size, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse archive size %q: %w", fields[0], err)
+		}
+		r.size, r.checksum, r.state = size, fields[1], receivingArchive
+		r.progress(0, r.size)
+	case receivingArchive:
+		if line == endMarker {
+			r.state = receivedArchive
+			return nil
+		}
+		data, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return fmt.Errorf("decode archive: %w", err)
+		}
+		if _, err := r.archive.Write(data); err != nil {
+			return fmt.Errorf("write archive: %w", err)
+		}
+		r.received += int64(len(data))
+		r.progress(r.received, r.size)
+	}
+	return nil
+}
+
+// verify returns an error if the archive wasn't fully received or doesn't match its checksum.
+func (r *archiveReceiver) verify() error {
+	if r.state != receivedArchive {
+		return r.diag.err("archive not received")
+	}
+	if r.received != r.size || hex.EncodeToString(r.hash.Sum(nil)) != r.checksum {
+		return errChecksumMismatch
+	}
+	return nil
+}
+
+// statusReceiver reads the outcome of the upload script.
+type statusReceiver struct {
+	*lineWriter
+	ok       bool
+	mismatch bool
+	diag     diagnostics
+}
+
+func newStatusReceiver() *statusReceiver {
+	r := &statusReceiver{}
+	r.lineWriter = &lineWriter{
+		handle: r.handle,
+	}
+	return r
+}
+
+func (r *statusReceiver) handle(line string) error {
+	switch line {
+	case okMarker:
+		r.ok = true
+	case mismatchMarker:
+		r.mismatch = true
+	default:
+		r.diag.add(line)
+	}
+	return nil
+}
+
+// verify returns an error if the archive wasn't extracted in the container.
+func (r *statusReceiver) verify() error {
+	if r.mismatch {
+		return errChecksumMismatch
+	}
+	if !r.ok {
+		return r.diag.err("extract archive in the container")
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cp
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/stretchr/testify/require"
+)
+
+var scriptCommandRegex = regexp.MustCompile(`^/bin/sh -c "echo (\S+) \| base64 -d \| /bin/sh"$`)
+
+// fakeExecutor emulates the output of the scripts run in a container.
+type fakeExecutor struct {
+	handle func(script string, stdin io.Reader, stdout io.Writer) error
+
+	in     ecs.ExecuteCommandInput
+	script string
+}
+
+func (e *fakeExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+	e.in = in
+	matches := scriptCommandRegex.FindStringSubmatch(in.Command)
+	if matches == nil {
+		return fmt.Errorf("unexpected command %q", in.Command)
+	}
+	script, err := base64.StdEncoding.DecodeString(matches[1])
+	if err != nil {
+		return err
+	}
+	e.script = string(script)
+	return e.handle(e.script, in.Stdin, in.Stdout)
+}
+
+// writeArchive writes the output of the download script for the archive, with the line endings of a terminal.
+func writeArchive(w io.Writer, archive []byte, checksum string) {
+	fmt.Fprintf(w, "\r\nStarting session with SessionId: ecs-execute-command-123\r\n")
+	fmt.Fprintf(w, "%s\r\n%d %s\r\n", beginMarker, len(archive), checksum)
+	encoded := base64.StdEncoding.EncodeToString(archive)
+	for len(encoded) > base64LineLength {
+		fmt.Fprintf(w, "%s\r\n", encoded[:base64LineLength])
+		encoded = encoded[base64LineLength:]
+	}
+	fmt.Fprintf(w, "%s\r\n%s\r\n", encoded, endMarker)
+	fmt.Fprintf(w, "\r\n\r\nExiting session with sessionId: ecs-execute-command-123.\r\n\r\n")
+}
+
+func archiveOf(t *testing.T, src string) ([]byte, string) {
+	buf := new(bytes.Buffer)
+	require.NoError(t, create(buf, src))
+	sum := sha256.Sum256(buf.Bytes())
+	return buf.Bytes(), hex.EncodeToString(sum[:])
+}
+
+func writeTestTree(t *testing.T, root string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "logs"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app.conf"), []byte("port = 80\n"), 0644))
+	// Large enough to span several lines and chunks of base64.
+	require.NoError(t, os.WriteFile(filepath.Join(root, "logs", "heap.hprof"), bytes.Repeat([]byte("0123456789"), 10000), 0600))
+}
+
+func requireTestTree(t *testing.T, root string) {
+	conf, err := os.ReadFile(filepath.Join(root, "app.conf"))
+	require.NoError(t, err)
+	require.Equal(t, "port = 80\n", string(conf))
+	heap, err := os.ReadFile(filepath.Join(root, "logs", "heap.hprof"))
+	require.NoError(t, err)
+	require.Equal(t, bytes.Repeat([]byte("0123456789"), 10000), heap)
+}
+
+func TestCopier_Download(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "dump")
+	writeTestTree(t, src)
+	archive, checksum := archiveOf(t, src)
+
+	testCases := map[string]struct {
+		inSrc    string
+		inDstDir bool
+		output   func(w io.Writer)
+		execErr  error
+
+		wantedScript string
+		wantedError  error
+	}{
+		"copies the directory as the destination": {
+			inSrc: "/tmp/dump/",
+			output: func(w io.Writer) {
+				writeArchive(w, archive, checksum)
+			},
+			wantedScript: `tar -C '/tmp' -cf "$f" 'dump'`,
+		},
+		"copies the directory inside of an existing destination directory": {
+			inSrc:    "/tmp/dump",
+			inDstDir: true,
+			output: func(w io.Writer) {
+				writeArchive(w, archive, checksum)
+			},
+			wantedScript: `tar -C '/tmp' -cf "$f" 'dump'`,
+		},
+		"returns an error if the checksum doesn't match": {
+			inSrc: "/tmp/dump",
+			output: func(w io.Writer) {
+				writeArchive(w, archive, strings.Repeat("0", 64))
+			},
+			wantedError: errors.New("copy /tmp/dump from container api: checksum of the copied archive does not match"),
+		},
+		"returns the output of the container if the archive wasn't received": {
+			inSrc: "/tmp/it's here",
+			output: func(w io.Writer) {
+				fmt.Fprint(w, "Starting session with SessionId: ecs-execute-command-123\r\n")
+				fmt.Fprint(w, "tar: it's here: No such file or directory\r\n")
+			},
+			wantedScript: `'it'\''s here'`,
+			wantedError:  errors.New("copy /tmp/it's here from container api: archive not received: tar: it's here: No such file or directory"),
+		},
+		"wraps the error from executing the command": {
+			inSrc:       "/tmp/dump",
+			output:      func(w io.Writer) {},
+			execErr:     errors.New("some error"),
+			wantedError: errors.New("copy /tmp/dump from container api: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			dst := filepath.Join(t.TempDir(), "out")
+			root := dst
+			if tc.inDstDir {
+				require.NoError(t, os.Mkdir(dst, 0755))
+				root = filepath.Join(dst, "dump")
+			}
+			exec := &fakeExecutor{
+				handle: func(script string, stdin io.Reader, stdout io.Writer) error {
+					tc.output(stdout)
+					return tc.execErr
+				},
+			}
+			var copied, total int64
+			copier := New(exec, Container{Cluster: "cluster", Task: "task", Name: "api"}, func(c, t int64) {
+				copied, total = c, t
+			})
+
+			// WHEN
+			err := copier.Download(tc.inSrc, dst)
+
+			// THEN
+			require.Equal(t, ecs.ExecuteCommandInput{
+				Cluster:   "cluster",
+				Task:      "task",
+				Container: "api",
+				Command:   exec.in.Command,
+				Stdout:    exec.in.Stdout,
+			}, exec.in)
+			require.Contains(t, exec.script, tc.wantedScript)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			requireTestTree(t, root)
+			require.Equal(t, int64(len(archive)), copied)
+			require.Equal(t, int64(len(archive)), total)
+		})
+	}
+}
+
+func TestCopier_Upload(t *testing.T) {
+	src := filepath.Join(t.TempDir(), "config")
+	writeTestTree(t, src)
+	archive, checksum := archiveOf(t, src)
+
+	testCases := map[string]struct {
+		inDst  string
+		status string
+
+		wantedScript string
+		wantedError  error
+	}{
+		"copies the directory to the destination": {
+			inDst:  "/etc/app/",
+			status: okMarker,
+			wantedScript: fmt.Sprintf(`if echo "%s  $f" | sha256sum -c - > /dev/null 2>&1; then
+  if [ -d '/etc/app' ]; then
+    tar -C '/etc/app' -xf "$f" && echo COPILOT-CP-OK
+  else
+    d=$(mktemp -d) && tar -C "$d" -xf "$f" && mkdir -p '/etc' && mv "$d"/'config' '/etc/app' && echo COPILOT-CP-OK`, checksum),
+		},
+		"returns an error if the checksum doesn't match in the container": {
+			inDst:       "/etc/app",
+			status:      mismatchMarker,
+			wantedError: errors.New("copy " + src + " to container api: checksum of the copied archive does not match"),
+		},
+		"returns the output of the container if the archive wasn't extracted": {
+			inDst:       "/etc/app",
+			status:      "mkdir: can't create directory '/etc': Permission denied",
+			wantedError: errors.New("copy " + src + " to container api: extract archive in the container: mkdir: can't create directory '/etc': Permission denied"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var received []byte
+			exec := &fakeExecutor{
+				handle: func(script string, stdin io.Reader, stdout io.Writer) error {
+					r := bufio.NewReader(stdin)
+					encoded, err := r.ReadString(endOfTransmission[0])
+					if err != nil {
+						return err
+					}
+					encoded = strings.TrimSuffix(encoded, endOfTransmission)
+					for _, line := range strings.Split(strings.TrimSpace(encoded), "\n") {
+						if len(line) > base64LineLength {
+							return fmt.Errorf("line of %d characters is too long", len(line))
+						}
+					}
+					received, err = base64.StdEncoding.DecodeString(strings.ReplaceAll(encoded, "\n", ""))
+					if err != nil {
+						return err
+					}
+					fmt.Fprintf(stdout, "%s\r\n", tc.status)
+					return nil
+				},
+			}
+			var copied, total int64
+			copier := New(exec, Container{Cluster: "cluster", Task: "task", Name: "api"}, func(c, t int64) {
+				copied, total = c, t
+			})
+
+			// WHEN
+			err := copier.Upload(src, tc.inDst)
+
+			// THEN
+			require.Contains(t, exec.script, tc.wantedScript)
+			require.Equal(t, archive, received)
+			require.Equal(t, int64(len(archive)), copied)
+			require.Equal(t, int64(len(archive)), total)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCopier_Upload_SessionEndsEarly(t *testing.T) {
+	// GIVEN
+	src := filepath.Join(t.TempDir(), "config")
+	writeTestTree(t, src)
+	exec := &fakeExecutor{
+		handle: func(script string, stdin io.Reader, stdout io.Writer) error {
+			return errors.New("session closed")
+		},
+	}
+
+	// WHEN
+	err := New(exec, Container{Name: "api"}, nil).Upload(src, "/etc/app")
+
+	// THEN
+	require.EqualError(t, err, fmt.Sprintf("copy %s to container api: session closed", src))
+}
@@ -49,6 +49,8 @@ type ecsClient interface {
 
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
+	ListExecutions(stateMachineARN string, maxResults int) ([]stepfunctions.Execution, error)
+	ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error)
 }
 
 // ServiceDesc contains the description of an ECS service.
@@ -0,0 +1,148 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	unknownFailureReason = "unknown error"
+
+	maxConcurrentExecutionHistories = 5 // Limits the number of GetExecutionHistory calls in flight to avoid being throttled.
+)
+
+// JobExecution contains the summary of an execution of a job.
+type JobExecution struct {
+	Name      string
+	ARN       string
+	Status    string // One of the sfn.ExecutionStatus values, such as "SUCCEEDED".
+	StartedAt time.Time
+	StoppedAt time.Time // Zero if the execution is still running.
+	Attempts  int       // Number of times the job was attempted, whether or not its task could be started.
+	Cause     string    // Reason of the failure if the execution did not succeed.
+	TaskARNs  []string  // Tasks started by every attempt of the execution.
+}
+
+// Retries returns the number of times the job was retried in the execution.
+func (e *JobExecution) Retries() int {
+	if e.Attempts <= 1 {
+		return 0
+	}
+	return e.Attempts - 1
+}
+
+// Duration returns how long the execution ran for, or zero if it is still running.
+func (e *JobExecution) Duration() time.Duration {
+	if e.StoppedAt.IsZero() {
+		return 0
+	}
+	return e.StoppedAt.Sub(e.StartedAt)
+}
+
+// JobExecutions returns up to limit of the most recent executions of the job, most recent first.
+func (c Client) JobExecutions(app, env, job string, limit int) ([]*JobExecution, error) {
+	stateMachineARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := c.StepFuncClient.ListExecutions(stateMachineARN, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list executions of job %s: %w", job, err)
+	}
+	jobExecutions := make([]*JobExecution, len(executions))
+	sem := make(chan struct{}, maxConcurrentExecutionHistories)
+	g := new(errgroup.Group)
+	for i := range executions {
+		i, execution := i, executions[i]
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			events, err := c.StepFuncClient.ExecutionHistory(execution.ARN)
+			if err != nil {
+				return fmt.Errorf("get history of execution %s of job %s: %w", execution.Name, job, err)
+			}
+			jobExecution := &JobExecution{
+				Name:      execution.Name,
+				ARN:       execution.ARN,
+				Status:    execution.Status,
+				StartedAt: execution.StartDate,
+				StoppedAt: execution.StopDate,
+			}
+			if err := jobExecution.summarize(events); err != nil {
+				return fmt.Errorf("summarize execution %s of job %s: %w", execution.Name, job, err)
+			}
+			jobExecutions[i] = jobExecution
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return jobExecutions, nil
+}
+
+func (e *JobExecution) summarize(events []stepfunctions.HistoryEvent) error {
+	for _, event := range events {
+		switch event.Type {
+		case sfn.HistoryEventTypeTaskSubmitted:
+			e.Attempts++
+			_, taskARNs, err := SubmittedTasks(event.Output)
+			if err != nil {
+				return err
+			}
+			e.TaskARNs = append(e.TaskARNs, taskARNs...)
+		case sfn.HistoryEventTypeTaskSubmitFailed:
+			e.Attempts++
+		case sfn.HistoryEventTypeExecutionFailed, sfn.HistoryEventTypeExecutionTimedOut, sfn.HistoryEventTypeExecutionAborted:
+			e.Cause = FailureReason(event)
+		}
+	}
+	return nil
+}
+
+// SubmittedTasks returns the cluster and the ARNs of the tasks started by the "ecs:runTask.sync" integration
+// from the output of a TaskSubmitted event of a job's state machine.
+func SubmittedTasks(output string) (cluster string, taskARNs []string, err error) {
+	var runTask struct {
+		Tasks []struct {
+			TaskArn    string `json:"TaskArn"`
+			ClusterArn string `json:"ClusterArn"`
+		} `json:"Tasks"`
+	}
+	if err := json.Unmarshal([]byte(output), &runTask); err != nil {
+		return "", nil, fmt.Errorf("unmarshal submitted tasks: %w", err)
+	}
+	for _, task := range runTask.Tasks {
+		if cluster == "" {
+			cluster = task.ClusterArn
+		}
+		taskARNs = append(taskARNs, task.TaskArn)
+	}
+	return cluster, taskARNs, nil
+}
+
+// FailureReason returns why the task stopped if the cause of a failed event of a job's state machine is an ECS task,
+// otherwise the error of the event along with its cause if the cause is a message, such as why a task could not be started.
+func FailureReason(event stepfunctions.HistoryEvent) string {
+	var stoppedTask struct {
+		StoppedReason string `json:"StoppedReason"`
+	}
+	if err := json.Unmarshal([]byte(event.Cause), &stoppedTask); err == nil && stoppedTask.StoppedReason != "" {
+		return stoppedTask.StoppedReason
+	}
+	if event.Error != "" && event.Cause != "" && !json.Valid([]byte(event.Cause)) {
+		return fmt.Sprintf("%s: %s", event.Error, event.Cause)
+	}
+	if event.Error != "" {
+		return event.Error
+	}
+	return unknownFailureReason
+}
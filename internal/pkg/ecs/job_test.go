@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_JobExecutions(t *testing.T) {
+	const (
+		testApp           = "testApp"
+		testEnv           = "testEnv"
+		testJob           = "testJob"
+		testARN           = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+		testExecutionARN  = "arn:aws:states:us-east-1:1234456789012:execution:testApp-testEnv-testJob:1"
+		testExecutionARN2 = "arn:aws:states:us-east-1:1234456789012:execution:testApp-testEnv-testJob:2"
+		testCluster       = "arn:aws:ecs:us-east-1:1234456789012:cluster/testCluster"
+		testTask1         = "arn:aws:ecs:us-east-1:1234456789012:task/testCluster/1"
+		testTask2         = "arn:aws:ecs:us-east-1:1234456789012:task/testCluster/2"
+	)
+	startDate := time.Date(2022, 10, 1, 2, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(5 * time.Minute)
+	mockStateMachine := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, map[string]string{
+			deploy.AppTagKey:     testApp,
+			deploy.EnvTagKey:     testEnv,
+			deploy.ServiceTagKey: testJob,
+		}).Return([]*resourcegroups.Resource{
+			{
+				ARN: testARN,
+			},
+		}, nil)
+	}
+	mockExecutions := func(m clientMocks) {
+		m.StepFuncClient.EXPECT().ListExecutions(testARN, 10).Return([]stepfunctions.Execution{
+			{
+				ARN:       testExecutionARN,
+				Name:      "1",
+				Status:    "FAILED",
+				StartDate: startDate,
+				StopDate:  stopDate,
+			},
+		}, nil)
+	}
+
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedExecutions []*JobExecution
+		wantedError      error
+	}{
+		"fail to get the state machine": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get state machine resource by tags for job testJob: some error"),
+		},
+		"fail to list executions": {
+			setupMocks: func(m clientMocks) {
+				mockStateMachine(m)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 10).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of job testJob: some error"),
+		},
+		"fail to get the history of an execution": {
+			setupMocks: func(m clientMocks) {
+				mockStateMachine(m)
+				mockExecutions(m)
+				m.StepFuncClient.EXPECT().ExecutionHistory(testExecutionARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution 1 of job testJob: some error"),
+		},
+		"fail to parse the submitted tasks": {
+			setupMocks: func(m clientMocks) {
+				mockStateMachine(m)
+				mockExecutions(m)
+				m.StepFuncClient.EXPECT().ExecutionHistory(testExecutionARN).Return([]stepfunctions.HistoryEvent{
+					{ID: 1, Type: "TaskSubmitted", Output: "not json"},
+				}, nil)
+			},
+			wantedError: errors.New("summarize execution 1 of job testJob: unmarshal submitted tasks: invalid character 'o' in literal null (expecting 'u')"),
+		},
+		"summarizes the attempts and the cause of the failure": {
+			setupMocks: func(m clientMocks) {
+				mockStateMachine(m)
+				mockExecutions(m)
+				m.StepFuncClient.EXPECT().ExecutionHistory(testExecutionARN).Return([]stepfunctions.HistoryEvent{
+					{ID: 1, Type: "ExecutionStarted"},
+					{ID: 2, Type: "TaskSubmitted", Output: `{"Tasks":[{"TaskArn":"` + testTask1 + `","ClusterArn":"` + testCluster + `"}]}`},
+					{ID: 3, Type: "TaskFailed", Error: "States.TaskFailed", Cause: `{"StoppedReason":"Essential container in task exited"}`},
+					{ID: 4, Type: "TaskSubmitted", Output: `{"Tasks":[{"TaskArn":"` + testTask2 + `","ClusterArn":"` + testCluster + `"}]}`},
+					{ID: 5, Type: "TaskFailed", Error: "States.TaskFailed", Cause: `{"StoppedReason":"Essential container in task exited"}`},
+					{ID: 6, Type: "ExecutionFailed", Error: "States.TaskFailed", Cause: `{"StoppedReason":"Essential container in task exited"}`},
+				}, nil)
+			},
+			wantedExecutions: []*JobExecution{
+				{
+					Name:      "1",
+					ARN:       testExecutionARN,
+					Status:    "FAILED",
+					StartedAt: startDate,
+					StoppedAt: stopDate,
+					Attempts:  2,
+					Cause:     "Essential container in task exited",
+					TaskARNs:  []string{testTask1, testTask2},
+				},
+			},
+		},
+		"summarizes every execution in the order they were listed": {
+			setupMocks: func(m clientMocks) {
+				mockStateMachine(m)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 10).Return([]stepfunctions.Execution{
+					{ARN: testExecutionARN, Name: "1", Status: "RUNNING", StartDate: startDate},
+					{ARN: testExecutionARN2, Name: "2", Status: "FAILED", StartDate: startDate, StopDate: stopDate},
+				}, nil)
+				m.StepFuncClient.EXPECT().ExecutionHistory(testExecutionARN).Return([]stepfunctions.HistoryEvent{
+					{ID: 1, Type: "ExecutionStarted"},
+					{ID: 2, Type: "TaskSubmitted", Output: `{"Tasks":[{"TaskArn":"` + testTask1 + `","ClusterArn":"` + testCluster + `"}]}`},
+				}, nil)
+				m.StepFuncClient.EXPECT().ExecutionHistory(testExecutionARN2).Return([]stepfunctions.HistoryEvent{
+					{ID: 1, Type: "ExecutionStarted"},
+					{ID: 2, Type: "TaskSubmitFailed", Error: "ECS.AmazonECSException", Cause: "No Container Instances were found in your cluster."},
+					{ID: 3, Type: "ExecutionFailed", Error: "ECS.AmazonECSException", Cause: "No Container Instances were found in your cluster."},
+				}, nil)
+			},
+			wantedExecutions: []*JobExecution{
+				{
+					Name:      "1",
+					ARN:       testExecutionARN,
+					Status:    "RUNNING",
+					StartedAt: startDate,
+					Attempts:  1,
+					TaskARNs:  []string{testTask1},
+				},
+				{
+					Name:      "2",
+					ARN:       testExecutionARN2,
+					Status:    "FAILED",
+					StartedAt: startDate,
+					StoppedAt: stopDate,
+					Attempts:  1,
+					Cause:     "ECS.AmazonECSException: No Container Instances were found in your cluster.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.JobExecutions(testApp, testEnv, testJob, 10)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedExecutions, got)
+		})
+	}
+}
+
+func TestJobExecution_Retries(t *testing.T) {
+	require.Equal(t, 0, (&JobExecution{}).Retries())
+	require.Equal(t, 0, (&JobExecution{Attempts: 1}).Retries())
+	require.Equal(t, 2, (&JobExecution{Attempts: 3}).Retries())
+}
+
+func TestFailureReason(t *testing.T) {
+	testCases := map[string]struct {
+		in     stepfunctions.HistoryEvent
+		wanted string
+	}{
+		"the stopped reason of the task": {
+			in:     stepfunctions.HistoryEvent{Error: "States.TaskFailed", Cause: `{"StoppedReason":"OutOfMemoryError"}`},
+			wanted: "OutOfMemoryError",
+		},
+		"the error and the cause if the cause is a message": {
+			in:     stepfunctions.HistoryEvent{Error: "ECS.AmazonECSException", Cause: "No Container Instances were found in your cluster."},
+			wanted: "ECS.AmazonECSException: No Container Instances were found in your cluster.",
+		},
+		"the error if the cause is not a task": {
+			in:     stepfunctions.HistoryEvent{Error: "States.Timeout"},
+			wanted: "States.Timeout",
+		},
+		"unknown error": {
+			wanted: "unknown error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, FailureReason(tc.in))
+		})
+	}
+}
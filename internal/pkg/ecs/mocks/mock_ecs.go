@@ -9,6 +9,7 @@ import (
 
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -269,6 +270,36 @@ func (m *MockstepFunctionsClient) EXPECT() *MockstepFunctionsClientMockRecorder
 	return m.recorder
 }
 
+// ExecutionHistory mocks base method.
+func (m *MockstepFunctionsClient) ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionHistory", executionARN)
+	ret0, _ := ret[0].([]stepfunctions.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionHistory indicates an expected call of ExecutionHistory.
+func (mr *MockstepFunctionsClientMockRecorder) ExecutionHistory(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionHistory", reflect.TypeOf((*MockstepFunctionsClient)(nil).ExecutionHistory), executionARN)
+}
+
+// ListExecutions mocks base method.
+func (m *MockstepFunctionsClient) ListExecutions(stateMachineARN string, maxResults int) ([]stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", stateMachineARN, maxResults)
+	ret0, _ := ret[0].([]stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockstepFunctionsClientMockRecorder) ListExecutions(stateMachineARN, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockstepFunctionsClient)(nil).ListExecutions), stateMachineARN, maxResults)
+}
+
 // StateMachineDefinition mocks base method.
 func (m *MockstepFunctionsClient) StateMachineDefinition(stateMachineARN string) (string, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +70,21 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Dir sets the internal *exec.Cmd's Dir field to run the command from the directory.
+func Dir(dir string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Dir = dir
+	}
+}
+
+// Env appends the environment variables, in the form "key=value", to the environment of the current process
+// and sets them as the internal *exec.Cmd's Env field.
+func Env(vars ...string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Env = append(os.Environ(), vars...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +60,42 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartStreamingSession starts a session using the ssm plugin without attaching it to the terminal.
+// The input is read from stdin and the output of the session is written to stdout.
+func (s SSMPluginCommand) StartStreamingSession(ssmSess *ecs.Session, stdin io.Reader, stdout io.Writer) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	if err := s.runner.Run(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction},
+		Stdin(stdin), Stdout(stdout)); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// StartPortForwardingSession starts a port forwarding session using the ssm plugin.
+// The plugin keeps forwarding the port until it's interrupted.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	params, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session parameters: %w", err)
+	}
+	// Unlike ECS Exec sessions, the plugin needs the parameters and the SSM endpoint to open a port forwarding session.
+	// The profile is left empty since the credentials are already resolved in the session.
+	endpoint := s.sess.ClientConfig(ssm.EndpointsID).Endpoint
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction, "", string(params), endpoint}); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
@@ -4,15 +4,18 @@
 package exec
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -83,3 +86,104 @@ func TestSSMPluginCommand_StartSession(t *testing.T) {
 		})
 	}
 }
+
+func TestSSMPluginCommand_StartStreamingSession(t *testing.T) {
+	mockSession := &ecs.Session{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	tests := map[string]struct {
+		runErr      error
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			runErr:      errors.New("some error"),
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			mockRunner.EXPECT().Run(ssmPluginBinaryName,
+				[]string{`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`, "us-west-2", "StartSession"},
+				gomock.Any(), gomock.Any()).Return(tc.runErr)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+			}
+			err := s.StartStreamingSession(mockSession, strings.NewReader("input"), &bytes.Buffer{})
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSMPluginCommand_StartPortForwardingSession(t *testing.T) {
+	mockSession := &ssm.StartSessionOutput{
+		SessionId:  aws.String("mockSessionID"),
+		StreamUrl:  aws.String("mockStreamURL"),
+		TokenValue: aws.String("mockTokenValue"),
+	}
+	mockParams := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSession"),
+		Parameters: map[string][]*string{
+			"portNumber": aws.StringSlice([]string{"80"}),
+		},
+		Target: aws.String("ecs:mockCluster_mockTaskID_mockRuntimeID"),
+	}
+	wantedArgs := []string{
+		`{"SessionId":"mockSessionID","StreamUrl":"mockStreamURL","TokenValue":"mockTokenValue"}`,
+		"us-west-2",
+		"StartSession",
+		"",
+		`{"DocumentName":"AWS-StartPortForwardingSession","Parameters":{"portNumber":["80"]},"Reason":null,"Target":"ecs:mockCluster_mockTaskID_mockRuntimeID"}`,
+		"https://ssm.us-west-2.amazonaws.com",
+	}
+	tests := map[string]struct {
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"return error if fail to start session": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("start session: some error"),
+		},
+		"success": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().InteractiveRun(ssmPluginBinaryName, wantedArgs).Return(nil)
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRunner := NewMockrunner(ctrl)
+			tc.setupMocks(mockRunner)
+			sess, err := session.NewSession(&aws.Config{
+				Region: aws.String("us-west-2"),
+			})
+			require.NoError(t, err)
+			s := SSMPluginCommand{
+				runner: mockRunner,
+				sess:   sess,
+			}
+			err = s.StartPortForwardingSession(mockSession, mockParams)
+			if tc.wantedError != nil {
+				require.EqualError(t, tc.wantedError, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -89,6 +89,7 @@ type ServiceProps struct {
 	WorkloadProps
 	Port        uint16
 	HealthCheck manifest.ContainerHealthCheck
+	SourceDir   string // Directory of the files to upload for a static site.
 	appDomain   *string
 }
 
@@ -135,7 +136,7 @@ func (w *WorkloadInitializer) addWlToStore(wl *config.Workload, wlType string) e
 
 func (w *WorkloadInitializer) initJob(props *JobProps) (string, error) {
 	if props.DockerfilePath != "" {
-		path, err := relativeDockerfilePath(w.Ws, props.DockerfilePath)
+		path, err := relativeWorkspacePath(w.Ws, props.DockerfilePath)
 		if err != nil {
 			return "", err
 		}
@@ -187,12 +188,19 @@ func (w *WorkloadInitializer) initJob(props *JobProps) (string, error) {
 
 func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	if props.DockerfilePath != "" {
-		path, err := relativeDockerfilePath(w.Ws, props.DockerfilePath)
+		path, err := relativeWorkspacePath(w.Ws, props.DockerfilePath)
 		if err != nil {
 			return "", err
 		}
 		props.DockerfilePath = path
 	}
+	if props.SourceDir != "" {
+		path, err := relativeWorkspacePath(w.Ws, props.SourceDir)
+		if err != nil {
+			return "", err
+		}
+		props.SourceDir = path
+	}
 	app, err := w.Store.GetApplication(props.App)
 	if err != nil {
 		return "", fmt.Errorf("get application %s: %w", props.App, err)
@@ -226,7 +234,10 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	log.Successf(manifestMsgFmt, svcWlType, color.HighlightUserInput(props.Name), color.HighlightResource(manifestPath))
 
 	helpText := "Your manifest contains configurations like your container size and port."
-	if props.Port != 0 {
+	switch {
+	case props.Type == manifest.StaticSiteType:
+		helpText = "Your manifest contains configurations like the directory of your site and the cache control of its files."
+	case props.Port != 0:
 		helpText = fmt.Sprintf("Your manifest contains configurations like your container size and port (:%d).", props.Port)
 	}
 	log.Infoln(color.Help(helpText))
@@ -297,6 +308,8 @@ func (w *WorkloadInitializer) newServiceManifest(i *ServiceProps) (encoding.Bina
 		return newBackendServiceManifest(i)
 	case manifest.WorkerServiceType:
 		return newWorkerServiceManifest(i)
+	case manifest.StaticSiteType:
+		return newStaticSiteManifest(i), nil
 	default:
 		return nil, fmt.Errorf("service type %s doesn't have a manifest", i.Type)
 	}
@@ -375,8 +388,15 @@ func newWorkerServiceManifest(i *ServiceProps) (*manifest.WorkerService, error)
 	}), nil
 }
 
-// relativeDockerfilePath returns the path from the workspace root to the Dockerfile.
-func relativeDockerfilePath(ws Workspace, path string) (string, error) {
+func newStaticSiteManifest(i *ServiceProps) *manifest.StaticSite {
+	return manifest.NewStaticSite(manifest.StaticSiteProps{
+		Name: i.Name,
+		Path: filepath.ToSlash(i.SourceDir),
+	})
+}
+
+// relativeWorkspacePath returns the path from the workspace root to a file or directory, such as the Dockerfile.
+func relativeWorkspacePath(ws Workspace, path string) (string, error) {
 	wsRoot, err := ws.Path()
 	if err != nil {
 		return "", fmt.Errorf("get workspace path: %w", err)
@@ -387,7 +407,7 @@ func relativeDockerfilePath(ws Workspace, path string) (string, error) {
 	}
 	relDfPath, err := filepath.Rel(wsRoot, absDfPath)
 	if err != nil {
-		return "", fmt.Errorf("find relative path from workspace root to Dockerfile: %v", err)
+		return "", fmt.Errorf("find relative path from workspace root to %s: %v", path, err)
 	}
 	return relDfPath, nil
 }
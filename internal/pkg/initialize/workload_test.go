@@ -446,6 +446,7 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		inImage          string
 		inHealthCheck    manifest.ContainerHealthCheck
 		inTopics         []manifest.TopicSubscription
+		inSourceDir      string
 
 		mockWriter      func(m *mocks.MockWorkspace)
 		mockstore       func(m *mocks.MockStore)
@@ -764,6 +765,40 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				m.EXPECT().Stop(log.Ssuccessf(fmtAddWlToAppComplete, "service", "worker"))
 			},
 		},
+		"writes Static Site manifest with the source directory relative to the workspace": {
+			inSvcType:   manifest.StaticSiteType,
+			inAppName:   "app",
+			inSvcName:   "frontend",
+			inSourceDir: "/copilot/frontend/dist",
+
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().Path().Return("/copilot", nil)
+				m.EXPECT().WriteServiceManifest(gomock.Any(), "frontend").
+					Do(func(m *manifest.StaticSite, _ string) {
+						require.Equal(t, manifest.StaticSiteType, *m.Workload.Type)
+						require.Equal(t, "frontend/dist", *m.Source.Path)
+					}).Return("/copilot/frontend/manifest.yml", nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().CreateService(&config.Workload{
+					Name: "frontend",
+					App:  "app",
+					Type: manifest.StaticSiteType,
+				}).Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name: "app",
+				}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddServiceToApp(&config.Application{
+					Name: "app",
+				}, "frontend")
+			},
+			mockProg: func(m *mocks.MockProg) {
+				m.EXPECT().Start(fmt.Sprintf(fmtAddWlToAppStart, "service", "frontend"))
+				m.EXPECT().Stop(log.Ssuccessf(fmtAddWlToAppComplete, "service", "frontend"))
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -809,6 +844,7 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				},
 				Port:        tc.inSvcPort,
 				HealthCheck: tc.inHealthCheck,
+				SourceDir:   tc.inSourceDir,
 			})
 
 			// THEN
@@ -21,9 +21,16 @@ import (
 
 const (
 	defaultServiceLogsLimit = 10
+	// defaultFilterLogsSince is how far back log events are filtered if no start time is set,
+	// since filtering scans every log event from the start time.
+	defaultFilterLogsSince = time.Hour
 
 	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
 	fmtSvcLogStreamPrefix = "copilot/%s"
+
+	// The containers of a job and its state machine write their logs to the same log group.
+	containerLogStreamPrefix    = "copilot/"
+	stateMachineLogStreamPrefix = "states/"
 )
 
 type logGetter interface {
@@ -34,6 +41,9 @@ type logGetter interface {
 type ServiceClient struct {
 	logGroupName        string
 	logStreamNamePrefix string
+	containerOnly       bool // If true, only retrieve logs from the log streams of the container in logStreamNamePrefix.
+	isJob               bool
+	stateMachineLogs    bool // If true, retrieve the logs of the state machine executions of a job along with the logs of its containers.
 	eventsGetter        logGetter
 	w                   io.Writer
 
@@ -47,6 +57,8 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// FilterPattern is a CloudWatch Logs filter pattern to only retrieve the matching log events.
+	FilterPattern string
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 }
@@ -60,7 +72,10 @@ type NewServiceLogsConfig struct {
 	LogGroup    string
 	WkldType    string
 	TaskIDs     []string
+	Container   string // Name of the container to retrieve logs from, defaults to all the containers.
 	ConfigStore describe.ConfigStoreSvc
+
+	IncludeStateMachineLogs bool // If true, the logs of the state machine executions of a job are retrieved as well.
 }
 
 func (o WriteLogEventsOpts) limit() *int64 {
@@ -83,6 +98,9 @@ func (o WriteLogEventsOpts) startTime(now func() time.Time) *int64 {
 		// Start following log events from current timestamp.
 		return aws.Int64(now().UnixMilli())
 	}
+	if o.FilterPattern != "" {
+		return aws.Int64(now().Add(-defaultFilterLogsSince).UnixMilli())
+	}
 	return nil
 }
 
@@ -100,9 +118,16 @@ func NewServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, error) {
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
+	container := opts.Svc
+	if opts.Container != "" {
+		container = opts.Container
+	}
 	return &ServiceClient{
 		logGroupName:        logGroup,
-		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, opts.Svc),
+		logStreamNamePrefix: fmt.Sprintf(fmtSvcLogStreamPrefix, container),
+		containerOnly:       opts.Container != "",
+		isJob:               opts.WkldType == manifest.ScheduledJobType,
+		stateMachineLogs:    opts.IncludeStateMachineLogs,
 		eventsGetter:        cloudwatchlogs.New(opts.Sess),
 		w:                   log.OutputWriter,
 		now:                 time.Now,
@@ -113,6 +138,9 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 	if opts.TaskIDs != nil {
 		return nil, fmt.Errorf("cannot use --tasks for App Runner service logs")
 	}
+	if opts.Container != "" {
+		return nil, fmt.Errorf("cannot use --container for App Runner service logs")
+	}
 	serviceDescriber, err := describe.NewRDWebServiceDescriber(describe.NewServiceConfig{
 		App: opts.App,
 		Svc: opts.Svc,
@@ -149,15 +177,7 @@ func newAppRunnerServiceClient(opts *NewServiceLogsConfig) (*ServiceClient, erro
 
 // WriteLogEvents writes service logs.
 func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
-	logEventsOpts := cloudwatchlogs.LogEventsOpts{
-		LogGroup:  s.logGroupName,
-		Limit:     opts.limit(),
-		EndTime:   opts.EndTime,
-		StartTime: opts.startTime(s.now),
-	}
-	if opts.TaskIDs != nil {
-		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
-	}
+	logEventsOpts := s.logEventsOpts(opts)
 	for {
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
@@ -178,6 +198,28 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 	}
 }
 
+func (s *ServiceClient) logEventsOpts(opts WriteLogEventsOpts) cloudwatchlogs.LogEventsOpts {
+	logEventsOpts := cloudwatchlogs.LogEventsOpts{
+		LogGroup:      s.logGroupName,
+		Limit:         opts.limit(),
+		EndTime:       opts.EndTime,
+		StartTime:     opts.startTime(s.now),
+		FilterPattern: opts.FilterPattern,
+	}
+	switch {
+	case opts.TaskIDs != nil:
+		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
+	case s.containerOnly:
+		logEventsOpts.LogStreams = []string{s.logStreamNamePrefix + "/"}
+	case s.isJob && !s.stateMachineLogs:
+		logEventsOpts.LogStreams = []string{containerLogStreamPrefix}
+	}
+	if s.stateMachineLogs && logEventsOpts.LogStreams != nil {
+		logEventsOpts.LogStreams = append(logEventsOpts.LogStreams, stateMachineLogStreamPrefix)
+	}
+	return logEventsOpts
+}
+
 func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
 	for _, taskID := range taskIDs {
 		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
@@ -59,12 +59,16 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 	mockStartTime := aws.Int64(123456789)
 	mockCurrentTimestamp := time.Date(2020, 11, 23, 0, 0, 0, 0, time.UTC) // Copilot GA date :).
 	testCases := map[string]struct {
-		follow     bool
-		limit      *int64
-		startTime  *int64
-		jsonOutput bool
-		taskIDs    []string
-		setupMocks func(mocks serviceLogsMocks)
+		follow        bool
+		limit         *int64
+		startTime     *int64
+		jsonOutput    bool
+		taskIDs       []string
+		containerOnly bool
+		isJob         bool
+		stateMachine  bool
+		filterPattern string
+		setupMocks    func(mocks serviceLogsMocks)
 
 		wantedError   error
 		wantedContent string
@@ -141,6 +145,59 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
 `,
 		},
+		"success with container and filter pattern": {
+			containerOnly: true,
+			filterPattern: "ERROR",
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"mockLogStreamPrefix/"}, param.LogStreams)
+							require.Equal(t, "ERROR", param.FilterPattern)
+							require.Equal(t, aws.Int64(mockCurrentTimestamp.Add(-time.Hour).UnixMilli()), param.StartTime)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: moreLogEvents,
+						}, nil),
+				)
+			},
+
+			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
+`,
+		},
+		"only retrieves the logs of the containers of a job by default": {
+			isJob: true,
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"copilot/"}, param.LogStreams)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+		},
+		"retrieves the logs of every stream of a job when including the state machine logs": {
+			isJob:        true,
+			stateMachine: true,
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Nil(t, param.LogStreams)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+		},
+		"retrieves the logs of the tasks and the state machine of a job": {
+			isJob:        true,
+			stateMachine: true,
+			taskIDs:      []string{"mockTaskID1"},
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"mockLogStreamPrefix/mockTaskID1", "states/"}, param.LogStreams)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+		},
 		"success with no filtering": {
 			taskIDs: []string{"mockTaskID1"},
 			setupMocks: func(m serviceLogsMocks) {
@@ -180,6 +237,9 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 			svcLogs := &ServiceClient{
 				logGroupName:        mockLogGroupName,
 				logStreamNamePrefix: mockLogStreamPrefix,
+				containerOnly:       tc.containerOnly,
+				isJob:               tc.isJob,
+				stateMachineLogs:    tc.stateMachine,
 				eventsGetter:        mocklogGetter,
 				w:                   b,
 				now: func() time.Time {
@@ -193,11 +253,12 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 				logWriter = WriteJSONLogs
 			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
-				Follow:    tc.follow,
-				TaskIDs:   tc.taskIDs,
-				Limit:     tc.limit,
-				StartTime: tc.startTime,
-				OnEvents:  logWriter,
+				Follow:        tc.follow,
+				TaskIDs:       tc.taskIDs,
+				Limit:         tc.limit,
+				StartTime:     tc.startTime,
+				FilterPattern: tc.filterPattern,
+				OnEvents:      logWriter,
 			})
 
 			// THEN
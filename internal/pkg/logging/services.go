@@ -0,0 +1,145 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	c "github.com/fatih/color"
+)
+
+// Colors used to prefix the log events of each service, picked in a round-robin fashion.
+var serviceColors = []*c.Color{color.Cyan, color.Magenta, color.DullGreen, color.DullBlue, color.HiCyan, color.BoldFgYellow}
+
+// ServicesClient retrieves and merges the logs of multiple services in the same environment.
+type ServicesClient struct {
+	svcs    []string
+	clients []*ServiceClient
+	w       io.Writer
+}
+
+// NewServicesClient returns a ServicesClient that interleaves the logs of each service in cfgs.
+func NewServicesClient(cfgs []*NewServiceLogsConfig) (*ServicesClient, error) {
+	client := &ServicesClient{
+		w: log.OutputWriter,
+	}
+	for _, cfg := range cfgs {
+		svcClient, err := NewServiceClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("create logs client for service %s: %w", cfg.Svc, err)
+		}
+		client.svcs = append(client.svcs, cfg.Svc)
+		client.clients = append(client.clients, svcClient)
+	}
+	return client, nil
+}
+
+// WriteLogEvents writes the log events of all the services ordered by timestamp.
+// Each event is prefixed with the name of the service that emitted it.
+func (s *ServicesClient) WriteLogEvents(opts WriteLogEventsOpts) error {
+	prefixes := s.prefixes()
+	logEventsOpts := make([]cloudwatchlogs.LogEventsOpts, len(s.clients))
+	for i, client := range s.clients {
+		logEventsOpts[i] = client.logEventsOpts(opts)
+	}
+	for {
+		var events []*serviceLogEvent
+		var hasMore bool
+		for i, client := range s.clients {
+			out, err := client.eventsGetter.LogEvents(logEventsOpts[i])
+			if err != nil {
+				return fmt.Errorf("get log events for service %s: %w", s.svcs[i], err)
+			}
+			for _, event := range out.Events {
+				events = append(events, &serviceLogEvent{
+					Event:  event,
+					svc:    s.svcs[i],
+					prefix: prefixes[i],
+				})
+			}
+			if out.StreamLastEventTime != nil {
+				hasMore = true
+			}
+			logEventsOpts[i].StreamLastEventTime = out.StreamLastEventTime
+		}
+		sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
+		if limit := opts.limit(); limit != nil && len(events) > int(*limit) {
+			events = events[len(events)-int(*limit):] // Only keep the latest events across all services.
+		}
+		if err := opts.OnEvents(s.w, serviceEventsToHumanJSONStringers(events)); err != nil {
+			return err
+		}
+		if !opts.Follow {
+			return nil
+		}
+		// for unit test.
+		if !hasMore {
+			return nil
+		}
+		time.Sleep(cloudwatchlogs.SleepDuration)
+	}
+}
+
+// prefixes returns the colored service names, padded to the same width so that the messages are aligned.
+func (s *ServicesClient) prefixes() []string {
+	var width int
+	for _, svc := range s.svcs {
+		if len(svc) > width {
+			width = len(svc)
+		}
+	}
+	prefixes := make([]string, len(s.svcs))
+	for i, svc := range s.svcs {
+		prefixes[i] = serviceColors[i%len(serviceColors)].Sprintf("%-*s |", width, svc)
+	}
+	return prefixes
+}
+
+// serviceLogEvent is a log event along with the name of the service that emitted it.
+type serviceLogEvent struct {
+	*cloudwatchlogs.Event
+	svc    string
+	prefix string
+}
+
+// JSONString returns the stringified log event with the service name in JSON format.
+func (e *serviceLogEvent) JSONString() (string, error) {
+	b, err := json.Marshal(struct {
+		Service       string      `json:"serviceName"`
+		LogStreamName string      `json:"logStreamName"`
+		IngestionTime int64       `json:"ingestionTime"`
+		Message       interface{} `json:"message"`
+		Timestamp     int64       `json:"timestamp"`
+	}{
+		Service:       e.svc,
+		LogStreamName: e.LogStreamName,
+		IngestionTime: e.IngestionTime,
+		Message:       e.JSONMessage(),
+		Timestamp:     e.Timestamp,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal a log event: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified log event prefixed with the service name in human-readable format.
+func (e *serviceLogEvent) HumanString() string {
+	return fmt.Sprintf("%s %s", e.prefix, e.Event.HumanString())
+}
+
+func serviceEventsToHumanJSONStringers(events []*serviceLogEvent) []HumanJSONStringer {
+	logStringers := make([]HumanJSONStringer, len(events))
+	for ind, event := range events {
+		logStringers[ind] = event
+	}
+	return logStringers
+}
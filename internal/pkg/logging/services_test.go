@@ -0,0 +1,164 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/logging/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServicesClient_WriteLogEvents(t *testing.T) {
+	mockCurrentTimestamp := time.Date(2020, 11, 23, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		follow     bool
+		limit      *int64
+		jsonOutput bool
+		setupMocks func(fe, api *mocks.MocklogGetter)
+
+		wantedError   string
+		wantedContent string
+	}{
+		"returns a wrapped error if the events of a service can't be retrieved": {
+			setupMocks: func(fe, api *mocks.MocklogGetter) {
+				fe.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: "get log events for service api: some error",
+		},
+		"interleaves the events of all the services by timestamp": {
+			setupMocks: func(fe, api *mocks.MocklogGetter) {
+				fe.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/fe/1", Message: "GET /", Timestamp: 1},
+						{LogStreamName: "copilot/fe/1", Message: "200 OK", Timestamp: 4},
+					},
+				}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/api/2", Message: "GET /users", Timestamp: 2},
+					},
+				}, nil)
+			},
+
+			wantedContent: `fe  | copilot/fe/1 GET /
+api | copilot/api/2 GET /users
+fe  | copilot/fe/1 200 OK
+`,
+		},
+		"keeps only the latest events across services up to the limit": {
+			limit: aws.Int64(2),
+			setupMocks: func(fe, api *mocks.MocklogGetter) {
+				fe.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/fe/1", Message: "GET /", Timestamp: 1},
+						{LogStreamName: "copilot/fe/1", Message: "200 OK", Timestamp: 4},
+					},
+				}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/api/2", Message: "GET /users", Timestamp: 2},
+					},
+				}, nil)
+			},
+
+			wantedContent: `api | copilot/api/2 GET /users
+fe  | copilot/fe/1 200 OK
+`,
+		},
+		"includes the service name in json output": {
+			jsonOutput: true,
+			setupMocks: func(fe, api *mocks.MocklogGetter) {
+				fe.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{LogStreamName: "copilot/fe/1", Message: "GET /", Timestamp: 1},
+					},
+				}, nil)
+				api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{}, nil)
+			},
+
+			wantedContent: "{\"serviceName\":\"fe\",\"logStreamName\":\"copilot/fe/1\",\"ingestionTime\":0,\"message\":\"GET /\",\"timestamp\":1}\n",
+		},
+		"follows the events of each service from their last event time": {
+			follow: true,
+			setupMocks: func(fe, api *mocks.MocklogGetter) {
+				gomock.InOrder(
+					fe.EXPECT().LogEvents(gomock.Any()).Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, aws.Int64(mockCurrentTimestamp.UnixMilli()), param.StartTime)
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{LogStreamName: "copilot/fe/1", Message: "GET /", Timestamp: 1},
+						},
+						StreamLastEventTime: map[string]int64{"copilot/fe/1": 1},
+					}, nil),
+					fe.EXPECT().LogEvents(gomock.Any()).Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, map[string]int64{"copilot/fe/1": 1}, param.StreamLastEventTime)
+					}).Return(&cloudwatchlogs.LogEventsOutput{}, nil),
+				)
+				gomock.InOrder(
+					api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+						StreamLastEventTime: map[string]int64{},
+					}, nil),
+					api.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{LogStreamName: "copilot/api/2", Message: "GET /users", Timestamp: 2},
+						},
+					}, nil),
+				)
+			},
+
+			wantedContent: `fe  | copilot/fe/1 GET /
+api | copilot/api/2 GET /users
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			fe, api := mocks.NewMocklogGetter(ctrl), mocks.NewMocklogGetter(ctrl)
+			tc.setupMocks(fe, api)
+			now := func() time.Time { return mockCurrentTimestamp }
+
+			b := &bytes.Buffer{}
+			client := &ServicesClient{
+				svcs: []string{"fe", "api"},
+				clients: []*ServiceClient{
+					{logGroupName: "/copilot/app-test-fe", logStreamNamePrefix: "copilot/fe", eventsGetter: fe, now: now},
+					{logGroupName: "/copilot/app-test-api", logStreamNamePrefix: "copilot/api", eventsGetter: api, now: now},
+				},
+				w: b,
+			}
+
+			// WHEN
+			logWriter := WriteHumanLogs
+			if tc.jsonOutput {
+				logWriter = WriteJSONLogs
+			}
+			err := client.WriteLogEvents(WriteLogEventsOpts{
+				Follow:   tc.follow,
+				Limit:    tc.limit,
+				OnEvents: logWriter,
+			})
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
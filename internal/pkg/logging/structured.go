@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+)
+
+const missingFieldValue = "-"
+
+// LogLevels are the valid levels of structured log messages, ordered by increasing severity.
+var LogLevels = []string{"trace", "debug", "info", "warn", "error", "fatal"}
+
+var (
+	// logLevelAliases maps commonly used level names to one of the LogLevels.
+	logLevelAliases = map[string]string{
+		"warning":  "warn",
+		"err":      "error",
+		"critical": "fatal",
+		"panic":    "fatal",
+	}
+	// levelFields are the fields checked in order to find the level of a structured log message.
+	levelFields = []string{"level", "lvl", "severity"}
+)
+
+// StructuredLogWriter writes log events whose messages are JSON objects.
+// Messages that can't be parsed as JSON objects are written as is, regardless of the minimum level.
+type StructuredLogWriter struct {
+	Fields []string // Fields of the messages to display as aligned columns. If empty, the messages are displayed as is.
+	Level  string   // Minimum level of the messages to display. If empty, all the messages are displayed.
+}
+
+type structuredLog struct {
+	HumanJSONStringer
+	fields map[string]interface{} // Nil if the message is not a JSON object.
+}
+
+// WriteHumanLogs writes the log events at or above the minimum level in human-readable format,
+// with the selected fields of each message projected into aligned columns.
+func (s StructuredLogWriter) WriteHumanLogs(w io.Writer, logStringers []HumanJSONStringer) error {
+	logs := s.parse(logStringers)
+	if len(s.Fields) == 0 {
+		return WriteHumanLogs(w, toHumanJSONStringers(logs))
+	}
+	widths := make([]int, len(s.Fields))
+	for _, l := range logs {
+		if l.fields == nil {
+			continue
+		}
+		for i, field := range s.Fields {
+			if n := len(fieldValue(l.fields, field)); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	for _, l := range logs {
+		if l.fields == nil {
+			fmt.Fprint(w, l.HumanString())
+			continue
+		}
+		columns := make([]string, len(s.Fields))
+		for i, field := range s.Fields {
+			columns[i] = fmt.Sprintf("%-*s", widths[i], fieldValue(l.fields, field))
+		}
+		fmt.Fprint(w, withMessage(l.HumanJSONStringer, strings.TrimRight(strings.Join(columns, "  "), " ")).HumanString())
+	}
+	return nil
+}
+
+// WriteJSONLogs writes the log events at or above the minimum level in JSON format.
+func (s StructuredLogWriter) WriteJSONLogs(w io.Writer, logStringers []HumanJSONStringer) error {
+	return WriteJSONLogs(w, toHumanJSONStringers(s.parse(logStringers)))
+}
+
+// parse parses the messages of the log events and drops the ones below the minimum level.
+// If a minimum level is set, JSON messages without a level are dropped as well,
+// while messages that aren't JSON objects are always kept as they can't be filtered.
+func (s StructuredLogWriter) parse(logStringers []HumanJSONStringer) []structuredLog {
+	var logs []structuredLog
+	for _, l := range logStringers {
+		var fields map[string]interface{}
+		if msg, ok := messageOf(l); ok {
+			dec := json.NewDecoder(strings.NewReader(msg))
+			dec.UseNumber()
+			if err := dec.Decode(&fields); err != nil {
+				fields = nil
+			}
+		}
+		if s.Level != "" && fields != nil && levelIndex(messageLevel(fields)) < levelIndex(s.Level) {
+			continue
+		}
+		logs = append(logs, structuredLog{
+			HumanJSONStringer: l,
+			fields:            fields,
+		})
+	}
+	return logs
+}
+
+// IsValidLogLevel returns true if the level is one of the LogLevels or a common alias.
+func IsValidLogLevel(level string) bool {
+	return levelIndex(level) != -1
+}
+
+func levelIndex(level string) int {
+	level = strings.ToLower(level)
+	if alias, ok := logLevelAliases[level]; ok {
+		level = alias
+	}
+	for i, l := range LogLevels {
+		if l == level {
+			return i
+		}
+	}
+	return -1
+}
+
+func messageLevel(fields map[string]interface{}) string {
+	for _, key := range levelFields {
+		if level, ok := fields[key].(string); ok {
+			return level
+		}
+	}
+	return ""
+}
+
+// fieldValue returns the value of the field in the message. Nested fields can be selected with dots, like "http.status".
+func fieldValue(fields map[string]interface{}, name string) string {
+	val, ok := fields[name]
+	if !ok {
+		var cur interface{} = fields
+		for _, key := range strings.Split(name, ".") {
+			m, isObj := cur.(map[string]interface{})
+			if !isObj {
+				return missingFieldValue
+			}
+			if cur, ok = m[key]; !ok {
+				return missingFieldValue
+			}
+		}
+		val = cur
+	}
+	if s, ok := val.(string); ok {
+		return s
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(val); err != nil {
+		return fmt.Sprint(val)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+func messageOf(l HumanJSONStringer) (string, bool) {
+	switch e := l.(type) {
+	case *cloudwatchlogs.Event:
+		return e.Message, true
+	case *serviceLogEvent:
+		return e.Message, true
+	}
+	return "", false
+}
+
+// withMessage returns a copy of the log event with a different message.
+func withMessage(l HumanJSONStringer, msg string) HumanJSONStringer {
+	switch e := l.(type) {
+	case *cloudwatchlogs.Event:
+		event := *e
+		event.Message = msg
+		return &event
+	case *serviceLogEvent:
+		event := *e.Event
+		event.Message = msg
+		return &serviceLogEvent{
+			Event:  &event,
+			svc:    e.svc,
+			prefix: e.prefix,
+		}
+	}
+	return l
+}
+
+func toHumanJSONStringers(logs []structuredLog) []HumanJSONStringer {
+	logStringers := make([]HumanJSONStringer, len(logs))
+	for i, l := range logs {
+		logStringers[i] = l.HumanJSONStringer
+	}
+	return logStringers
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructuredLogWriter_WriteHumanLogs(t *testing.T) {
+	events := func() []HumanJSONStringer {
+		return []HumanJSONStringer{
+			&cloudwatchlogs.Event{
+				LogStreamName: "copilot/fe/1234",
+				Message:       `{"level":"info","msg":"listening","port":8080}`,
+			},
+			&cloudwatchlogs.Event{
+				LogStreamName: "copilot/fe/1234",
+				Message:       "starting server",
+			},
+			&cloudwatchlogs.Event{
+				LogStreamName: "copilot/fe/1234",
+				Message:       `{"level":"WARNING","msg":"slow request","http":{"status":504},"trace_id":"abc"}`,
+			},
+		}
+	}
+	testCases := map[string]struct {
+		fields []string
+		level  string
+
+		wanted string
+	}{
+		"writes messages as is without fields": {
+			wanted: `copilot/fe/1234 {"level":"info","msg":"listening","port":8080}
+copilot/fe/1234 starting server
+copilot/fe/1234 {"level":"WARNING","msg":"slow request","http":{"status":504},"trace_id":"abc"}
+`,
+		},
+		"projects fields into aligned columns and falls back to raw text": {
+			fields: []string{"level", "msg", "http.status", "trace_id"},
+			wanted: `copilot/fe/1234 info     listening     -    -
+copilot/fe/1234 starting server
+copilot/fe/1234 WARNING  slow request  504  abc
+`,
+		},
+		"keeps only messages at or above the level and the ones that aren't JSON": {
+			fields: []string{"msg"},
+			level:  "warn",
+			wanted: `copilot/fe/1234 starting server
+copilot/fe/1234 slow request
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(bytes.Buffer)
+			w := StructuredLogWriter{
+				Fields: tc.fields,
+				Level:  tc.level,
+			}
+
+			// WHEN
+			err := w.WriteHumanLogs(buf, events())
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
+
+func TestStructuredLogWriter_WriteJSONLogs(t *testing.T) {
+	// GIVEN
+	buf := new(bytes.Buffer)
+	w := StructuredLogWriter{
+		Level: "error",
+	}
+
+	// WHEN
+	err := w.WriteJSONLogs(buf, []HumanJSONStringer{
+		&cloudwatchlogs.Event{
+			LogStreamName: "copilot/fe/1234",
+			Message:       `{"level":"info","msg":"listening"}`,
+		},
+		&cloudwatchlogs.Event{
+			LogStreamName: "copilot/fe/1234",
+			Message:       `{"level":"error","msg":"timeout"}`,
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `{"logStreamName":"copilot/fe/1234","ingestionTime":0,"message":{"level":"error","msg":"timeout"},"timestamp":0}`+"\n", buf.String())
+}
+
+func TestIsValidLogLevel(t *testing.T) {
+	require.True(t, IsValidLogLevel("error"))
+	require.True(t, IsValidLogLevel("WARNING"))
+	require.False(t, IsValidLogLevel("verbose"))
+}
@@ -5,6 +5,7 @@ package logging
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -133,8 +134,9 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			tc.setUpMocks(mocks)
 
 			ew := &TaskClient{
-				groupName: groupName,
-				tasks:     tc.tasks,
+				logGroupName:    fmt.Sprintf(fmtTaskLogGroupName, groupName),
+				logStreamPrefix: fmt.Sprintf(fmtTaskLogStreamPrefix, groupName),
+				tasks:           tc.tasks,
 
 				eventsWriter:  mockWriter{},
 				eventsLogger:  mocks.logGetter,
@@ -34,6 +34,7 @@ type BackendServiceConfig struct {
 	Network          NetworkConfig             `yaml:"network"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Overrides        []OverrideRule            `yaml:"overrides"`
 	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
 }
@@ -119,6 +120,11 @@ func (s *BackendService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ReferencedSecrets returns the secrets referenced by the containers of the service.
+func (s *BackendService) ReferencedSecrets() []Secret {
+	return referencedSecrets(s.TaskConfig, s.Logging, s.Sidecars)
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -398,6 +398,42 @@ func TestBackendService_Publish(t *testing.T) {
 	}
 }
 
+func TestBackendService_ReferencedSecrets(t *testing.T) {
+	// GIVEN
+	mft := &BackendService{
+		BackendServiceConfig: BackendServiceConfig{
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"DB_PASSWORD": {from: stringP("/copilot/app/test/secrets/db_password")},
+				},
+			},
+			Logging: Logging{
+				SecretOptions: map[string]Secret{
+					"API_KEY": {fromSecretsManager: secretsManagerSecret{Name: stringP("logs-api-key")}},
+				},
+			},
+			Sidecars: map[string]*SidecarConfig{
+				"nginx": {
+					Secrets: map[string]Secret{
+						"TOKEN": {from: stringP("/copilot/app/test/secrets/token")},
+					},
+				},
+				"empty": nil,
+			},
+		},
+	}
+
+	// WHEN
+	secrets := mft.ReferencedSecrets()
+
+	// THEN
+	var values []string
+	for _, secret := range secrets {
+		values = append(values, secret.Value())
+	}
+	require.ElementsMatch(t, []string{"/copilot/app/test/secrets/db_password", "logs-api-key", "/copilot/app/test/secrets/token"}, values)
+}
+
 func TestBackendSvc_ApplyEnv(t *testing.T) {
 	perc := Percentage(70)
 	mockConfig := ScalingConfigOrT[Percentage]{
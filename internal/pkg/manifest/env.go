@@ -88,6 +88,7 @@ type environmentConfig struct {
 	Observability environmentObservability `yaml:"observability,omitempty,flow"`
 	HTTPConfig    environmentHTTPConfig    `yaml:"http,omitempty,flow"`
 	CDNConfig     environmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	Overrides     []OverrideRule           `yaml:"overrides,omitempty"`
 }
 
 type environmentNetworkConfig struct {
@@ -106,16 +107,78 @@ type environmentCDNConfig struct {
 }
 
 // advancedCDNConfig represents an advanced configuration for a Content Delivery Network.
-type advancedCDNConfig struct{}
+type advancedCDNConfig struct {
+	Certificate    *string                 `yaml:"certificate,omitempty"`
+	Aliases        []string                `yaml:"aliases,omitempty"`
+	PriceClass     *string                 `yaml:"price_class,omitempty"`
+	WebACL         *string                 `yaml:"web_acl,omitempty"`
+	HeaderPolicies cdnHeaderPolicies       `yaml:",inline"`
+	CacheBehaviors []cdnCacheBehavior      `yaml:"cache_behaviors,omitempty"`
+	StaticAssets   []cdnStaticAssetsConfig `yaml:"static_assets,omitempty"`
+}
+
+// cdnHeaderPolicies holds the IDs of the CloudFront policies that control the headers
+// forwarded to the origin and returned to the viewer.
+type cdnHeaderPolicies struct {
+	OriginRequestPolicy   *string `yaml:"origin_request_policy,omitempty"`
+	ResponseHeadersPolicy *string `yaml:"response_headers_policy,omitempty"`
+}
+
+// IsEmpty returns true if no header policies are configured.
+func (p *cdnHeaderPolicies) IsEmpty() bool {
+	return p.OriginRequestPolicy == nil && p.ResponseHeadersPolicy == nil
+}
+
+// cdnCacheBehavior represents the caching configuration of the requests to the load balancer
+// whose path matches the path pattern.
+type cdnCacheBehavior struct {
+	Path           *string           `yaml:"path,omitempty"`
+	CachePolicy    *string           `yaml:"cache_policy,omitempty"`
+	HeaderPolicies cdnHeaderPolicies `yaml:",inline"`
+}
+
+// cdnStaticAssetsConfig represents an S3 bucket that serves the requests whose path matches
+// the path pattern instead of the load balancer.
+// Copilot replaces the whole policy of the bucket with one that only lets the distribution of the environment
+// read its objects, so the bucket must not be shared with another environment.
+type cdnStaticAssetsConfig struct {
+	Location *string `yaml:"location,omitempty"`
+	Path     *string `yaml:"path,omitempty"`
+}
+
+// BucketName returns the name of the bucket from the S3 domain name of the location,
+// or an empty string if the location isn't the domain name of a bucket.
+func (cfg *cdnStaticAssetsConfig) BucketName() string {
+	match := s3BucketDomainRegexp.FindStringSubmatch(aws.StringValue(cfg.Location))
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
 
 // IsEmpty returns whether environmentCDNConfig is empty.
 func (cfg *environmentCDNConfig) IsEmpty() bool {
 	return cfg.Enabled == nil && cfg.CDNConfig.IsEmpty()
 }
 
-// IsEmpty is a no-op for advancedCDNConfig.
+// IsEmpty returns true if none of the advanced CDN fields are configured.
 func (cfg *advancedCDNConfig) IsEmpty() bool {
-	return true
+	return cfg.Certificate == nil && len(cfg.Aliases) == 0 && cfg.PriceClass == nil && cfg.WebACL == nil && cfg.HeaderPolicies.IsEmpty() &&
+		len(cfg.CacheBehaviors) == 0 && len(cfg.StaticAssets) == 0
+}
+
+// StaticAssetsBuckets returns the names of the buckets that store the static assets, without duplicates.
+// The policy of each bucket is replaced with one that lets the distribution read its objects.
+func (cfg *advancedCDNConfig) StaticAssetsBuckets() []string {
+	var buckets []string
+	seen := make(map[string]bool)
+	for _, assets := range cfg.StaticAssets {
+		if bucket := assets.BucketName(); !seen[bucket] {
+			seen[bucket] = true
+			buckets = append(buckets, bucket)
+		}
+	}
+	return buckets
 }
 
 // CDNEnabled returns whether a CDN configuration has been enabled in the environment manifest.
@@ -149,6 +212,15 @@ func (cfg *environmentCDNConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML serializes the environmentCDNConfig back to either a boolean or a composite-style map.
+// This method implements the yaml.Marshaler (v3) interface.
+func (cfg environmentCDNConfig) MarshalYAML() (interface{}, error) {
+	if !cfg.CDNConfig.IsEmpty() {
+		return cfg.CDNConfig, nil
+	}
+	return cfg.Enabled, nil
+}
+
 // IsEmpty returns true if vpc is not configured.
 func (cfg environmentVPCConfig) IsEmpty() bool {
 	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty()
@@ -420,6 +420,57 @@ cdn: true
 				},
 			},
 		},
+		"unmarshal with advanced content delivery network configuration": {
+			inContent: `name: prod
+type: Environment
+
+cdn:
+  certificate: arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn
+  price_class: PriceClass_100
+  web_acl: arn:aws:wafv2:us-east-1:1111111:global/webacl/mock-acl/1234
+  origin_request_policy: mock-origin-request-policy
+  cache_behaviors:
+    - path: /api/*
+      cache_policy: mock-cache-policy
+      response_headers_policy: mock-response-headers-policy
+  static_assets:
+    - location: mock-bucket.s3.us-west-2.amazonaws.com
+      path: static/*
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				environmentConfig: environmentConfig{
+					CDNConfig: environmentCDNConfig{
+						CDNConfig: advancedCDNConfig{
+							Certificate: aws.String("arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"),
+							PriceClass:  aws.String("PriceClass_100"),
+							WebACL:      aws.String("arn:aws:wafv2:us-east-1:1111111:global/webacl/mock-acl/1234"),
+							HeaderPolicies: cdnHeaderPolicies{
+								OriginRequestPolicy: aws.String("mock-origin-request-policy"),
+							},
+							CacheBehaviors: []cdnCacheBehavior{
+								{
+									Path:        aws.String("/api/*"),
+									CachePolicy: aws.String("mock-cache-policy"),
+									HeaderPolicies: cdnHeaderPolicies{
+										ResponseHeadersPolicy: aws.String("mock-response-headers-policy"),
+									},
+								},
+							},
+							StaticAssets: []cdnStaticAssetsConfig{
+								{
+									Location: aws.String("mock-bucket.s3.us-west-2.amazonaws.com"),
+									Path:     aws.String("static/*"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"unmarshal with http": {
 			inContent: `name: prod
 type: Environment
@@ -845,6 +896,64 @@ func TestEnvironmentCDNConfig_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestCDNStaticAssetsConfig_BucketName(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"regional domain name": {
+			in:     "mock-bucket.s3.us-west-2.amazonaws.com",
+			wanted: "mock-bucket",
+		},
+		"legacy global domain name": {
+			in:     "mock.bucket.s3.amazonaws.com",
+			wanted: "mock.bucket",
+		},
+		"china regions": {
+			in:     "mock-bucket.s3.cn-north-1.amazonaws.com.cn",
+			wanted: "mock-bucket",
+		},
+		"not an S3 domain name": {
+			in:     "example.com",
+			wanted: "",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := cdnStaticAssetsConfig{
+				Location: aws.String(tc.in),
+			}
+			require.Equal(t, tc.wanted, cfg.BucketName())
+		})
+	}
+}
+
+func TestAdvancedCDNConfig_StaticAssetsBuckets(t *testing.T) {
+	// GIVEN
+	cfg := advancedCDNConfig{
+		StaticAssets: []cdnStaticAssetsConfig{
+			{
+				Location: aws.String("images.s3.us-west-2.amazonaws.com"),
+				Path:     aws.String("/images/*"),
+			},
+			{
+				Location: aws.String("assets.s3.us-west-2.amazonaws.com"),
+				Path:     aws.String("/css/*"),
+			},
+			{
+				Location: aws.String("images.s3.us-west-2.amazonaws.com"),
+				Path:     aws.String("/icons/*"),
+			},
+		},
+	}
+
+	// WHEN
+	buckets := cfg.StaticAssetsBuckets()
+
+	// THEN
+	require.Equal(t, []string{"images", "assets"}, buckets)
+}
+
 func TestEnvironmentCDNConfig_CDNEnabled(t *testing.T) {
 	testCases := map[string]struct {
 		in     environmentCDNConfig
@@ -856,6 +965,14 @@ func TestEnvironmentCDNConfig_CDNEnabled(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"enabled via advanced configuration": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					PriceClass: aws.String("PriceClass_100"),
+				},
+			},
+			wanted: true,
+		},
 		"not enabled because empty": {
 			in:     environmentCDNConfig{},
 			wanted: false,
@@ -14,40 +14,128 @@ import (
 )
 
 const (
-	reservedEnvVarKeyForAppName = "COPILOT_APPLICATION_NAME"
-	reservedEnvVarKeyForEnvName = "COPILOT_ENVIRONMENT_NAME"
+	reservedEnvVarKeyForAppName   = "COPILOT_APPLICATION_NAME"
+	reservedEnvVarKeyForEnvName   = "COPILOT_ENVIRONMENT_NAME"
+	reservedEnvVarKeyForAccountID = "COPILOT_ACCOUNT_ID"
+	reservedEnvVarKeyForRegion    = "COPILOT_REGION"
+	reservedEnvVarKeyForGitSHA    = "COPILOT_GIT_SHA"
+)
+
+// Operators that can follow a variable name in a substitution, such as "${VAR:-default}".
+const (
+	interpolateOpDefault = ":-"
+	interpolateOpError   = ":?"
+
+	// interpolateEscapedDollar is replaced with a literal "$", so that "$${VAR}" is never substituted.
+	interpolateEscapedDollar = "$$"
 )
 
 var (
 	// Taken from docker/compose.
 	// Environment variable names consist solely of uppercase letters, digits, and underscore,
 	// and do not begin with a digit. （https://pubs.opengroup.org/onlinepubs/007904875/basedefs/xbd_chap08.html）
-	interpolatorEnvVarRegExp = regexp.MustCompile(`\${([_a-zA-Z][_a-zA-Z0-9]*)}`)
+	// There are three capture groups in this regex: the name of the variable, the optional operator, and the
+	// default value or error message that follows the operator.
+	interpolatorEnvVarRegExp = regexp.MustCompile(`\$\$|\${([_a-zA-Z][_a-zA-Z0-9]*)(?:(:[-?])([^}]*))?}`)
+	envVarNameRegExp         = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
 )
 
 // Interpolator substitutes variables in a manifest.
 type Interpolator struct {
 	predefinedEnvVars map[string]string
+	vars              map[string]string // Variables defined by the user other than the OS environment variables.
+}
+
+// InterpolatorOption is a functional option to configure the variables of an Interpolator.
+type InterpolatorOption func(*Interpolator)
+
+// WithAccountID sets the predefined variable COPILOT_ACCOUNT_ID to the ID of the environment's account.
+func WithAccountID(id string) InterpolatorOption {
+	return withPredefinedEnvVar(reservedEnvVarKeyForAccountID, id)
+}
+
+// WithRegion sets the predefined variable COPILOT_REGION to the region of the environment.
+func WithRegion(region string) InterpolatorOption {
+	return withPredefinedEnvVar(reservedEnvVarKeyForRegion, region)
+}
+
+// WithGitSHA sets the predefined variable COPILOT_GIT_SHA to the commit checked out in the workspace.
+func WithGitSHA(sha string) InterpolatorOption {
+	return withPredefinedEnvVar(reservedEnvVarKeyForGitSHA, sha)
+}
+
+// WithVariables adds variables, such as the ones read from a var file, that are substituted
+// when no OS environment variable with the same name is set.
+func WithVariables(vars map[string]string) InterpolatorOption {
+	return func(i *Interpolator) {
+		for k, v := range vars {
+			i.vars[k] = v
+		}
+	}
+}
+
+func withPredefinedEnvVar(key, value string) InterpolatorOption {
+	return func(i *Interpolator) {
+		if value == "" {
+			// Leave the variable undefined so that referring to it is reported.
+			return
+		}
+		i.predefinedEnvVars[key] = value
+	}
 }
 
 // NewInterpolator initiates a new Interpolator.
-func NewInterpolator(appName, envName string) *Interpolator {
-	return &Interpolator{
+func NewInterpolator(appName, envName string, opts ...InterpolatorOption) *Interpolator {
+	i := &Interpolator{
 		predefinedEnvVars: map[string]string{
 			reservedEnvVarKeyForAppName: appName,
 			reservedEnvVarKeyForEnvName: envName,
 		},
+		vars: make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// ErrInterpolate occurs when some variables of a manifest cannot be substituted.
+type ErrInterpolate struct {
+	errs []*errInterpolateVariable
+}
+
+type errInterpolateVariable struct {
+	line int // Line of the YAML node where the variable is referred to.
+	err  error
+}
+
+func (e *errInterpolateVariable) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.err)
+}
+
+// Error implements the error interface.
+func (e *ErrInterpolate) Error() string {
+	if len(e.errs) == 1 {
+		return e.errs[0].Error()
 	}
+	msgs := make([]string, len(e.errs))
+	for idx, err := range e.errs {
+		msgs[idx] = fmt.Sprintf("  %s", err.Error())
+	}
+	return fmt.Sprintf("%d variables cannot be substituted:\n%s", len(e.errs), strings.Join(msgs, "\n"))
 }
 
 // Interpolate substitutes environment variables in a string.
+// All the variables that cannot be substituted are reported at once in an *ErrInterpolate.
 func (i *Interpolator) Interpolate(s string) (string, error) {
 	content, err := unmarshalYAML([]byte(s))
 	if err != nil {
 		return "", err
 	}
-	if err := i.applyInterpolation(content); err != nil {
-		return "", err
+	if errs := i.applyInterpolation(content); len(errs) != 0 {
+		return "", &ErrInterpolate{
+			errs: errs,
+		}
 	}
 	out, err := marshalYAML(content)
 	if err != nil {
@@ -56,59 +144,114 @@ func (i *Interpolator) Interpolate(s string) (string, error) {
 	return string(out), nil
 }
 
-func (i *Interpolator) applyInterpolation(node *yaml.Node) error {
+func (i *Interpolator) applyInterpolation(node *yaml.Node) []*errInterpolateVariable {
+	var errs []*errInterpolateVariable
 	switch node.Tag {
 	case "!!map":
 		// The content of a map always come in pairs. If the node pair exists, return the map node.
 		// Note that the rest of code massively uses yaml node tree.
 		// Please refer to https://www.efekarakus.com/2020/05/30/deep-dive-go-yaml-cfn.html
 		for idx := 0; idx < len(node.Content); idx += 2 {
-			if err := i.applyInterpolation(node.Content[idx+1]); err != nil {
-				return err
-			}
+			errs = append(errs, i.applyInterpolation(node.Content[idx+1])...)
 		}
 	case "!!str":
 		interpolated, err := i.interpolatePart(node.Value)
-		if err != nil {
-			return err
+		for _, e := range err {
+			errs = append(errs, &errInterpolateVariable{
+				line: node.Line,
+				err:  e,
+			})
 		}
 		node.Value = interpolated
 	default:
 		for _, content := range node.Content {
-			if err := i.applyInterpolation(content); err != nil {
-				return err
-			}
+			errs = append(errs, i.applyInterpolation(content)...)
 		}
 	}
-	return nil
+	return errs
 }
 
-func (i *Interpolator) interpolatePart(s string) (string, error) {
-	matches := interpolatorEnvVarRegExp.FindAllStringSubmatch(s, -1)
-	if len(matches) == 0 {
-		return s, nil
+func (i *Interpolator) interpolatePart(s string) (string, []error) {
+	var errs []error
+	replaced := interpolatorEnvVarRegExp.ReplaceAllStringFunc(s, func(match string) string {
+		if match == interpolateEscapedDollar {
+			return "$"
+		}
+		// https://pkg.go.dev/regexp#Regexp.FindStringSubmatch
+		subMatches := interpolatorEnvVarRegExp.FindStringSubmatch(match)
+		key, op, word := subMatches[1], subMatches[2], subMatches[3]
+		val, isSet, err := i.lookup(key)
+		if err != nil {
+			errs = append(errs, err)
+			return match
+		}
+		switch op {
+		case interpolateOpDefault:
+			if !isSet || val == "" {
+				return word
+			}
+		case interpolateOpError:
+			if !isSet || val == "" {
+				if word == "" {
+					word = "is required"
+				}
+				errs = append(errs, fmt.Errorf(`environment variable "%s" %s`, key, word))
+				return match
+			}
+		default:
+			if !isSet {
+				errs = append(errs, fmt.Errorf(`environment variable "%s" is not defined`, key))
+				return match
+			}
+		}
+		return val
+	})
+	return replaced, errs
+}
+
+// lookup returns the value of a variable in order of precedence: predefined variables,
+// OS environment variables, and then variables defined by the user.
+func (i *Interpolator) lookup(key string) (val string, isSet bool, err error) {
+	predefinedVal, isPredefined := i.predefinedEnvVars[key]
+	osVal, isEnvVarSet := os.LookupEnv(key)
+	if isPredefined && isEnvVarSet && predefinedVal != osVal {
+		return "", false, fmt.Errorf(`predefined environment variable "%s" cannot be overridden by OS environment variable with the same name`, key)
 	}
-	replaced := s
-	for _, match := range matches {
-		// https://pkg.go.dev/regexp#Regexp.FindAllStringSubmatch
-		key := match[1]
-		currSegment := fmt.Sprintf("${%s}", key)
-		predefinedVal, isPredefined := i.predefinedEnvVars[key]
-		osVal, isEnvVarSet := os.LookupEnv(key)
-		if isPredefined && isEnvVarSet && predefinedVal != osVal {
-			return "", fmt.Errorf(`predefined environment variable "%s" cannot be overridden by OS environment variable with the same name`, key)
+	if isPredefined {
+		if _, ok := i.vars[key]; ok {
+			return "", false, fmt.Errorf(`predefined environment variable "%s" cannot be overridden by a variable with the same name`, key)
 		}
-		if isPredefined {
-			replaced = strings.ReplaceAll(replaced, currSegment, predefinedVal)
+		return predefinedVal, true, nil
+	}
+	if isEnvVarSet {
+		return osVal, true, nil
+	}
+	val, isSet = i.vars[key]
+	return val, isSet, nil
+}
+
+// ParseVarFile parses the content of a var file into variables that can be substituted in a manifest.
+// Each line of the file is either empty, a comment starting with "#", or a "KEY=VALUE" pair whose
+// value can be surrounded by quotes.
+func ParseVarFile(content []byte) (map[string]string, error) {
+	vars := make(map[string]string)
+	for idx, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		if isEnvVarSet {
-			replaced = strings.ReplaceAll(replaced, currSegment, osVal)
-			continue
+		key, val, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !envVarNameRegExp.MatchString(key) {
+			return nil, fmt.Errorf(`line %d: "%s" must be of the form KEY=VALUE, where KEY contains only letters, digits and underscores`, idx+1, line)
+		}
+		val = strings.TrimSpace(val)
+		if len(val) >= 2 && (val[0] == '"' || val[0] == '\'') && val[len(val)-1] == val[0] {
+			val = val[1 : len(val)-1]
 		}
-		return "", fmt.Errorf(`environment variable "%s" is not defined`, key)
+		vars[key] = val
 	}
-	return replaced, nil
+	return vars, nil
 }
 
 func unmarshalYAML(temp []byte) (*yaml.Node, error) {
@@ -14,6 +14,7 @@ import (
 func TestInterpolator_Interpolate(t *testing.T) {
 	testCases := map[string]struct {
 		inputEnvVar map[string]string
+		inputOpts   []InterpolatorOption
 		inputStr    string
 
 		wanted    string
@@ -22,7 +23,7 @@ func TestInterpolator_Interpolate(t *testing.T) {
 		"should return error if env var is not defined": {
 			inputStr: "/copilot/my-app/${env}/secrets/db_password",
 
-			wantedErr: fmt.Errorf(`environment variable "env" is not defined`),
+			wantedErr: fmt.Errorf(`line 1: environment variable "env" is not defined`),
 		},
 		"should return error if trying to override predefined env var": {
 			inputStr: "/copilot/my-app/${COPILOT_ENVIRONMENT_NAME}/secrets/db_password",
@@ -30,7 +31,70 @@ func TestInterpolator_Interpolate(t *testing.T) {
 				"COPILOT_ENVIRONMENT_NAME": "prod",
 			},
 
-			wantedErr: fmt.Errorf(`predefined environment variable "COPILOT_ENVIRONMENT_NAME" cannot be overridden by OS environment variable with the same name`),
+			wantedErr: fmt.Errorf(`line 1: predefined environment variable "COPILOT_ENVIRONMENT_NAME" cannot be overridden by OS environment variable with the same name`),
+		},
+		"should return error if trying to override predefined env var with a user defined variable": {
+			inputStr: "${COPILOT_REGION}",
+			inputOpts: []InterpolatorOption{
+				WithRegion("us-west-2"),
+				WithVariables(map[string]string{
+					"COPILOT_REGION": "us-east-1",
+				}),
+			},
+
+			wantedErr: fmt.Errorf(`line 1: predefined environment variable "COPILOT_REGION" cannot be overridden by a variable with the same name`),
+		},
+		"should report all variables that cannot be substituted with their line numbers": {
+			inputStr: `name: ${name}
+image:
+  location: ${repo}:${tag:-latest}
+variables:
+  LOG_LEVEL: ${LOG_LEVEL:?must be set to "info" or "debug"}
+  EMPTY: ${EMPTY:?}
+  GIT_SHA: ${COPILOT_GIT_SHA}`,
+			inputEnvVar: map[string]string{
+				"name":  "api",
+				"EMPTY": "",
+			},
+
+			wantedErr: fmt.Errorf(`4 variables cannot be substituted:
+  line 3: environment variable "repo" is not defined
+  line 5: environment variable "LOG_LEVEL" must be set to "info" or "debug"
+  line 6: environment variable "EMPTY" is required
+  line 7: environment variable "COPILOT_GIT_SHA" is not defined`),
+		},
+		"success with default values, escaped dollar signs, and extra variables": {
+			inputStr: `image:
+  location: ${COPILOT_ACCOUNT_ID}.dkr.ecr.${COPILOT_REGION}.amazonaws.com/api:${COPILOT_GIT_SHA}
+command: echo $${HOME} $$ $PATH
+variables:
+  LOG_LEVEL: ${LOG_LEVEL:-info}
+  EMPTY: ${EMPTY:-default}
+  OVERRIDDEN: ${OVERRIDDEN:?must be set}
+  FROM_FILE: ${FROM_FILE}`,
+			inputEnvVar: map[string]string{
+				"EMPTY":      "",
+				"OVERRIDDEN": "os",
+			},
+			inputOpts: []InterpolatorOption{
+				WithAccountID("123456789012"),
+				WithRegion("us-west-2"),
+				WithGitSHA("abc1234"),
+				WithVariables(map[string]string{
+					"OVERRIDDEN": "file",
+					"FROM_FILE":  "file",
+				}),
+			},
+
+			wanted: `image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api:abc1234
+command: echo ${HOME} $ $PATH
+variables:
+  LOG_LEVEL: info
+  EMPTY: default
+  OVERRIDDEN: os
+  FROM_FILE: file
+`,
 		},
 		"success with no matches": {
 			inputStr: "1234567890.dkr.ecr.us-west-2.amazonaws.com/vault/test:latest",
@@ -99,6 +163,7 @@ variables:
 			itpl := NewInterpolator(
 				"myApp",
 				"test",
+				tc.inputOpts...,
 			)
 			for k, v := range tc.inputEnvVar {
 				require.NoError(t, os.Setenv(k, v))
@@ -118,3 +183,54 @@ variables:
 		})
 	}
 }
+
+func TestParseVarFile(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wanted    map[string]string
+		wantedErr error
+	}{
+		"should return error if a line is not a key value pair": {
+			in: `TAG=latest
+# The repository of the image.
+REPO`,
+
+			wantedErr: fmt.Errorf(`line 3: "REPO" must be of the form KEY=VALUE, where KEY contains only letters, digits and underscores`),
+		},
+		"should return error if a key is invalid": {
+			in: `0TAG=latest`,
+
+			wantedErr: fmt.Errorf(`line 1: "0TAG=latest" must be of the form KEY=VALUE, where KEY contains only letters, digits and underscores`),
+		},
+		"success": {
+			in: `# Variables for the test environment.
+TAG=latest
+
+LOG_LEVEL = "debug"
+GREETING='hello world'
+QUERY=a=b
+EMPTY=`,
+
+			wanted: map[string]string{
+				"TAG":       "latest",
+				"LOG_LEVEL": "debug",
+				"GREETING":  "hello world",
+				"QUERY":     "a=b",
+				"EMPTY":     "",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseVarFile([]byte(tc.in))
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -16,6 +16,9 @@ const (
 
 const (
 	scheduledJobManifestPath = "workloads/jobs/scheduled-job/manifest.yml"
+
+	// jobScheduleNone is the legacy schedule of a job that is only run manually.
+	jobScheduleNone = "none"
 )
 
 // JobTypes returns the list of supported job manifest types.
@@ -47,11 +50,33 @@ type ScheduledJobConfig struct {
 	Network                 NetworkConfig  `yaml:"network"`
 	PublishConfig           PublishConfig  `yaml:"publish"`
 	TaskDefOverrides        []OverrideRule `yaml:"taskdef_overrides"`
+	Overrides               []OverrideRule `yaml:"overrides"`
 }
 
-// JobTriggerConfig represents the configuration for the event that triggers the job.
+// JobTriggerConfig represents the configuration for the events that trigger the job.
+// A job without any trigger can only be run manually.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule  *string           `yaml:"schedule"`
+	Schedules []string          `yaml:"schedules"`
+	Events    []JobEventTrigger `yaml:"events"`
+}
+
+// JobEventTrigger represents an EventBridge event pattern that triggers the job.
+type JobEventTrigger struct {
+	Bus     *string                `yaml:"bus"` // Name or ARN of the event bus. Defaults to the default event bus of the account.
+	Pattern map[string]interface{} `yaml:"pattern"`
+}
+
+// ScheduleExpressions returns the schedules that trigger the job.
+// The legacy "none" schedule is omitted, as it is equivalent to not having a schedule.
+func (c JobTriggerConfig) ScheduleExpressions() []string {
+	if c.Schedule != nil {
+		if aws.StringValue(c.Schedule) == jobScheduleNone {
+			return nil
+		}
+		return []string{aws.StringValue(c.Schedule)}
+	}
+	return c.Schedules
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -150,6 +175,11 @@ func (j *ScheduledJob) EnvFile() string {
 	return aws.StringValue(j.TaskConfig.EnvFile)
 }
 
+// ReferencedSecrets returns the secrets referenced by the containers of the job.
+func (j *ScheduledJob) ReferencedSecrets() []Secret {
+	return referencedSecrets(j.TaskConfig, j.Logging, j.Sidecars)
+}
+
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
 func newDefaultScheduledJob() *ScheduledJob {
 	return &ScheduledJob{
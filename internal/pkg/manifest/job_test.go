@@ -371,6 +371,53 @@ func TestScheduledJob_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with schedule overridden by schedules and events": {
+			inputManifest: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("report-generator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedule: aws.String("@daily"),
+					},
+				},
+				Environments: map[string]*ScheduledJobConfig{
+					"prod": {
+						On: JobTriggerConfig{
+							Schedules: []string{"@hourly", "0 9 * * 1-5"},
+							Events: []JobEventTrigger{
+								{
+									Pattern: map[string]interface{}{
+										"source": []interface{}{"aws.s3"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			inputEnv: "prod",
+
+			wantedManifest: &ScheduledJob{
+				Workload: Workload{
+					Name: aws.String("report-generator"),
+					Type: aws.String(ScheduledJobType),
+				},
+				ScheduledJobConfig: ScheduledJobConfig{
+					On: JobTriggerConfig{
+						Schedules: []string{"@hourly", "0 9 * * 1-5"},
+						Events: []JobEventTrigger{
+							{
+								Pattern: map[string]interface{}{
+									"source": []interface{}{"aws.s3"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -500,3 +547,46 @@ func TestScheduledJob_Publish(t *testing.T) {
 		})
 	}
 }
+
+func TestJobTriggerConfig_ScheduleExpressions(t *testing.T) {
+	testCases := map[string]struct {
+		in JobTriggerConfig
+
+		wantedSchedules []string
+	}{
+		"manual job without any trigger": {},
+		"manual job with the legacy none schedule": {
+			in: JobTriggerConfig{
+				Schedule: aws.String("none"),
+			},
+		},
+		"single schedule": {
+			in: JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+			},
+			wantedSchedules: []string{"@daily"},
+		},
+		"multiple schedules": {
+			in: JobTriggerConfig{
+				Schedules: []string{"@daily", "@every 1h"},
+			},
+			wantedSchedules: []string{"@daily", "@every 1h"},
+		},
+		"event triggers only": {
+			in: JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"source": []interface{}{"aws.s3"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedSchedules, tc.in.ScheduleExpressions())
+		})
+	}
+}
@@ -59,6 +59,7 @@ type LoadBalancedWebServiceConfig struct {
 	Network          NetworkConfig                    `yaml:"network"`
 	PublishConfig    PublishConfig                    `yaml:"publish"`
 	TaskDefOverrides []OverrideRule                   `yaml:"taskdef_overrides"`
+	Overrides        []OverrideRule                   `yaml:"overrides"`
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	DeployConfig     DeploymentConfiguration          `yaml:"deployment"`
 	Observability    Observability                    `yaml:"observability"`
@@ -190,6 +191,11 @@ func (s *LoadBalancedWebService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ReferencedSecrets returns the secrets referenced by the containers of the service.
+func (s *LoadBalancedWebService) ReferencedSecrets() []Secret {
+	return referencedSecrets(s.TaskConfig, s.Logging, s.Sidecars)
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
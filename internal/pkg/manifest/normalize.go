@@ -0,0 +1,216 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var yamlMarshalerType = reflect.TypeOf((*yaml.Marshaler)(nil)).Elem()
+
+// MarshalNormalized serializes a manifest, typically after its environment overrides are applied,
+// into a canonical YAML document that can be compared field by field with another manifest.
+//
+// Unlike yaml.Marshal, fields that are not set are omitted, and fields that accept multiple types
+// are written with the type that is set, like "count: 1" instead of "count: {value: 1, advancedcount: {}}".
+func MarshalNormalized(mft interface{}) ([]byte, error) {
+	node, err := normalizedNode(reflect.ValueOf(mft), false)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return nil, nil
+	}
+	out, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, fmt.Errorf("marshal normalized manifest: %w", err)
+	}
+	return out, nil
+}
+
+// normalizedNode returns the YAML node for the value, or nil if the value is not set.
+// A zero value is considered set only if it was explicitly referenced by a pointer.
+func normalizedNode(v reflect.Value, explicit bool) (*yaml.Node, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+	}
+	if v.CanInterface() && v.Type().Implements(yamlMarshalerType) {
+		out, err := v.Interface().(yaml.Marshaler).MarshalYAML()
+		if err != nil {
+			return nil, err
+		}
+		return normalizedNode(reflect.ValueOf(out), explicit)
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return normalizedNode(v.Elem(), true)
+	case reflect.Struct:
+		return normalizedStruct(v)
+	case reflect.Map:
+		return normalizedMap(v)
+	case reflect.Slice, reflect.Array:
+		return normalizedSequence(v)
+	}
+	if v.IsZero() && !explicit {
+		return nil, nil
+	}
+	return scalarNode(v)
+}
+
+func scalarNode(v reflect.Value) (*yaml.Node, error) {
+	var val interface{}
+	switch {
+	case v.CanInterface():
+		val = v.Interface()
+	case v.Kind() == reflect.String:
+		// Fields promoted from unexported embedded structs can't be accessed with Interface.
+		val = v.String()
+	case v.Kind() == reflect.Bool:
+		val = v.Bool()
+	case v.CanInt():
+		val = v.Int()
+	case v.CanUint():
+		val = v.Uint()
+	case v.CanFloat():
+		val = v.Float()
+	default:
+		return nil, fmt.Errorf("unsupported type %s", v.Type())
+	}
+	node := &yaml.Node{}
+	if err := node.Encode(val); err != nil {
+		return nil, fmt.Errorf("encode %v: %w", val, err)
+	}
+	return node, nil
+}
+
+// normalizedStruct returns a mapping node for structs with YAML tags.
+// Structs without any tags hold alternative types for the same field, so only the node of the one that is set is returned.
+func normalizedStruct(v reflect.Value) (*yaml.Node, error) {
+	var fields []int
+	var isUnion = true
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+		if _, ok := field.Tag.Lookup("yaml"); ok {
+			isUnion = false
+		}
+		fields = append(fields, i)
+	}
+	if isUnion {
+		// If more than one alternative is set, like a default "count" along with an advanced one, the advanced configuration wins.
+		var set *yaml.Node
+		for _, i := range fields {
+			node, err := normalizedNode(v.Field(i), false)
+			if err != nil {
+				return nil, err
+			}
+			if node == nil {
+				continue
+			}
+			if node.Kind == yaml.MappingNode {
+				return node, nil
+			}
+			if set == nil {
+				set = node
+			}
+		}
+		return set, nil
+	}
+	mapping := &yaml.Node{
+		Kind: yaml.MappingNode,
+	}
+	for _, i := range fields {
+		field := v.Type().Field(i)
+		opts := strings.Split(field.Tag.Get("yaml"), ",")
+		name := opts[0]
+		if name == "-" {
+			continue
+		}
+		node, err := normalizedNode(v.Field(i), false)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			continue
+		}
+		if isInline(field, opts) && node.Kind == yaml.MappingNode {
+			mapping.Content = append(mapping.Content, node.Content...)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, node)
+	}
+	if len(mapping.Content) == 0 {
+		return nil, nil
+	}
+	return mapping, nil
+}
+
+func isInline(field reflect.StructField, tagOpts []string) bool {
+	for _, opt := range tagOpts[1:] {
+		if opt == "inline" {
+			return true
+		}
+	}
+	return field.Anonymous && tagOpts[0] == ""
+}
+
+func normalizedMap(v reflect.Value) (*yaml.Node, error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	mapping := &yaml.Node{
+		Kind: yaml.MappingNode,
+	}
+	for _, key := range keys {
+		node, err := normalizedNode(v.MapIndex(key), true)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			continue
+		}
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: fmt.Sprint(key.Interface())}, node)
+	}
+	if len(mapping.Content) == 0 {
+		return nil, nil
+	}
+	return mapping, nil
+}
+
+func normalizedSequence(v reflect.Value) (*yaml.Node, error) {
+	if v.Len() == 0 {
+		return nil, nil
+	}
+	seq := &yaml.Node{
+		Kind: yaml.SequenceNode,
+	}
+	for i := 0; i < v.Len(); i++ {
+		node, err := normalizedNode(v.Index(i), true)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			node = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}
+		}
+		seq.Content = append(seq.Content, node)
+	}
+	return seq, nil
+}
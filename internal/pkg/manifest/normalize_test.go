@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalNormalized(t *testing.T) {
+	testCases := map[string]struct {
+		in         string
+		envToApply string
+
+		wanted string
+	}{
+		"writes only the fields that are set with the type that is set": {
+			in: `name: fe
+type: Backend Service
+image:
+  build: ./Dockerfile
+  port: 80
+entrypoint: ["/bin/sh", "-c"]
+command: run
+count:
+  range: 1-10
+  cpu_percentage: 70
+secrets:
+  DB: /db/pass
+  TOKEN:
+    secretsmanager: token
+`,
+			wanted: `name: fe
+type: Backend Service
+image:
+    build: ./Dockerfile
+    port: 80
+entrypoint:
+    - /bin/sh
+    - -c
+command: run
+cpu: 256
+memory: 512
+count:
+    range: 1-10
+    cpu_percentage: 70
+exec: false
+secrets:
+    DB: /db/pass
+    TOKEN:
+        secretsmanager: token
+network:
+    vpc:
+        placement: public
+`,
+		},
+		"writes the environment overrides": {
+			in: `name: fe
+type: Backend Service
+image:
+  location: nginx
+exec: true
+count: 1
+environments:
+  prod:
+    count: 3
+    exec: false
+`,
+			envToApply: "prod",
+			wanted: `name: fe
+type: Backend Service
+image:
+    location: nginx
+cpu: 256
+memory: 512
+count: 3
+exec: false
+network:
+    vpc:
+        placement: public
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := UnmarshalWorkload([]byte(tc.in))
+			require.NoError(t, err)
+			if tc.envToApply != "" {
+				mft, err = mft.ApplyEnv(tc.envToApply)
+				require.NoError(t, err)
+			}
+
+			// WHEN
+			out, err := MarshalNormalized(mft)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
+
+func TestMarshalNormalized_Environment(t *testing.T) {
+	// GIVEN
+	mft, err := UnmarshalEnvironment([]byte(`name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-123
+cdn: true
+observability:
+  container_insights: false
+`))
+	require.NoError(t, err)
+
+	// WHEN
+	out, err := MarshalNormalized(mft)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `name: test
+type: Environment
+network:
+    vpc:
+        id: vpc-123
+observability:
+    container_insights: false
+cdn: true
+`, string(out))
+}
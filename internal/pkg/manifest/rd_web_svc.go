@@ -33,6 +33,7 @@ type RequestDrivenWebServiceConfig struct {
 	PublishConfig                     PublishConfig                        `yaml:"publish"`
 	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	Observability                     Observability                        `yaml:"observability"`
+	Overrides                         []OverrideRule                       `yaml:"overrides"`
 }
 
 // Observability holds configuration for observability to the service.
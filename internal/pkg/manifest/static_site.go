@@ -0,0 +1,131 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	staticSiteManifestPath = "workloads/services/static-site/manifest.yml"
+
+	defaultStaticSiteIndexDocument = "index.html"
+)
+
+// StaticSite holds the configuration to create a static site service manifest.
+type StaticSite struct {
+	Workload         `yaml:",inline"`
+	StaticSiteConfig `yaml:",inline"`
+	// Use *StaticSiteConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*StaticSiteConfig `yaml:",flow"`
+
+	parser template.Parser
+}
+
+// StaticSiteConfig holds the configuration that can be overridden per environments.
+type StaticSiteConfig struct {
+	HTTP          StaticSiteHTTP     `yaml:"http"`
+	Source        StaticSiteSource   `yaml:"source"`
+	IndexDocument *string            `yaml:"index_document"`
+	ErrorDocument *string            `yaml:"error_document"`
+	CacheControl  []CacheControlRule `yaml:"cache_control"`
+	Tags          map[string]string  `yaml:"tags"`
+	Overrides     []OverrideRule     `yaml:"overrides"`
+}
+
+// StaticSiteHTTP holds the configuration for the custom domain of a static site.
+type StaticSiteHTTP struct {
+	Alias       *string `yaml:"alias"`
+	Certificate *string `yaml:"certificate"` // ARN of an ACM certificate in us-east-1 that covers the alias.
+	HostedZone  *string `yaml:"hosted_zone"` // ID of the hosted zone where an alias record for the distribution is created.
+}
+
+// IsEmpty returns true if the custom domain is not configured.
+func (h *StaticSiteHTTP) IsEmpty() bool {
+	return h.Alias == nil && h.Certificate == nil && h.HostedZone == nil
+}
+
+// StaticSiteSource holds the location of the files to upload for a static site.
+type StaticSiteSource struct {
+	Path  *string `yaml:"path"`  // Path to the directory to upload, relative to the workspace root.
+	Build *string `yaml:"build"` // Optional command to run from the workspace root before uploading the directory.
+}
+
+// CacheControlRule sets the Cache-Control header of the files matching a glob.
+type CacheControlRule struct {
+	Glob  *string `yaml:"glob"`
+	Value *string `yaml:"value"`
+}
+
+// StaticSiteProps represents the configuration needed to create a static site.
+type StaticSiteProps struct {
+	Name string
+	Path string // Path to the directory to upload, relative to the workspace root.
+}
+
+// NewStaticSite applies the props to a default static site configuration and returns it.
+func NewStaticSite(props StaticSiteProps) *StaticSite {
+	svc := newDefaultStaticSite()
+	// Apply overrides.
+	svc.Name = stringP(props.Name)
+	svc.Source.Path = stringP(props.Path)
+	svc.parser = template.New()
+	return svc
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (s *StaticSite) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(staticSiteManifestPath, *s)
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// ApplyEnv returns the service manifest with environment overrides.
+// If the environment passed in does not have any overrides then it returns itself.
+func (s StaticSite) ApplyEnv(envName string) (WorkloadManifest, error) {
+	overrideConfig, ok := s.Environments[envName]
+	if !ok {
+		return &s, nil
+	}
+
+	if overrideConfig == nil {
+		return &s, nil
+	}
+
+	// Apply overrides to the original service s.
+	for _, t := range defaultTransformers {
+		err := mergo.Merge(&s, StaticSite{
+			StaticSiteConfig: *overrideConfig,
+		}, mergo.WithOverride, mergo.WithTransformers(t))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.Environments = nil
+	return &s, nil
+}
+
+// RequiredEnvironmentFeatures returns environment features that are required for this manifest.
+// A static site does not run in the environment's network, so it doesn't require any feature.
+func (s *StaticSite) RequiredEnvironmentFeatures() []string {
+	return nil
+}
+
+// newDefaultStaticSite returns a static site that serves "index.html" as its index document.
+func newDefaultStaticSite() *StaticSite {
+	return &StaticSite{
+		Workload: Workload{
+			Type: aws.String(StaticSiteType),
+		},
+		StaticSiteConfig: StaticSiteConfig{
+			IndexDocument: aws.String(defaultStaticSiteIndexDocument),
+		},
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStaticSite(t *testing.T) {
+	// WHEN
+	got := NewStaticSite(StaticSiteProps{
+		Name: "frontend",
+		Path: "public",
+	})
+
+	// THEN
+	require.Equal(t, &StaticSite{
+		Workload: Workload{
+			Name: aws.String("frontend"),
+			Type: aws.String(StaticSiteType),
+		},
+		StaticSiteConfig: StaticSiteConfig{
+			Source: StaticSiteSource{
+				Path: aws.String("public"),
+			},
+			IndexDocument: aws.String("index.html"),
+		},
+		parser: template.New(),
+	}, got)
+}
+
+func TestStaticSite_MarshalBinary(t *testing.T) {
+	// GIVEN
+	wantedBytes, err := ioutil.ReadFile(filepath.Join("testdata", "static-site.yml"))
+	require.NoError(t, err)
+	mft := NewStaticSite(StaticSiteProps{
+		Name: "frontend",
+		Path: "public",
+	})
+
+	// WHEN
+	tpl, err := mft.MarshalBinary()
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, string(wantedBytes), string(tpl))
+}
+
+func TestStaticSite_UnmarshalWorkload(t *testing.T) {
+	// GIVEN
+	in := []byte(`
+name: frontend
+type: Static Site
+source:
+  path: dist
+  build: npm run build
+error_document: 404.html
+cache_control:
+  - glob: "*.html"
+    value: no-cache
+environments:
+  prod:
+    http:
+      alias: www.example.com
+      certificate: arn:aws:acm:us-east-1:123456789012:certificate/abc
+`)
+
+	// WHEN
+	got, err := UnmarshalWorkload(in)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, &StaticSite{
+		Workload: Workload{
+			Name: aws.String("frontend"),
+			Type: aws.String(StaticSiteType),
+		},
+		StaticSiteConfig: StaticSiteConfig{
+			Source: StaticSiteSource{
+				Path:  aws.String("dist"),
+				Build: aws.String("npm run build"),
+			},
+			IndexDocument: aws.String("index.html"),
+			ErrorDocument: aws.String("404.html"),
+			CacheControl: []CacheControlRule{
+				{
+					Glob:  aws.String("*.html"),
+					Value: aws.String("no-cache"),
+				},
+			},
+		},
+		Environments: map[string]*StaticSiteConfig{
+			"prod": {
+				HTTP: StaticSiteHTTP{
+					Alias:       aws.String("www.example.com"),
+					Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+				},
+			},
+		},
+	}, got)
+}
+
+func TestStaticSite_ApplyEnv(t *testing.T) {
+	mft := StaticSite{
+		Workload: Workload{
+			Name: aws.String("frontend"),
+			Type: aws.String(StaticSiteType),
+		},
+		StaticSiteConfig: StaticSiteConfig{
+			Source: StaticSiteSource{
+				Path: aws.String("public"),
+			},
+			IndexDocument: aws.String("index.html"),
+			CacheControl: []CacheControlRule{
+				{
+					Glob:  aws.String("*"),
+					Value: aws.String("no-cache"),
+				},
+			},
+		},
+		Environments: map[string]*StaticSiteConfig{
+			"prod": {
+				HTTP: StaticSiteHTTP{
+					Alias:       aws.String("www.example.com"),
+					Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+				},
+				CacheControl: []CacheControlRule{
+					{
+						Glob:  aws.String("*"),
+						Value: aws.String("max-age=300"),
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inEnvName string
+
+		wanted *StaticSite
+	}{
+		"no env override": {
+			inEnvName: "test",
+			wanted:    &mft,
+		},
+		"with overrides": {
+			inEnvName: "prod",
+			wanted: &StaticSite{
+				Workload: Workload{
+					Name: aws.String("frontend"),
+					Type: aws.String(StaticSiteType),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					HTTP: StaticSiteHTTP{
+						Alias:       aws.String("www.example.com"),
+						Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					IndexDocument: aws.String("index.html"),
+					CacheControl: []CacheControlRule{
+						{
+							Glob:  aws.String("*"),
+							Value: aws.String("max-age=300"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := mft.ApplyEnv(tc.inEnvName)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -24,6 +24,8 @@ const (
 	BackendServiceType = "Backend Service"
 	// WorkerServiceType is a worker service that manages the consumption of messages.
 	WorkerServiceType = "Worker Service"
+	// StaticSiteType is a static website whose files are stored in Amazon S3 and served by Amazon CloudFront.
+	StaticSiteType = "Static Site"
 )
 
 // ServiceTypes returns the list of supported service manifest types.
@@ -33,6 +35,7 @@ func ServiceTypes() []string {
 		LoadBalancedWebServiceType,
 		BackendServiceType,
 		WorkerServiceType,
+		StaticSiteType,
 	}
 }
 
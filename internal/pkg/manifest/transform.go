@@ -37,6 +37,7 @@ var defaultTransformers = []mergo.Transformers{
 	routingRuleConfigOrBoolTransformer{},
 	secretTransformer{},
 	environmentCDNConfigTransformer{},
+	jobTriggerConfigTransformer{},
 }
 
 // See a complete list of `reflect.Kind` here: https://pkg.go.dev/reflect#Kind.
@@ -517,6 +518,32 @@ func (t environmentCDNConfigTransformer) Transformer(typ reflect.Type) func(dst,
 	}
 }
 
+type jobTriggerConfigTransformer struct{}
+
+// Transformer returns custom merge logic for JobTriggerConfig's fields.
+func (t jobTriggerConfigTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(JobTriggerConfig{}) {
+		return nil
+	}
+
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(JobTriggerConfig), src.Interface().(JobTriggerConfig)
+
+		if srcStruct.Schedule != nil {
+			dstStruct.Schedules = nil
+		}
+
+		if srcStruct.Schedules != nil {
+			dstStruct.Schedule = nil
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type basicTransformer struct{}
 
 // Transformer returns custom merge logic for volume's fields.
@@ -1133,7 +1133,9 @@ func TestEnvironmentCDNConfigTransformer_Transformer(t *testing.T) {
 	}{
 		"cdnconfig set to empty if enabled is not nil": {
 			original: func(cfg *environmentCDNConfig) {
-				cfg.CDNConfig = advancedCDNConfig{} // Need to update with advanced fields when AdvancedCDNConfig struct is not empty
+				cfg.CDNConfig = advancedCDNConfig{
+					PriceClass: aws.String("PriceClass_100"),
+				}
 			},
 			override: func(cfg *environmentCDNConfig) {
 				cfg.Enabled = aws.Bool(true)
@@ -1142,6 +1144,21 @@ func TestEnvironmentCDNConfigTransformer_Transformer(t *testing.T) {
 				cfg.Enabled = aws.Bool(true)
 			},
 		},
+		"enabled set to nil if cdnconfig is not empty": {
+			original: func(cfg *environmentCDNConfig) {
+				cfg.Enabled = aws.Bool(true)
+			},
+			override: func(cfg *environmentCDNConfig) {
+				cfg.CDNConfig = advancedCDNConfig{
+					WebACL: aws.String("arn:aws:wafv2:us-east-1:1111111:global/webacl/mock-acl/1234"),
+				}
+			},
+			wanted: func(cfg *environmentCDNConfig) {
+				cfg.CDNConfig = advancedCDNConfig{
+					WebACL: aws.String("arn:aws:wafv2:us-east-1:1111111:global/webacl/mock-acl/1234"),
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1165,3 +1182,55 @@ func TestEnvironmentCDNConfigTransformer_Transformer(t *testing.T) {
 		})
 	}
 }
+
+func TestJobTriggerConfigTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(cfg *JobTriggerConfig)
+		override func(cfg *JobTriggerConfig)
+		wanted   func(cfg *JobTriggerConfig)
+	}{
+		"schedules set to empty if schedule is not nil": {
+			original: func(cfg *JobTriggerConfig) {
+				cfg.Schedules = []string{"@hourly", "@daily"}
+			},
+			override: func(cfg *JobTriggerConfig) {
+				cfg.Schedule = aws.String("@weekly")
+			},
+			wanted: func(cfg *JobTriggerConfig) {
+				cfg.Schedule = aws.String("@weekly")
+			},
+		},
+		"schedule set to nil if schedules is not nil": {
+			original: func(cfg *JobTriggerConfig) {
+				cfg.Schedule = aws.String("@weekly")
+			},
+			override: func(cfg *JobTriggerConfig) {
+				cfg.Schedules = []string{"@hourly", "@daily"}
+			},
+			wanted: func(cfg *JobTriggerConfig) {
+				cfg.Schedules = []string{"@hourly", "@daily"}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted JobTriggerConfig
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use custom transformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(jobTriggerConfigTransformer{}))
+			require.NoError(t, err)
+
+			require.NoError(t, err)
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
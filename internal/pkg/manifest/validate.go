@@ -7,15 +7,21 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
+	"github.com/aws/copilot-cli/internal/pkg/template/override"
 	"github.com/dustin/go-humanize/english"
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -30,8 +36,25 @@ const (
 	ephemeralMaxValueGiB = 200
 
 	envFileExt = ".env"
+
+	// Min traffic percentages shifted at each step of a deployment, and max time to wait between steps.
+	minCanaryTrafficShiftPercent = 1
+	minLinearTrafficShiftPercent = 3
+	maxDeploymentWaitTime        = 24 * time.Hour
+
+	// Max number of load balancer target groups an ECS service can be registered with.
+	maxTargetGroupsPerService = 5
+
+	// Prefix of a cron schedule that runs a job at a fixed interval, such as "@every 1h30m".
+	jobScheduleEveryPrefix = "@every "
 )
 
+// eventPatternFields are the top-level fields of an event that an EventBridge event pattern can match.
+var eventPatternFields = []string{"version", "id", "detail-type", "source", "account", "time", "region", "resources", "detail"}
+
+// eventPatternOr is the field of an event pattern that matches an event if any of the patterns in its list match.
+const eventPatternOr = "$or"
+
 const (
 	// Protocols.
 	TCP = "TCP"
@@ -48,16 +71,28 @@ var (
 	awsNameRegexp       = regexp.MustCompile(`^[a-z][a-z0-9\-]+$`) // Validates that an expression starts with a letter and only contains letters, numbers, and hyphens.
 	punctuationRegExp   = regexp.MustCompile(`[\.\-]{2,}`)         // Check for consecutive periods or dashes.
 	trailingPunctRegExp = regexp.MustCompile(`[\-\.]$`)            // Check for trailing dash or dot.
+	cfnOutputNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)     // Validates that an expression is a valid CloudFormation logical ID.
+
+	awsScheduleRegexp = regexp.MustCompile(`^(?:rate|cron)\(.*\)$`) // Validates that an expression is an AWS schedule expression, such as rate(5 minutes).
 
 	essentialContainerDependsOnValidStatuses = []string{dependsOnStart, dependsOnHealthy}
 	dependsOnValidStatuses                   = []string{dependsOnStart, dependsOnComplete, dependsOnSuccess, dependsOnHealthy}
 	nlbValidProtocols                        = []string{TCP, tls}
 	TracingValidVendors                      = []string{awsXRAY}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	ecsTrafficShiftingStrategies             = []string{ECSCanaryDeploymentStrategy, ECSLinearDeploymentStrategy, ECSBlueGreenDeploymentStrategy}
 
 	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
 
-	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
+	invalidTaskDefOverridePathRegexp = []string{`Family`, `ContainerDefinitions\[\d+\].Name`, `ContainerDefinitions\[[a-zA-Z0-9_-]+=[^\[\]]+\].Name`}
+
+	// cfnTemplateSections are the top-level sections of a CloudFormation template that can be overridden.
+	cfnTemplateSections = []string{
+		"AWSTemplateFormatVersion", "Description", "Metadata", "Parameters", "Rules",
+		"Mappings", "Conditions", "Transform", "Resources", "Outputs",
+	}
+	// cfnTemplateDefinitionSections are the sections of a CloudFormation template whose logical IDs are defined by a map.
+	cfnTemplateDefinitionSections = []string{"Parameters", "Resources", "Outputs"}
 )
 
 // Validate returns nil if LoadBalancedWebService is configured correctly.
@@ -76,6 +111,22 @@ func (l LoadBalancedWebService) Validate() error {
 	}); err != nil {
 		return fmt.Errorf("validate HTTP load balancer target: %w", err)
 	}
+	for ind, rule := range l.RoutingRule.AdditionalRoutingRules {
+		if err = validateTargetContainer(validateTargetContainerOpts{
+			mainContainerName: aws.StringValue(l.Name),
+			targetContainer:   rule.GetTargetContainer(),
+			sidecarConfig:     l.Sidecars,
+		}); err != nil {
+			return fmt.Errorf(`validate HTTP load balancer target for "http.additional_rules[%d]": %w`, ind, err)
+		}
+	}
+	targetGroups := 1 + len(l.RoutingRule.AdditionalRoutingRules)
+	if !l.NLBConfig.IsEmpty() {
+		targetGroups++
+	}
+	if targetGroups > maxTargetGroupsPerService {
+		return fmt.Errorf(`a service can be registered with at most %d target groups including the one of "nlb", but %d are required by "http.additional_rules"`, maxTargetGroupsPerService, targetGroups)
+	}
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(l.Name),
 		targetContainer:   l.NLBConfig.TargetContainer,
@@ -98,14 +149,86 @@ func (d DeploymentConfiguration) Validate() error {
 	if d.isEmpty() {
 		return nil
 	}
-	for _, validStrategy := range ecsRollingUpdateStrategies {
-		if strings.EqualFold(aws.StringValue(d.Rolling), validStrategy) {
-			return nil
+	if d.Rolling != nil && d.Strategy != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "rolling",
+			secondField: "strategy",
 		}
 	}
-	return fmt.Errorf("invalid rolling deployment strategy %s, must be one of %s",
-		aws.StringValue(d.Rolling),
-		english.WordSeries(ecsRollingUpdateStrategies, "or"))
+	if d.Rolling != nil && !contains(strings.ToLower(aws.StringValue(d.Rolling)), ecsRollingUpdateStrategies) {
+		return fmt.Errorf("invalid rolling deployment strategy %s, must be one of %s",
+			aws.StringValue(d.Rolling),
+			english.WordSeries(ecsRollingUpdateStrategies, "or"))
+	}
+	if d.Strategy != nil && !contains(strings.ToLower(aws.StringValue(d.Strategy)), ecsTrafficShiftingStrategies) {
+		return fmt.Errorf("invalid deployment strategy %s, must be one of %s",
+			aws.StringValue(d.Strategy),
+			english.WordSeries(ecsTrafficShiftingStrategies, "or"))
+	}
+	if d.BakeTime != nil {
+		if d.Strategy == nil {
+			return &errFieldMustBeSpecified{
+				missingField:      "strategy",
+				conditionalFields: []string{"bake_time"},
+			}
+		}
+		if err := validateDeploymentWaitTime(*d.BakeTime); err != nil {
+			return fmt.Errorf(`validate "bake_time": %w`, err)
+		}
+	}
+	if err := d.TrafficShift.validate(aws.StringValue(d.Strategy)); err != nil {
+		return fmt.Errorf(`validate "traffic_shift": %w`, err)
+	}
+	for i, alarm := range d.RollbackAlarms {
+		if alarm == "" {
+			return fmt.Errorf(`validate "rollback_alarms[%d]": alarm name cannot be empty`, i)
+		}
+	}
+	return nil
+}
+
+func (t TrafficShiftConfig) validate(strategy string) error {
+	if t.IsEmpty() {
+		return nil
+	}
+	strategy = strings.ToLower(strategy)
+	if strategy != ECSCanaryDeploymentStrategy && strategy != ECSLinearDeploymentStrategy {
+		return fmt.Errorf(`"traffic_shift" can only be specified with a %q or %q deployment "strategy"`,
+			ECSCanaryDeploymentStrategy, ECSLinearDeploymentStrategy)
+	}
+	if t.Percent != nil {
+		minPercent := minCanaryTrafficShiftPercent
+		if strategy == ECSLinearDeploymentStrategy {
+			minPercent = minLinearTrafficShiftPercent
+		}
+		if percent := aws.IntValue(t.Percent); percent < minPercent || percent > 100 {
+			return fmt.Errorf(`"percent" must be between %d and 100 for a %s deployment`, minPercent, strategy)
+		}
+	}
+	if t.Interval != nil {
+		if err := validateDeploymentWaitTime(*t.Interval); err != nil {
+			return fmt.Errorf(`validate "interval": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validateDeploymentWaitTime validates that a deployment wait time can be expressed in whole minutes up to a day.
+func validateDeploymentWaitTime(d time.Duration) error {
+	if d < 0 || d > maxDeploymentWaitTime {
+		return fmt.Errorf("duration must be between 0s and %s", maxDeploymentWaitTime)
+	}
+	if d%time.Minute != 0 {
+		return fmt.Errorf("duration %s must be a whole number of minutes", d)
+	}
+	return nil
+}
+
+func (d DeploymentConfiguration) validateNoTrafficShifting(wlType string) error {
+	if d.Strategy != nil {
+		return fmt.Errorf(`"strategy" is not supported for %s`, wlType)
+	}
+	return nil
 }
 
 // Validate returns nil if LoadBalancedWebServiceConfig is configured correctly.
@@ -150,6 +273,9 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateOverrides(l.Overrides); err != nil {
+		return err
+	}
 	if l.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: l.Storage.Volumes,
@@ -171,6 +297,14 @@ func (l LoadBalancedWebServiceConfig) Validate() error {
 	if err = l.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if l.DeployConfig.Strategy != nil {
+		if l.RoutingRule.Disabled() {
+			return errors.New(`deployment "strategy" requires "http" to be enabled`)
+		}
+		if !l.NLBConfig.IsEmpty() {
+			return errors.New(`deployment "strategy" is not supported with "nlb"`)
+		}
+	}
 	return nil
 }
 
@@ -180,6 +314,9 @@ func (b BackendService) Validate() error {
 	if err = b.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if err = b.DeployConfig.validateNoTrafficShifting(BackendServiceType); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
 	if err = b.BackendServiceConfig.Validate(); err != nil {
 		return err
 	}
@@ -209,6 +346,9 @@ func (b BackendServiceConfig) Validate() error {
 	if err = b.RoutingRule.Validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if len(b.RoutingRule.AdditionalRoutingRules) != 0 {
+		return errors.New(`"http.additional_rules" is only supported for Load Balanced Web Services`)
+	}
 	if b.RoutingRule.IsEmpty() && (!b.Count.AdvancedCount.Requests.IsEmpty() || !b.Count.AdvancedCount.ResponseTime.IsEmpty()) {
 		return &errFieldMustBeSpecified{
 			missingField:      "http",
@@ -237,6 +377,9 @@ func (b BackendServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateOverrides(b.Overrides); err != nil {
+		return err
+	}
 	if b.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: b.Storage.Volumes,
@@ -289,6 +432,9 @@ func (r RequestDrivenWebServiceConfig) Validate() error {
 	if err = r.Observability.Validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if err = validateOverrides(r.Overrides); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -298,6 +444,9 @@ func (w WorkerService) Validate() error {
 	if err = w.DeployConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if err = w.DeployConfig.validateNoTrafficShifting(WorkerServiceType); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
 	if err = w.WorkerServiceConfig.Validate(); err != nil {
 		return err
 	}
@@ -349,6 +498,9 @@ func (w WorkerServiceConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateOverrides(w.Overrides); err != nil {
+		return err
+	}
 	if w.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: w.Storage.Volumes,
@@ -367,6 +519,85 @@ func (w WorkerServiceConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if StaticSite is configured correctly.
+func (s StaticSite) Validate() error {
+	if err := s.StaticSiteConfig.Validate(); err != nil {
+		return err
+	}
+	return s.Workload.Validate()
+}
+
+// Validate returns nil if StaticSiteConfig is configured correctly.
+func (s StaticSiteConfig) Validate() error {
+	var err error
+	if err = s.HTTP.Validate(); err != nil {
+		return fmt.Errorf(`validate "http": %w`, err)
+	}
+	if err = s.Source.Validate(); err != nil {
+		return fmt.Errorf(`validate "source": %w`, err)
+	}
+	for ind, rule := range s.CacheControl {
+		if err = rule.Validate(); err != nil {
+			return fmt.Errorf(`validate "cache_control[%d]": %w`, ind, err)
+		}
+	}
+	if err = validateOverrides(s.Overrides); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate returns nil if StaticSiteHTTP is configured correctly.
+func (h StaticSiteHTTP) Validate() error {
+	if h.IsEmpty() {
+		return nil
+	}
+	if h.Alias == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "alias",
+			conditionalFields: []string{"certificate", "hosted_zone"},
+		}
+	}
+	if h.Certificate == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "certificate",
+			conditionalFields: []string{"alias"},
+		}
+	}
+	if _, err := arn.Parse(aws.StringValue(h.Certificate)); err != nil {
+		return fmt.Errorf(`parse "certificate": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if StaticSiteSource is configured correctly.
+func (s StaticSiteSource) Validate() error {
+	if s.Path == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if CacheControlRule is configured correctly.
+func (r CacheControlRule) Validate() error {
+	if r.Glob == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "glob",
+		}
+	}
+	if _, err := path.Match(aws.StringValue(r.Glob), ""); err != nil {
+		return fmt.Errorf(`invalid "glob" %q: %w`, aws.StringValue(r.Glob), err)
+	}
+	if r.Value == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "value",
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if ScheduledJob is configured correctly.
 func (s ScheduledJob) Validate() error {
 	var err error
@@ -424,6 +655,9 @@ func (s ScheduledJobConfig) Validate() error {
 			return fmt.Errorf(`validate "taskdef_overrides[%d]": %w`, ind, err)
 		}
 	}
+	if err = validateOverrides(s.Overrides); err != nil {
+		return err
+	}
 	if s.TaskConfig.IsWindows() {
 		if err = validateWindows(validateWindowsOpts{
 			efsVolumes: s.Storage.Volumes,
@@ -672,6 +906,19 @@ func (r RoutingRuleConfiguration) Validate() error {
 			conditionalFields: []string{"hosted_zone"},
 		}
 	}
+	for ind, rule := range r.AdditionalRoutingRules {
+		if len(rule.AdditionalRoutingRules) != 0 {
+			return fmt.Errorf(`"additional_rules[%d]" cannot have its own "additional_rules"`, ind)
+		}
+		if rule.Path == nil {
+			return fmt.Errorf(`validate "additional_rules[%d]": %w`, ind, &errFieldMustBeSpecified{
+				missingField: "path",
+			})
+		}
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf(`validate "additional_rules[%d]": %w`, ind, err)
+		}
+	}
 	return nil
 }
 
@@ -804,6 +1051,9 @@ func (t TaskConfig) Validate() error {
 	if err = t.Storage.Validate(); err != nil {
 		return fmt.Errorf(`validate "storage": %w`, err)
 	}
+	if err = t.EnvAddons.Validate(); err != nil {
+		return fmt.Errorf(`validate "env_addons": %w`, err)
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
@@ -813,6 +1063,26 @@ func (t TaskConfig) Validate() error {
 	return nil
 }
 
+// Validate returns nil if EnvAddons is configured correctly.
+func (e EnvAddons) Validate() error {
+	fields := []struct {
+		name    string
+		outputs []string
+	}{
+		{name: "variables", outputs: e.Variables},
+		{name: "secrets", outputs: e.Secrets},
+		{name: "policies", outputs: e.Policies},
+	}
+	for _, field := range fields {
+		for _, output := range field.outputs {
+			if !cfnOutputNameRegexp.MatchString(output) {
+				return fmt.Errorf(`%q in "%s" must be the name of an environment addons output that contains only alphanumeric characters`, output, field.name)
+			}
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if PlatformArgsOrString is configured correctly.
 func (p PlatformArgsOrString) Validate() error {
 	if p.IsEmpty() {
@@ -1334,11 +1604,43 @@ func (o Observability) Validate() error {
 
 // Validate returns nil if JobTriggerConfig is configured correctly.
 func (c JobTriggerConfig) Validate() error {
-	if c.Schedule == nil {
+	if c.Schedule != nil && c.Schedules != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "schedule",
+			secondField: "schedules",
+		}
+	}
+	if schedule := aws.StringValue(c.Schedule); c.Schedule != nil && schedule != jobScheduleNone {
+		if err := validateJobSchedule(schedule); err != nil {
+			return fmt.Errorf(`validate "schedule": %w`, err)
+		}
+	}
+	for ind, schedule := range c.Schedules {
+		if err := validateJobSchedule(schedule); err != nil {
+			return fmt.Errorf(`validate "schedules[%d]": %w`, ind, err)
+		}
+	}
+	for ind, event := range c.Events {
+		if err := event.Validate(); err != nil {
+			return fmt.Errorf(`validate "events[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if JobEventTrigger is configured correctly.
+func (e JobEventTrigger) Validate() error {
+	if e.Bus != nil && aws.StringValue(e.Bus) == "" {
+		return errors.New(`"bus" cannot be an empty string`)
+	}
+	if len(e.Pattern) == 0 {
 		return &errFieldMustBeSpecified{
-			missingField: "schedule",
+			missingField: "pattern",
 		}
 	}
+	if err := validateEventPattern(e.Pattern, ""); err != nil {
+		return fmt.Errorf(`validate "pattern": %w`, err)
+	}
 	return nil
 }
 
@@ -1427,6 +1729,9 @@ func (d DeadLetterQueue) Validate() error {
 
 // Validate returns nil if OverrideRule is configured correctly.
 func (r OverrideRule) Validate() error {
+	if err := r.validateOp(); err != nil {
+		return err
+	}
 	for _, s := range invalidTaskDefOverridePathRegexp {
 		re := regexp.MustCompile(fmt.Sprintf(`^%s$`, s))
 		if re.MatchString(r.Path) {
@@ -1436,6 +1741,53 @@ func (r OverrideRule) Validate() error {
 	return nil
 }
 
+func (r OverrideRule) validateOp() error {
+	if r.Op != "" && !contains(r.Op, override.ValidOperations) {
+		return fmt.Errorf(`"op" field value '%s' must be one of %s`, r.Op, english.WordSeries(override.ValidOperations, "or"))
+	}
+	return nil
+}
+
+// validateOverrides returns nil if the override rules of the CloudFormation template are configured correctly.
+// The logical IDs in the paths are validated against the template once it is rendered.
+func validateOverrides(rules []OverrideRule) error {
+	for ind, rule := range rules {
+		if err := rule.validateOverride(); err != nil {
+			return fmt.Errorf(`validate "overrides[%d]": %w`, ind, err)
+		}
+	}
+	return nil
+}
+
+func (r OverrideRule) validateOverride() error {
+	if r.Path == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if err := r.validateOp(); err != nil {
+		return err
+	}
+	segments, err := override.SplitPath(r.Path)
+	if err != nil {
+		return err
+	}
+	section := override.UnquoteKey(segments[0])
+	if !contains(section, cfnTemplateSections) {
+		return fmt.Errorf(`"%s" is not a section of a CloudFormation template`, section)
+	}
+	if r.Op == override.OpRemove {
+		return nil
+	}
+	if r.Value.IsZero() {
+		return fmt.Errorf(`"value" must be specified unless "op" is "%s"`, override.OpRemove)
+	}
+	if len(segments) == 2 && contains(section, cfnTemplateDefinitionSections) && r.Value.Kind != yaml.MappingNode {
+		return fmt.Errorf(`"value" of "%s" must be a map that holds the entire definition`, r.Path)
+	}
+	return nil
+}
+
 // Validate is a no-op for Secrets.
 func (s Secret) Validate() error {
 	return nil
@@ -1563,6 +1915,113 @@ func buildDependencyGraph(deps map[string]containerDependency) (*graph.Graph[str
 	return dependencyGraph, nil
 }
 
+// validateJobSchedule returns nil if the schedule is a valid AWS schedule expression, cron expression or preset.
+// AWS schedule expressions such as "cron(0 12 L * ? 2021)" are validated server-side.
+func validateJobSchedule(schedule string) error {
+	if awsScheduleRegexp.MatchString(schedule) {
+		return nil
+	}
+	if strings.HasPrefix(schedule, jobScheduleEveryPrefix) {
+		interval, err := time.ParseDuration(strings.TrimPrefix(schedule, jobScheduleEveryPrefix))
+		if err != nil {
+			return fmt.Errorf("interval %q must be a valid duration (example: @every 1h30m): %w", schedule, err)
+		}
+		if interval < time.Minute || interval != interval.Truncate(time.Minute) {
+			return fmt.Errorf("interval %q must be a whole number of minutes greater than or equal to 1 minute", schedule)
+		}
+		return nil
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		return fmt.Errorf("schedule %q is not a valid cron expression, rate or preset (examples: @weekly; @every 30m; 0 0 * * 0): %w", schedule, err)
+	}
+	const dayOfMonth, dayOfWeek = 2, 4
+	if fields := strings.Fields(schedule); len(fields) == 5 && !strings.ContainsAny(fields[dayOfMonth], "*?") && !strings.ContainsAny(fields[dayOfWeek], "*?") {
+		return fmt.Errorf("schedule %q cannot specify both the day of month and the day of week", schedule)
+	}
+	return nil
+}
+
+// validateEventPattern returns nil if every field of the pattern is an event field with a valid value.
+// The patterns of a top-level "$or" are validated the same way.
+func validateEventPattern(pattern map[string]interface{}, prefix string) error {
+	for field, value := range pattern {
+		path := prefix + field
+		if field == eventPatternOr {
+			patterns, err := eventPatternOrElements(path, value)
+			if err != nil {
+				return err
+			}
+			for i, p := range patterns {
+				if err := validateEventPattern(p, fmt.Sprintf("%s[%d].", path, i)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if !contains(field, eventPatternFields) {
+			return fmt.Errorf(`field %q is not an event field: %s`, path, english.OxfordWordSeries(eventPatternFields, "or"))
+		}
+		if err := validateEventPatternValue(path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventPatternOrElements returns the patterns in the list of a "$or" field.
+func eventPatternOrElements(field string, value interface{}) ([]map[string]interface{}, error) {
+	list, ok := value.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("value of %q must be a non-empty list of patterns", field)
+	}
+	patterns := make([]map[string]interface{}, len(list))
+	for i, elem := range list {
+		pattern, ok := elem.(map[string]interface{})
+		if !ok || len(pattern) == 0 {
+			return nil, fmt.Errorf(`"%s[%d]" must be a non-empty pattern`, field, i)
+		}
+		patterns[i] = pattern
+	}
+	return patterns, nil
+}
+
+// validateEventPatternValue returns nil if the value of a field in an event pattern is either a non-empty list of values
+// to match, or a nested pattern.
+func validateEventPatternValue(field string, value interface{}) error {
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			return fmt.Errorf("list of values to match for %q cannot be empty", field)
+		}
+		return nil
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return fmt.Errorf("nested pattern for %q cannot be empty", field)
+		}
+		for nestedField, nestedValue := range v {
+			nestedPath := fmt.Sprintf("%s.%s", field, nestedField)
+			if nestedField != eventPatternOr {
+				if err := validateEventPatternValue(nestedPath, nestedValue); err != nil {
+					return err
+				}
+				continue
+			}
+			patterns, err := eventPatternOrElements(nestedPath, nestedValue)
+			if err != nil {
+				return err
+			}
+			for i, p := range patterns {
+				if err := validateEventPatternValue(fmt.Sprintf("%s[%d]", nestedPath, i), p); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("value of %q must be a list of values to match or a nested pattern", field)
+	}
+}
+
 // Validate that paths contain only an approved set of characters to guard against command injection.
 // We can accept 0-9A-Za-z-_.
 func validateVolumePath(input string) error {
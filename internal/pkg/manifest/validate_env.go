@@ -6,15 +6,25 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/dustin/go-humanize/english"
 )
 
 var (
 	errAZsNotEqual = errors.New("public subnets and private subnets do not span the same availability zones")
 
 	minAZs = 2
+
+	// CloudFront only accepts certificates and web ACLs created in us-east-1.
+	cloudFrontRegion = "us-east-1"
+	cdnPriceClasses  = []string{"PriceClass_100", "PriceClass_200", "PriceClass_All"}
+
+	// s3BucketDomainRegexp matches the regional or legacy global domain name of an S3 bucket, such as "bucket.s3.us-west-2.amazonaws.com".
+	s3BucketDomainRegexp = regexp.MustCompile(`^([a-z0-9][a-z0-9.-]*[a-z0-9])\.s3(?:[.-][a-z0-9-]+)?\.amazonaws\.com(?:\.cn)?$`)
 )
 
 // Validate returns nil if Environment is configured correctly.
@@ -36,7 +46,13 @@ func (e environmentConfig) Validate() error {
 	if err := e.HTTPConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "http config": %w`, err)
 	}
+	if err := e.CDNConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "cdn": %w`, err)
+	}
 
+	if err := validateOverrides(e.Overrides); err != nil {
+		return err
+	}
 	if e.HTTPConfig.Private.InternalALBSubnets != nil {
 		if !e.Network.VPC.imported() {
 			return errors.New("in order to specify internal ALB subnet placement, subnets must be imported")
@@ -216,8 +232,99 @@ func (cfg environmentCDNConfig) Validate() error {
 	return cfg.CDNConfig.Validate()
 }
 
-// Validate is a no-op for AdvancedCDNConfig.
+// Validate returns nil if advancedCDNConfig is configured correctly.
 func (cfg advancedCDNConfig) Validate() error {
+	if cfg.Certificate != nil {
+		certARN, err := arn.Parse(*cfg.Certificate)
+		if err != nil {
+			return fmt.Errorf(`parse "certificate": %w`, err)
+		}
+		if certARN.Region != cloudFrontRegion {
+			return fmt.Errorf(`"certificate" must be imported in region %s to be used by CloudFront, but it is in region %s`, cloudFrontRegion, certARN.Region)
+		}
+		if len(cfg.Aliases) == 0 {
+			return &errFieldMustBeSpecified{
+				missingField:      "aliases",
+				conditionalFields: []string{"certificate"},
+			}
+		}
+	}
+	if len(cfg.Aliases) != 0 && cfg.Certificate == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "certificate",
+			conditionalFields: []string{"aliases"},
+		}
+	}
+	for idx, alias := range cfg.Aliases {
+		if alias == "" {
+			return fmt.Errorf(`"aliases[%d]" cannot be an empty string`, idx)
+		}
+	}
+	if cfg.PriceClass != nil && !contains(aws.StringValue(cfg.PriceClass), cdnPriceClasses) {
+		return fmt.Errorf(`"price_class" %s must be one of %s`, aws.StringValue(cfg.PriceClass), english.WordSeries(cdnPriceClasses, "or"))
+	}
+	if cfg.WebACL != nil {
+		aclARN, err := arn.Parse(*cfg.WebACL)
+		if err != nil {
+			return fmt.Errorf(`parse "web_acl": %w`, err)
+		}
+		if aclARN.Service != "wafv2" || !strings.HasPrefix(aclARN.Resource, "global/webacl/") {
+			return fmt.Errorf(`"web_acl" %s must be the ARN of a WAFv2 web ACL with a CLOUDFRONT scope`, *cfg.WebACL)
+		}
+	}
+	paths := make(map[string]bool)
+	for idx, behavior := range cfg.CacheBehaviors {
+		if err := behavior.Validate(); err != nil {
+			return fmt.Errorf(`validate "cache_behaviors[%d]": %w`, idx, err)
+		}
+		if paths[aws.StringValue(behavior.Path)] {
+			return fmt.Errorf(`validate "cache_behaviors[%d]": path %s is already used by another cache behavior`, idx, aws.StringValue(behavior.Path))
+		}
+		paths[aws.StringValue(behavior.Path)] = true
+	}
+	for idx, assets := range cfg.StaticAssets {
+		if err := assets.Validate(); err != nil {
+			return fmt.Errorf(`validate "static_assets[%d]": %w`, idx, err)
+		}
+		if paths[aws.StringValue(assets.Path)] {
+			return fmt.Errorf(`validate "static_assets[%d]": path %s is already used by another cache behavior or static assets`, idx, aws.StringValue(assets.Path))
+		}
+		paths[aws.StringValue(assets.Path)] = true
+	}
+	return nil
+}
+
+// Validate returns nil if cdnCacheBehavior is configured correctly.
+func (b cdnCacheBehavior) Validate() error {
+	if aws.StringValue(b.Path) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if b.CachePolicy == nil && b.HeaderPolicies.IsEmpty() {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"cache_policy", "origin_request_policy", "response_headers_policy"},
+			conditionalField: "path",
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if cdnStaticAssetsConfig is configured correctly.
+func (cfg cdnStaticAssetsConfig) Validate() error {
+	if aws.StringValue(cfg.Location) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "location",
+		}
+	}
+	if cfg.BucketName() == "" {
+		return fmt.Errorf(`"location" %s must be the domain name of an S3 bucket, such as "bucket.s3.us-west-2.amazonaws.com"`, aws.StringValue(cfg.Location))
+	}
+	if aws.StringValue(cfg.Path) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
 	return nil
 }
 
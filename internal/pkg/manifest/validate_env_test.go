@@ -54,6 +54,17 @@ func TestEnvironmentConfig_Validate(t *testing.T) {
 		in          environmentConfig
 		wantedError string
 	}{
+		"error if an override rule has an invalid operation": {
+			in: environmentConfig{
+				Overrides: []OverrideRule{
+					{
+						Op:   "move",
+						Path: "Resources.PublicLoadBalancer.Properties.Scheme",
+					},
+				},
+			},
+			wantedError: `validate "overrides[0]": "op" field value 'move' must be one of add, remove, replace or test`,
+		},
 		"error if internal ALB subnet placement specified with adjusted vpc": {
 			in: environmentConfig{
 				Network: environmentNetworkConfig{
@@ -571,8 +582,142 @@ func TestCDNConfiguration_Validate(t *testing.T) {
 		},
 		"valid if advanced config configured correctly": {
 			in: environmentCDNConfig{
-				CDNConfig: advancedCDNConfig{},
+				CDNConfig: advancedCDNConfig{
+					Certificate: aws.String("arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"),
+					Aliases:     []string{"example.com", "www.example.com"},
+					PriceClass:  aws.String("PriceClass_All"),
+					WebACL:      aws.String("arn:aws:wafv2:us-east-1:1111111:global/webacl/mock-acl/1234"),
+					CacheBehaviors: []cdnCacheBehavior{
+						{
+							Path:        aws.String("/api/*"),
+							CachePolicy: aws.String("mock-cache-policy"),
+						},
+					},
+					StaticAssets: []cdnStaticAssetsConfig{
+						{
+							Location: aws.String("mock-bucket.s3.us-west-2.amazonaws.com"),
+							Path:     aws.String("static/*"),
+						},
+					},
+				},
+			},
+		},
+		"error if certificate is not imported in us-east-1": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					Certificate: aws.String("arn:aws:acm:us-west-2:1111111:certificate/look-like-a-good-arn"),
+				},
+			},
+			wantedError: errors.New(`"certificate" must be imported in region us-east-1 to be used by CloudFront, but it is in region us-west-2`),
+		},
+		"error if certificate is specified without aliases": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					Certificate: aws.String("arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"),
+				},
+			},
+			wantedError: errors.New(`"aliases" must be specified if "certificate" is specified`),
+		},
+		"error if aliases are specified without certificate": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					Aliases: []string{"example.com"},
+				},
+			},
+			wantedError: errors.New(`"certificate" must be specified if "aliases" is specified`),
+		},
+		"error if an alias is empty": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					Certificate: aws.String("arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"),
+					Aliases:     []string{"example.com", ""},
+				},
+			},
+			wantedError: errors.New(`"aliases[1]" cannot be an empty string`),
+		},
+		"error if price class is invalid": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					PriceClass: aws.String("PriceClass_300"),
+				},
+			},
+			wantedError: errors.New(`"price_class" PriceClass_300 must be one of PriceClass_100, PriceClass_200 or PriceClass_All`),
+		},
+		"error if web acl is not scoped to CloudFront": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					WebACL: aws.String("arn:aws:wafv2:us-west-2:1111111:regional/webacl/mock-acl/1234"),
+				},
+			},
+			wantedError: errors.New(`"web_acl" arn:aws:wafv2:us-west-2:1111111:regional/webacl/mock-acl/1234 must be the ARN of a WAFv2 web ACL with a CLOUDFRONT scope`),
+		},
+		"error if cache behavior has no path": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					CacheBehaviors: []cdnCacheBehavior{
+						{
+							CachePolicy: aws.String("mock-cache-policy"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "cache_behaviors[0]": "path" must be specified`),
+		},
+		"error if cache behavior has no policy": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					CacheBehaviors: []cdnCacheBehavior{
+						{
+							Path: aws.String("/api/*"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "cache_behaviors[0]": must specify at least one of "cache_policy", "origin_request_policy" or "response_headers_policy" if "path" is specified`),
+		},
+		"error if static assets have no location": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					StaticAssets: []cdnStaticAssetsConfig{
+						{
+							Path: aws.String("static/*"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "static_assets[0]": "location" must be specified`),
+		},
+		"error if static assets location is not the domain name of a bucket": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					StaticAssets: []cdnStaticAssetsConfig{
+						{
+							Location: aws.String("example.com"),
+							Path:     aws.String("static/*"),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "static_assets[0]": "location" example.com must be the domain name of an S3 bucket, such as "bucket.s3.us-west-2.amazonaws.com"`),
+		},
+		"error if static assets use the path of a cache behavior": {
+			in: environmentCDNConfig{
+				CDNConfig: advancedCDNConfig{
+					CacheBehaviors: []cdnCacheBehavior{
+						{
+							Path:        aws.String("static/*"),
+							CachePolicy: aws.String("mock-cache-policy"),
+						},
+					},
+					StaticAssets: []cdnStaticAssetsConfig{
+						{
+							Location: aws.String("mock-bucket.s3.us-west-2.amazonaws.com"),
+							Path:     aws.String("static/*"),
+						},
+					},
+				},
 			},
+			wantedError: errors.New(`validate "static_assets[0]": path static/* is already used by another cache behavior or static assets`),
 		},
 	}
 	for name, tc := range testCases {
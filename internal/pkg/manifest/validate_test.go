@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestLoadBalancedWebService_Validate(t *testing.T) {
@@ -133,6 +134,24 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "taskdef_overrides[0]": `,
 		},
+		"error if fail to validate overrides": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Overrides: []OverrideRule{
+						{
+							Op: "remove",
+						},
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "overrides[0]": "path" must be specified`),
+		},
 		"error if name is not set": {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
@@ -161,6 +180,52 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate HTTP load balancer target: `,
 		},
+		"error if there are too many target groups": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+							AdditionalRoutingRules: []RoutingRuleConfiguration{
+								{Path: stringP("/a")},
+								{Path: stringP("/b")},
+								{Path: stringP("/c")},
+								{Path: stringP("/d")},
+							},
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`a service can be registered with at most 5 target groups including the one of "nlb", but 6 are required by "http.additional_rules"`),
+		},
+		"error if the target container of an additional routing rule doesn't expose any port": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{Name: aws.String("mockName")},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Sidecars: map[string]*SidecarConfig{
+						"admin": {},
+					},
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+							AdditionalRoutingRules: []RoutingRuleConfiguration{
+								{
+									Path:            stringP("/admin"),
+									TargetContainer: aws.String("admin"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate HTTP load balancer target for "http.additional_rules[0]": target container admin doesn't expose any port`),
+		},
 		"error if fail to validate network load balancer target": {
 			lbConfig: LoadBalancedWebService{
 				Workload: Workload{Name: aws.String("mockName")},
@@ -340,6 +405,48 @@ func TestLoadBalancedWebService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment"`,
 		},
+		"error if deployment strategy is used without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("80"),
+					},
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("bluegreen"),
+					},
+				},
+			},
+			wantedError: errors.New(`deployment "strategy" requires "http" to be enabled`),
+		},
+		"error if deployment strategy is used with nlb": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfigOrBool{
+						RoutingRuleConfiguration: RoutingRuleConfiguration{
+							Path: stringP("/"),
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("80"),
+					},
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("canary"),
+					},
+				},
+			},
+			wantedError: errors.New(`deployment "strategy" is not supported with "nlb"`),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -432,6 +539,22 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "publish": `,
 		},
+		"error if additional routing rules are specified": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfiguration{
+						Path: stringP("/"),
+						AdditionalRoutingRules: []RoutingRuleConfiguration{
+							{
+								Path: stringP("/admin"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`"http.additional_rules" is only supported for Load Balanced Web Services`),
+		},
 		"error if fail to validate taskdef override": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -536,6 +659,20 @@ func TestBackendService_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment":`,
 		},
+		"error if deployment strategy is specified": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					DeployConfig: DeploymentConfiguration{
+						Strategy: aws.String("bluegreen"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": "strategy" is not supported for Backend Service`),
+		},
 		"error if fail to validate http": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -726,6 +863,163 @@ func TestRequestDrivenWebService_Validate(t *testing.T) {
 	}
 }
 
+func TestStaticSite_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		config StaticSite
+
+		wantedError          error
+		wantedErrorMsgPrefix string
+	}{
+		"error if name is not set": {
+			config: StaticSite{
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"name" must be specified`),
+		},
+		"error if source path is not set": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "source": "path" must be specified`),
+		},
+		"error if certificate is set without an alias": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					HTTP: StaticSiteHTTP{
+						Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": "alias" must be specified if "certificate" or "hosted_zone" are specified`),
+		},
+		"error if alias is set without a certificate": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					HTTP: StaticSiteHTTP{
+						Alias: aws.String("www.example.com"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": "certificate" must be specified if "alias" is specified`),
+		},
+		"error if certificate is not an ARN": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					HTTP: StaticSiteHTTP{
+						Alias:       aws.String("www.example.com"),
+						Certificate: aws.String("mockCertificate"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "http": parse "certificate": `,
+		},
+		"error if cache control glob is invalid": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					CacheControl: []CacheControlRule{
+						{
+							Glob:  aws.String("*.html"),
+							Value: aws.String("no-cache"),
+						},
+						{
+							Glob:  aws.String("assets/[a-"),
+							Value: aws.String("max-age=60"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "cache_control[1]": invalid "glob" "assets/[a-": syntax error in pattern`),
+		},
+		"error if cache control value is not set": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path: aws.String("public"),
+					},
+					CacheControl: []CacheControlRule{
+						{
+							Glob: aws.String("*.html"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "cache_control[0]": "value" must be specified`),
+		},
+		"valid static site with an alias": {
+			config: StaticSite{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				StaticSiteConfig: StaticSiteConfig{
+					Source: StaticSiteSource{
+						Path:  aws.String("dist"),
+						Build: aws.String("npm run build"),
+					},
+					HTTP: StaticSiteHTTP{
+						Alias:       aws.String("www.example.com"),
+						Certificate: aws.String("arn:aws:acm:us-east-1:123456789012:certificate/abc"),
+						HostedZone:  aws.String("Z0123456789"),
+					},
+					CacheControl: []CacheControlRule{
+						{
+							Glob:  aws.String("*.html"),
+							Value: aws.String("no-cache"),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.Validate()
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestWorkerService_Validate(t *testing.T) {
 	testImageConfig := ImageWithHealthcheck{
 		Image: Image{
@@ -991,7 +1285,9 @@ func TestScheduledJob_Validate(t *testing.T) {
 			config: ScheduledJob{
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
-					On:          JobTriggerConfig{},
+					On: JobTriggerConfig{
+						Schedule: aws.String("mockSchedule"),
+					},
 				},
 			},
 			wantedErrorMsgPrefix: `validate "on": `,
@@ -1001,7 +1297,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					On: JobTriggerConfig{
-						Schedule: aws.String("mockSchedule"),
+						Schedule: aws.String("@daily"),
 					},
 					PublishConfig: PublishConfig{
 						Topics: []Topic{
@@ -1017,7 +1313,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					On: JobTriggerConfig{
-						Schedule: aws.String("mockSchedule"),
+						Schedule: aws.String("@daily"),
 					},
 					TaskDefOverrides: []OverrideRule{
 						{
@@ -1033,7 +1329,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					On: JobTriggerConfig{
-						Schedule: aws.String("mockSchedule"),
+						Schedule: aws.String("@daily"),
 					},
 				},
 			},
@@ -1045,7 +1341,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					On: JobTriggerConfig{
-						Schedule: aws.String("mockSchedule"),
+						Schedule: aws.String("@daily"),
 					},
 					Sidecars: map[string]*SidecarConfig{
 						"foo": {
@@ -1065,7 +1361,7 @@ func TestScheduledJob_Validate(t *testing.T) {
 				ScheduledJobConfig: ScheduledJobConfig{
 					ImageConfig: testImageConfig,
 					On: JobTriggerConfig{
-						Schedule: aws.String("mockSchedule"),
+						Schedule: aws.String("@daily"),
 					},
 					TaskConfig: TaskConfig{
 						Platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("windows/amd64"))},
@@ -1350,6 +1646,48 @@ func TestRoutingRule_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "alias":`,
 		},
+		"error if an additional rule is missing its path": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRoutingRules: []RoutingRuleConfiguration{
+					{
+						Path: stringP("/api"),
+					},
+					{
+						TargetContainer: aws.String("admin"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "additional_rules[1]": "path" must be specified`),
+		},
+		"error if an additional rule is not valid": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRoutingRules: []RoutingRuleConfiguration{
+					{
+						Path:            stringP("/admin"),
+						ProtocolVersion: aws.String("quic"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "additional_rules[0]": "version" field value 'quic'`,
+		},
+		"error if an additional rule has its own additional rules": {
+			RoutingRule: RoutingRuleConfiguration{
+				Path: stringP("/"),
+				AdditionalRoutingRules: []RoutingRuleConfiguration{
+					{
+						Path: stringP("/admin"),
+						AdditionalRoutingRules: []RoutingRuleConfiguration{
+							{
+								Path: stringP("/admin/debug"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"additional_rules[0]" cannot have its own "additional_rules"`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1556,6 +1894,15 @@ func TestTaskConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "storage": `,
 		},
+		"error if env addons output name is invalid": {
+			TaskConfig: TaskConfig{
+				EnvAddons: EnvAddons{
+					Variables: []string{"ClusterEndpoint"},
+					Secrets:   []string{"cluster-secret"},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "env_addons": "cluster-secret" in "secrets" must be the name of an environment addons output that contains only alphanumeric characters`),
+		},
 		"error if invalid env file": {
 			TaskConfig: TaskConfig{
 				EnvFile: aws.String("foo"),
@@ -2572,9 +2919,195 @@ func TestJobTriggerConfig_Validate(t *testing.T) {
 		in     *JobTriggerConfig
 		wanted error
 	}{
-		"should return an error if schedule is empty": {
-			in:     &JobTriggerConfig{},
-			wanted: errors.New(`"schedule" must be specified`),
+		"should be valid without any trigger": {
+			in: &JobTriggerConfig{},
+		},
+		"should be valid with the legacy none schedule": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("none"),
+			},
+		},
+		"should be valid with schedules and events": {
+			in: &JobTriggerConfig{
+				Schedules: []string{"@daily", "@every 1h30m", "0 9 * * 1-5", "rate(5 minutes)", "cron(0 12 L * ? 2021)"},
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"source":      []interface{}{"aws.s3"},
+							"detail-type": []interface{}{"Object Created"},
+							"detail": map[string]interface{}{
+								"bucket": map[string]interface{}{
+									"name": []interface{}{"my-bucket"},
+								},
+							},
+						},
+					},
+					{
+						Bus: aws.String("orders"),
+						Pattern: map[string]interface{}{
+							"source": []interface{}{"com.example.orders"},
+						},
+					},
+				},
+			},
+		},
+		"should return an error if both schedule and schedules are specified": {
+			in: &JobTriggerConfig{
+				Schedule:  aws.String("@daily"),
+				Schedules: []string{"@weekly"},
+			},
+			wanted: errors.New(`must specify one, not both, of "schedule" and "schedules"`),
+		},
+		"should return an error if the schedule is not a valid cron expression": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("every day"),
+			},
+			wanted: errors.New(`validate "schedule": schedule "every day" is not a valid cron expression, rate or preset (examples: @weekly; @every 30m; 0 0 * * 0): expected exactly 5 fields, found 2: [every day]`),
+		},
+		"should return an error if an interval is shorter than a minute": {
+			in: &JobTriggerConfig{
+				Schedules: []string{"@daily", "@every 30s"},
+			},
+			wanted: errors.New(`validate "schedules[1]": interval "@every 30s" must be a whole number of minutes greater than or equal to 1 minute`),
+		},
+		"should return an error if a cron expression specifies both the day of month and the day of week": {
+			in: &JobTriggerConfig{
+				Schedules: []string{"0 9 1 * 1"},
+			},
+			wanted: errors.New(`validate "schedules[0]": schedule "0 9 1 * 1" cannot specify both the day of month and the day of week`),
+		},
+		"should return an error if an event pattern is missing": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Bus: aws.String("orders"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": "pattern" must be specified`),
+		},
+		"should return an error if the bus is empty": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Bus: aws.String(""),
+						Pattern: map[string]interface{}{
+							"source": []interface{}{"com.example.orders"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": "bus" cannot be an empty string`),
+		},
+		"should return an error if a field of the pattern is not an event field": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"sources": []interface{}{"aws.s3"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": field "sources" is not an event field: version, id, detail-type, source, account, time, region, resources, or detail`),
+		},
+		"should return an error if the value of a field is not a list": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"detail": map[string]interface{}{
+								"bucket": map[string]interface{}{
+									"name": "my-bucket",
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": value of "detail.bucket.name" must be a list of values to match or a nested pattern`),
+		},
+		"should return an error if a field of a top-level $or pattern is not an event field": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"$or": []interface{}{
+								map[string]interface{}{
+									"source": []interface{}{"aws.s3"},
+								},
+								map[string]interface{}{
+									"sources": []interface{}{"aws.ec2"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": field "$or[1].sources" is not an event field: version, id, detail-type, source, account, time, region, resources, or detail`),
+		},
+		"should return an error if $or is not a list of patterns": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"$or": []interface{}{"aws.s3"},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": "$or[0]" must be a non-empty pattern`),
+		},
+		"should return an error if the value of a field in a nested $or pattern is not a list": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"detail": map[string]interface{}{
+								"$or": []interface{}{
+									map[string]interface{}{
+										"eventName": "PutObject",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": value of "detail.$or[0].eventName" must be a list of values to match or a nested pattern`),
+		},
+		"should return nil if the pattern has a top-level $or": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"source": []interface{}{"aws.s3"},
+							"$or": []interface{}{
+								map[string]interface{}{
+									"detail-type": []interface{}{"Object Created"},
+								},
+								map[string]interface{}{
+									"detail": map[string]interface{}{
+										"reason": []interface{}{"PutObject"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"should return an error if the list of values of a field is empty": {
+			in: &JobTriggerConfig{
+				Events: []JobEventTrigger{
+					{
+						Pattern: map[string]interface{}{
+							"source": []interface{}{},
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "events[0]": validate "pattern": list of values to match for "source" cannot be empty`),
 		},
 	}
 	for name, tc := range testCases {
@@ -2724,6 +3257,26 @@ func TestOverrideRule_Validate(t *testing.T) {
 			},
 			wanted: errors.New(`"ContainerDefinitions\[\d+\].Name" cannot be overridden with a custom value`),
 		},
+		"should return an error if override rule selects the container name by key": {
+			in: OverrideRule{
+				Op:   "replace",
+				Path: "ContainerDefinitions[Name=nginx].Name",
+			},
+			wanted: errors.New(`"ContainerDefinitions\[[a-zA-Z0-9_-]+=[^\[\]]+\].Name" cannot be overridden with a custom value`),
+		},
+		"should return an error if operation is invalid": {
+			in: OverrideRule{
+				Op:   "copy",
+				Path: "ContainerDefinitions[0].Ulimits",
+			},
+			wanted: errors.New(`"op" field value 'copy' must be one of add, remove, replace or test`),
+		},
+		"should return nil for a valid operation": {
+			in: OverrideRule{
+				Op:   "remove",
+				Path: "ContainerDefinitions[Name=nginx].Ulimits",
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2738,6 +3291,73 @@ func TestOverrideRule_Validate(t *testing.T) {
 	}
 }
 
+func TestValidateOverrides(t *testing.T) {
+	mapValue := yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	scalarValue := yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "10"}
+	testCases := map[string]struct {
+		in     []OverrideRule
+		wanted error
+	}{
+		"should return an error if the path is not a section of a template": {
+			in: []OverrideRule{
+				{
+					Path:  "TargetGroup.Properties.HealthCheckTimeoutSeconds",
+					Value: scalarValue,
+				},
+			},
+			wanted: errors.New(`validate "overrides[0]": "TargetGroup" is not a section of a CloudFormation template`),
+		},
+		"should return an error if the value is missing": {
+			in: []OverrideRule{
+				{
+					Path: "Resources.TargetGroup.Properties.HealthCheckTimeoutSeconds",
+				},
+			},
+			wanted: errors.New(`validate "overrides[0]": "value" must be specified unless "op" is "remove"`),
+		},
+		"should return an error if a new logical ID is not defined by a map": {
+			in: []OverrideRule{
+				{
+					Path:  "Resources.Queue",
+					Value: scalarValue,
+				},
+			},
+			wanted: errors.New(`validate "overrides[0]": "value" of "Resources.Queue" must be a map that holds the entire definition`),
+		},
+		"should return nil for valid rules": {
+			in: []OverrideRule{
+				{
+					Path:  "Resources.TargetGroup.Properties.HealthCheckTimeoutSeconds",
+					Value: scalarValue,
+				},
+				{
+					Path:  "Resources.Queue",
+					Value: mapValue,
+				},
+				{
+					Op:   "remove",
+					Path: "Resources.LogGroup.Metadata",
+				},
+				{
+					Path:  `"Resources"."TargetGroup".Properties.Port`,
+					Value: scalarValue,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateOverrides(tc.in)
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateLoadBalancerTarget(t *testing.T) {
 	testCases := map[string]struct {
 		in     validateTargetContainerOpts
@@ -3032,6 +3652,89 @@ func TestDeploymentConfiguration_Validate(t *testing.T) {
 		"ok if deployment is empty": {
 			deployConfig: DeploymentConfiguration{},
 		},
+		"error if both rolling and strategy are specified": {
+			deployConfig: DeploymentConfiguration{
+				Rolling:  aws.String("default"),
+				Strategy: aws.String("canary"),
+			},
+			wanted: `must specify one, not both, of "rolling" and "strategy"`,
+		},
+		"error if strategy is invalid": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("allatonce"),
+			},
+			wanted: `invalid deployment strategy allatonce, must be one of canary, linear or bluegreen`,
+		},
+		"error if bake_time is specified without a strategy": {
+			deployConfig: DeploymentConfiguration{
+				BakeTime: durationp(10 * time.Minute),
+			},
+			wanted: `"strategy" must be specified if "bake_time" is specified`,
+		},
+		"error if bake_time is not in whole minutes": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("bluegreen"),
+				BakeTime: durationp(90 * time.Second),
+			},
+			wanted: `validate "bake_time": duration 1m30s must be a whole number of minutes`,
+		},
+		"error if bake_time is longer than a day": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("bluegreen"),
+				BakeTime: durationp(25 * time.Hour),
+			},
+			wanted: `validate "bake_time": duration must be between 0s and 24h0m0s`,
+		},
+		"error if traffic_shift is specified for a blue/green deployment": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("bluegreen"),
+				TrafficShift: TrafficShiftConfig{
+					Percent: aws.Int(10),
+				},
+			},
+			wanted: `validate "traffic_shift": "traffic_shift" can only be specified with a "canary" or "linear" deployment "strategy"`,
+		},
+		"error if linear traffic shift percent is too small": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("linear"),
+				TrafficShift: TrafficShiftConfig{
+					Percent: aws.Int(2),
+				},
+			},
+			wanted: `validate "traffic_shift": "percent" must be between 3 and 100 for a linear deployment`,
+		},
+		"error if traffic shift interval is invalid": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("canary"),
+				TrafficShift: TrafficShiftConfig{
+					Interval: durationp(30 * time.Second),
+				},
+			},
+			wanted: `validate "traffic_shift": validate "interval": duration 30s must be a whole number of minutes`,
+		},
+		"error if a rollback alarm is empty": {
+			deployConfig: DeploymentConfiguration{
+				RollbackAlarms: []string{"HighLatency", ""},
+			},
+			wanted: `validate "rollback_alarms[1]": alarm name cannot be empty`,
+		},
+		"ok for a canary deployment": {
+			deployConfig: DeploymentConfiguration{
+				Strategy: aws.String("canary"),
+				TrafficShift: TrafficShiftConfig{
+					Percent:  aws.Int(10),
+					Interval: durationp(5 * time.Minute),
+				},
+				BakeTime:       durationp(15 * time.Minute),
+				RollbackAlarms: []string{"HighLatency"},
+			},
+		},
+		"ok for rollback alarms with a rolling deployment": {
+			deployConfig: DeploymentConfiguration{
+				Rolling:        aws.String("default"),
+				RollbackAlarms: []string{"HighLatency"},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
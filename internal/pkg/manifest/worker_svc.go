@@ -47,6 +47,7 @@ type WorkerServiceConfig struct {
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	Network          NetworkConfig             `yaml:"network"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Overrides        []OverrideRule            `yaml:"overrides"`
 	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
 }
@@ -185,6 +186,11 @@ func (s *WorkerService) EnvFile() string {
 	return aws.StringValue(s.TaskConfig.EnvFile)
 }
 
+// ReferencedSecrets returns the secrets referenced by the containers of the service.
+func (s *WorkerService) ReferencedSecrets() []Secret {
+	return referencedSecrets(s.TaskConfig, s.Logging, s.Sidecars)
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
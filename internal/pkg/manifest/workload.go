@@ -84,6 +84,8 @@ func UnmarshalWorkload(in []byte) (WorkloadManifest, error) {
 		m = newDefaultBackendService()
 	case WorkerServiceType:
 		m = newDefaultWorkerService()
+	case StaticSiteType:
+		m = newDefaultStaticSite()
 	case ScheduledJobType:
 		m = newDefaultScheduledJob()
 	default:
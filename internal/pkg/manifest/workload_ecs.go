@@ -38,6 +38,11 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+
+	// traffic shifting deployment strategies
+	ECSCanaryDeploymentStrategy    = "canary"
+	ECSLinearDeploymentStrategy    = "linear"
+	ECSBlueGreenDeploymentStrategy = "bluegreen"
 )
 
 // Platform related settings.
@@ -80,11 +85,28 @@ type ImageWithPortAndHealthcheck struct {
 
 // DeploymentConfiguration represents the deployment strategies for a service.
 type DeploymentConfiguration struct {
-	Rolling *string `yaml:"rolling"`
+	Rolling        *string            `yaml:"rolling"`
+	Strategy       *string            `yaml:"strategy"`
+	TrafficShift   TrafficShiftConfig `yaml:"traffic_shift"`
+	BakeTime       *time.Duration     `yaml:"bake_time"`
+	RollbackAlarms []string           `yaml:"rollback_alarms"`
 }
 
 func (d *DeploymentConfiguration) isEmpty() bool {
-	return d == nil || d.Rolling == nil
+	return d == nil || (d.Rolling == nil && d.Strategy == nil && d.TrafficShift.IsEmpty() &&
+		d.BakeTime == nil && len(d.RollbackAlarms) == 0)
+}
+
+// TrafficShiftConfig represents how production traffic is shifted to the new revision
+// during canary and linear deployments.
+type TrafficShiftConfig struct {
+	Percent  *int           `yaml:"percent"`
+	Interval *time.Duration `yaml:"interval"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (t *TrafficShiftConfig) IsEmpty() bool {
+	return t.Percent == nil && t.Interval == nil
 }
 
 // ImageWithHealthcheckAndOptionalPort represents a container image with an optional exposed port and health check.
@@ -110,6 +132,20 @@ type TaskConfig struct {
 	EnvFile        *string              `yaml:"env_file"`
 	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
+	EnvAddons      EnvAddons            `yaml:"env_addons"`
+}
+
+// EnvAddons holds the names of the environment addons outputs that the tasks reference.
+// Variables and secrets are injected in the containers in SCREAMING_SNAKE_CASE, like the outputs of the workload addons.
+type EnvAddons struct {
+	Variables []string `yaml:"variables"`
+	Secrets   []string `yaml:"secrets"`
+	Policies  []string `yaml:"policies"` // Managed policies attached to the task role.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (e *EnvAddons) IsEmpty() bool {
+	return len(e.Variables) == 0 && len(e.Secrets) == 0 && len(e.Policies) == 0
 }
 
 // ContainerPlatform returns the platform for the service.
@@ -133,6 +169,11 @@ func (t TaskConfig) IsARM() bool {
 	return IsArmArch(t.Platform.Arch())
 }
 
+// HasAutoscaling returns whether the number of tasks is managed by autoscaling policies instead of a fixed count.
+func (t TaskConfig) HasAutoscaling() bool {
+	return t.Count.AdvancedCount.hasAutoscaling()
+}
+
 // Secret represents an identifier for sensitive data stored in either SSM or SecretsManager.
 type Secret struct {
 	from               *string              // SSM Parameter name or ARN to a secret.
@@ -158,6 +199,14 @@ func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// MarshalYAML implements the yaml.Marshaler interface to serialize the secret back to either a string or a "secretsmanager" object.
+func (s Secret) MarshalYAML() (interface{}, error) {
+	if s.IsSecretsManagerName() {
+		return s.fromSecretsManager, nil
+	}
+	return s.from, nil
+}
+
 // IsSecretsManagerName returns true if the secret refers to the name of a secret stored in SecretsManager.
 func (s *Secret) IsSecretsManagerName() bool {
 	return !s.fromSecretsManager.IsEmpty()
@@ -171,6 +220,29 @@ func (s *Secret) Value() string {
 	return aws.StringValue(s.from)
 }
 
+// referencedSecrets returns the secrets of the main container, the log router and the sidecars of a task.
+func referencedSecrets(task TaskConfig, logging Logging, sidecars map[string]*SidecarConfig) []Secret {
+	var secrets []Secret
+	for _, secret := range task.Secrets {
+		secrets = append(secrets, secret)
+	}
+	for _, secret := range logging.Secrets {
+		secrets = append(secrets, secret)
+	}
+	for _, secret := range logging.SecretOptions {
+		secrets = append(secrets, secret)
+	}
+	for _, sidecar := range sidecars {
+		if sidecar == nil {
+			continue
+		}
+		for _, secret := range sidecar.Secrets {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
 // secretsManagerSecret represents the name of a secret stored in SecretsManager.
 type secretsManagerSecret struct {
 	Name *string `yaml:"secretsmanager"`
@@ -233,6 +305,7 @@ type SidecarConfig struct {
 
 // OverrideRule holds the manifest overriding rule for CloudFormation template.
 type OverrideRule struct {
+	Op    string    `yaml:"op"` // One of "add", "remove", "replace" or "test". Upserts the value if empty.
 	Path  string    `yaml:"path"`
 	Value yaml.Node `yaml:"value"`
 }
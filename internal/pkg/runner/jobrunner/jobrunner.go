@@ -1,20 +1,38 @@
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
 
-//Package jobrunner provides support for invoking jobs.
+// Package jobrunner provides support for invoking jobs.
 package jobrunner
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
+const pollInterval = 3 * time.Second
+
 // StateMachineExecutor is the interface that implements the Execute method to invoke a state machine.
 type StateMachineExecutor interface {
-	Execute(stateMachineARN string) error
+	Execute(stateMachineARN string) (string, error)
+}
+
+// ExecutionDescriber is the interface to describe a state machine and the history of its executions.
+type ExecutionDescriber interface {
+	StateMachineDefinition(stateMachineARN string) (string, error)
+	ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error)
 }
 
 // CFNStackResourceLister is the interface to list CloudFormation stack resources.
@@ -22,6 +40,16 @@ type CFNStackResourceLister interface {
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 }
 
+// LogWriter is the interface to write the logs of tasks until they stop.
+type LogWriter interface {
+	WriteEventsUntilStopped() error
+}
+
+// NonZeroExitCodeChecker is the interface to check whether the essential containers of stopped tasks exited with an error.
+type NonZeroExitCodeChecker interface {
+	HasNonZeroExitCode(taskARNs []string, cluster string) error
+}
+
 // JobRunner can invoke a job.
 type JobRunner struct {
 	app string
@@ -30,6 +58,13 @@ type JobRunner struct {
 
 	cfn          CFNStackResourceLister
 	stateMachine StateMachineExecutor
+
+	execution    ExecutionDescriber
+	newLogWriter func(tasks []*task.Task) LogWriter
+	exitCodes    NonZeroExitCodeChecker
+
+	// Replaced in tests.
+	sleep func()
 }
 
 // Config hold the data needed to create a JobRunner.
@@ -41,6 +76,11 @@ type Config struct {
 	// Dependencies to invoke a job.
 	CFN          CFNStackResourceLister // CloudFormation client to list stack resources.
 	StateMachine StateMachineExecutor   // StepFunction client to execute a state machine.
+
+	// Dependencies to follow the execution of a job, only required by RunAndFollow.
+	Execution    ExecutionDescriber                 // StepFunction client to poll the history of the execution.
+	NewLogWriter func(tasks []*task.Task) LogWriter // Creates a writer for the logs of the tasks of an attempt.
+	ExitCodes    NonZeroExitCodeChecker             // ECS client to retrieve the exit code of the tasks.
 }
 
 // New creates a new JobRunner.
@@ -51,6 +91,12 @@ func New(cfg *Config) *JobRunner {
 		job:          cfg.Job,
 		cfn:          cfg.CFN,
 		stateMachine: cfg.StateMachine,
+		execution:    cfg.Execution,
+		newLogWriter: cfg.NewLogWriter,
+		exitCodes:    cfg.ExitCodes,
+		sleep: func() {
+			time.Sleep(pollInterval)
+		},
 	}
 
 }
@@ -58,9 +104,77 @@ func New(cfg *Config) *JobRunner {
 // Run invokes a job.
 // An error is returned if the state machine's ARN can not be derived from the job, or the execution fails.
 func (job *JobRunner) Run() error {
+	_, _, err := job.start()
+	return err
+}
+
+// RunAndFollow invokes a job, and writes the logs of its tasks until the execution of the job stops.
+// Every retry of the job is written along with the reason of the failed attempt.
+// If the job does not succeed, an error is returned. The error is an *ecs.ErrExitCode if a container of the job
+// exited with a non-zero code.
+func (job *JobRunner) RunAndFollow() error {
+	stateMachineARN, executionARN, err := job.start()
+	if err != nil {
+		return err
+	}
+	maxAttempts, err := job.maxAttempts(stateMachineARN)
+	if err != nil {
+		return err
+	}
+	var lastEventID int64
+	var attempt int
+	var tasks []*task.Task
+	for {
+		events, err := job.execution.ExecutionHistory(executionARN)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if event.ID <= lastEventID {
+				continue
+			}
+			lastEventID = event.ID
+			switch event.Type {
+			case sfn.HistoryEventTypeTaskSubmitted:
+				attempt++
+				if tasks, err = submittedTasks(event.Output); err != nil {
+					return err
+				}
+				job.logAttempt(tasks, attempt, maxAttempts)
+				if len(tasks) == 0 {
+					continue
+				}
+				if err := job.newLogWriter(tasks).WriteEventsUntilStopped(); err != nil {
+					return fmt.Errorf("write logs of job %q: %w", job.job, err)
+				}
+			case sfn.HistoryEventTypeTaskSubmitFailed:
+				attempt++
+				tasks = nil
+				if attempt < maxAttempts {
+					log.Warningf("Attempt %d of %d of job %s could not start a task: %s. Retrying.\n", attempt, maxAttempts, job.job, ecs.FailureReason(event))
+				}
+			case sfn.HistoryEventTypeTaskFailed, sfn.HistoryEventTypeTaskTimedOut:
+				if attempt < maxAttempts {
+					log.Warningf("Attempt %d of %d of job %s failed: %s. Retrying.\n", attempt, maxAttempts, job.job, ecs.FailureReason(event))
+				}
+			case sfn.HistoryEventTypeExecutionSucceeded:
+				return nil
+			case sfn.HistoryEventTypeExecutionFailed, sfn.HistoryEventTypeExecutionTimedOut, sfn.HistoryEventTypeExecutionAborted:
+				if err := job.checkExitCode(tasks); err != nil {
+					return err
+				}
+				return fmt.Errorf("execution %s of job %q did not succeed: %s", executionARN, job.job, ecs.FailureReason(event))
+			}
+		}
+		job.sleep()
+	}
+}
+
+// start starts the execution of the state machine of the job, and returns the ARNs of the state machine and the execution.
+func (job *JobRunner) start() (stateMachineARN, executionARN string, err error) {
 	resources, err := job.cfn.StackResources(stack.NameForService(job.app, job.env, job.job))
 	if err != nil {
-		return fmt.Errorf("describe stack %q: %v", stack.NameForService(job.app, job.env, job.job), err)
+		return "", "", fmt.Errorf("describe stack %q: %v", stack.NameForService(job.app, job.env, job.job), err)
 	}
 
 	var arn string
@@ -71,10 +185,84 @@ func (job *JobRunner) Run() error {
 		}
 	}
 	if arn == "" {
-		return fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+		return "", "", fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
 	}
-	if err := job.stateMachine.Execute(arn); err != nil {
-		return fmt.Errorf("execute state machine %q: %v", arn, err)
+	executionARN, err = job.stateMachine.Execute(arn)
+	if err != nil {
+		return "", "", fmt.Errorf("execute state machine %q: %v", arn, err)
+	}
+	return arn, executionARN, nil
+}
+
+// maxAttempts returns the number of times the job is attempted, from the retries configured in its manifest.
+func (job *JobRunner) maxAttempts(stateMachineARN string) (int, error) {
+	raw, err := job.execution.StateMachineDefinition(stateMachineARN)
+	if err != nil {
+		return 0, fmt.Errorf("get definition of state machine %q: %w", stateMachineARN, err)
+	}
+	var definition struct {
+		States map[string]struct {
+			Retry []struct {
+				MaxAttempts int `json:"MaxAttempts"`
+			} `json:"Retry"`
+		} `json:"States"`
+	}
+	if err := json.Unmarshal([]byte(raw), &definition); err != nil {
+		return 0, fmt.Errorf("unmarshal definition of state machine %q: %w", stateMachineARN, err)
+	}
+	attempts := 1
+	for _, state := range definition.States {
+		for _, retrier := range state.Retry {
+			attempts += retrier.MaxAttempts
+		}
+	}
+	return attempts, nil
+}
+
+func (job *JobRunner) logAttempt(tasks []*task.Task, attempt, maxAttempts int) {
+	var taskID string
+	if len(tasks) != 0 {
+		taskID, _ = awsecs.TaskID(tasks[0].TaskARN)
+	}
+	if maxAttempts > 1 {
+		log.Infof("Started task %s of job %s (attempt %d of %d).\n", color.HighlightResource(taskID), job.job, attempt, maxAttempts)
+		return
+	}
+	log.Infof("Started task %s of job %s.\n", color.HighlightResource(taskID), job.job)
+}
+
+// checkExitCode returns an *ecs.ErrExitCode if an essential container of the tasks exited with a non-zero code.
+func (job *JobRunner) checkExitCode(tasks []*task.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	err := job.exitCodes.HasNonZeroExitCode(taskARNs, tasks[0].ClusterARN)
+	var errExitCode *ecs.ErrExitCode
+	if errors.As(err, &errExitCode) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("get exit code of job %q: %w", job.job, err)
 	}
 	return nil
 }
+
+// submittedTasks returns the tasks started by the "ecs:runTask.sync" integration from the output of a TaskSubmitted event.
+func submittedTasks(output string) ([]*task.Task, error) {
+	cluster, taskARNs, err := ecs.SubmittedTasks(output)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*task.Task, len(taskARNs))
+	for i, taskARN := range taskARNs {
+		tasks[i] = &task.Task{
+			TaskARN:    taskARN,
+			ClusterARN: cluster,
+		}
+	}
+	return tasks, nil
+}
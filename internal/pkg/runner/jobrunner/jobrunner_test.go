@@ -9,8 +9,12 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/runner/jobrunner/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/task"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -31,7 +35,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"missing stack": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -44,7 +48,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"missing statemachine resource": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil).AnyTimes()
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", nil).AnyTimes()
 			},
 			App: "appname",
 			Env: "envname",
@@ -61,7 +65,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"failed statemachine execution": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(fmt.Errorf("ExecutionLimitExceeded"))
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", fmt.Errorf("ExecutionLimitExceeded"))
 			},
 			App: "appname",
 			Env: "envname",
@@ -79,7 +83,7 @@ func TestJobRunner_Run(t *testing.T) {
 
 		"run success": {
 			MockExecutor: func(m *mocks.MockStateMachineExecutor) {
-				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return(nil)
+				m.EXPECT().Execute("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job").Return("", nil)
 			},
 			App: "appname",
 			Env: "envname",
@@ -125,3 +129,172 @@ func TestJobRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestJobRunner_RunAndFollow(t *testing.T) {
+	const (
+		mockStateMachineARN = "arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"
+		mockExecutionARN    = "arn:aws:states:us-east-1:111111111111:execution:app-env-job:1234"
+		mockDefinition      = `{"States":{"Run Fargate Task":{"Type":"Task","Retry":[{"ErrorEquals":["States.ALL"],"MaxAttempts":1}]}}}`
+		mockClusterARN      = "arn:aws:ecs:us-east-1:111111111111:cluster/app-env-Cluster"
+		mockTaskARN1        = "arn:aws:ecs:us-east-1:111111111111:task/app-env-Cluster/task1"
+		mockTaskARN2        = "arn:aws:ecs:us-east-1:111111111111:task/app-env-Cluster/task2"
+	)
+	submitted := func(id int64, taskARN string) stepfunctions.HistoryEvent {
+		return stepfunctions.HistoryEvent{
+			ID:     id,
+			Type:   sfn.HistoryEventTypeTaskSubmitted,
+			Output: fmt.Sprintf(`{"Tasks":[{"TaskArn":"%s","ClusterArn":"%s"}]}`, taskARN, mockClusterARN),
+		}
+	}
+	taskFailed := func(id int64) stepfunctions.HistoryEvent {
+		return stepfunctions.HistoryEvent{
+			ID:    id,
+			Type:  sfn.HistoryEventTypeTaskFailed,
+			Error: "States.TaskFailed",
+			Cause: `{"StoppedReason":"Essential container in task exited"}`,
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m jobRunnerMocks)
+
+		wantedLogged []string
+		wantedError  error
+	}{
+		"returns nil once the execution succeeds after a retry": {
+			setupMocks: func(m jobRunnerMocks) {
+				gomock.InOrder(
+					m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+						{ID: 1, Type: sfn.HistoryEventTypeExecutionStarted},
+						submitted(2, mockTaskARN1),
+					}, nil),
+					m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+						{ID: 1, Type: sfn.HistoryEventTypeExecutionStarted},
+						submitted(2, mockTaskARN1),
+						taskFailed(3),
+						submitted(4, mockTaskARN2),
+					}, nil),
+					m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+						{ID: 1, Type: sfn.HistoryEventTypeExecutionStarted},
+						submitted(2, mockTaskARN1),
+						taskFailed(3),
+						submitted(4, mockTaskARN2),
+						{ID: 5, Type: sfn.HistoryEventTypeTaskSucceeded},
+						{ID: 6, Type: sfn.HistoryEventTypeExecutionSucceeded},
+					}, nil),
+				)
+				m.logWriter.EXPECT().WriteEventsUntilStopped().Return(nil).Times(2)
+			},
+			wantedLogged: []string{mockTaskARN1, mockTaskARN2},
+		},
+		"returns the exit code of the container if the execution fails": {
+			setupMocks: func(m jobRunnerMocks) {
+				m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+					submitted(1, mockTaskARN1),
+					taskFailed(2),
+					submitted(3, mockTaskARN2),
+					taskFailed(4),
+					{ID: 5, Type: sfn.HistoryEventTypeExecutionFailed, Error: "States.TaskFailed"},
+				}, nil)
+				m.logWriter.EXPECT().WriteEventsUntilStopped().Return(nil).Times(2)
+				m.exitCodes.EXPECT().HasNonZeroExitCode([]string{mockTaskARN2}, mockClusterARN).Return(&ecs.ErrExitCode{})
+			},
+			wantedLogged: []string{mockTaskARN1, mockTaskARN2},
+			wantedError:  &ecs.ErrExitCode{},
+		},
+		"returns the reason of the failure if no container exited with an error": {
+			setupMocks: func(m jobRunnerMocks) {
+				m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+					submitted(1, mockTaskARN1),
+					{ID: 2, Type: sfn.HistoryEventTypeExecutionTimedOut, Error: "States.Timeout"},
+				}, nil)
+				m.logWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+				m.exitCodes.EXPECT().HasNonZeroExitCode([]string{mockTaskARN1}, mockClusterARN).Return(nil)
+			},
+			wantedLogged: []string{mockTaskARN1},
+			wantedError:  fmt.Errorf(`execution %s of job "jobname" did not succeed: States.Timeout`, mockExecutionARN),
+		},
+		"returns the reason of the failure if no task could be started": {
+			setupMocks: func(m jobRunnerMocks) {
+				m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+					submitted(1, mockTaskARN1),
+					taskFailed(2),
+					{ID: 3, Type: sfn.HistoryEventTypeTaskSubmitFailed, Error: "ECS.AmazonECSException", Cause: "No Container Instances were found in your cluster."},
+					{ID: 4, Type: sfn.HistoryEventTypeExecutionFailed, Error: "ECS.AmazonECSException", Cause: "No Container Instances were found in your cluster."},
+				}, nil)
+				m.logWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+			},
+			wantedLogged: []string{mockTaskARN1},
+			wantedError:  fmt.Errorf(`execution %s of job "jobname" did not succeed: ECS.AmazonECSException: No Container Instances were found in your cluster.`, mockExecutionARN),
+		},
+		"wraps the error from writing the logs": {
+			setupMocks: func(m jobRunnerMocks) {
+				m.execution.EXPECT().ExecutionHistory(mockExecutionARN).Return([]stepfunctions.HistoryEvent{
+					submitted(1, mockTaskARN1),
+				}, nil)
+				m.logWriter.EXPECT().WriteEventsUntilStopped().Return(errors.New("some error"))
+			},
+			wantedLogged: []string{mockTaskARN1},
+			wantedError:  errors.New(`write logs of job "jobname": some error`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobRunnerMocks{
+				cfn:          mocks.NewMockCFNStackResourceLister(ctrl),
+				stateMachine: mocks.NewMockStateMachineExecutor(ctrl),
+				execution:    mocks.NewMockExecutionDescriber(ctrl),
+				logWriter:    mocks.NewMockLogWriter(ctrl),
+				exitCodes:    mocks.NewMockNonZeroExitCodeChecker(ctrl),
+			}
+			m.cfn.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+				{
+					ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+					PhysicalResourceId: aws.String(mockStateMachineARN),
+				},
+			}, nil)
+			m.stateMachine.EXPECT().Execute(mockStateMachineARN).Return(mockExecutionARN, nil)
+			m.execution.EXPECT().StateMachineDefinition(mockStateMachineARN).Return(mockDefinition, nil)
+			tc.setupMocks(m)
+			var logged []string
+			jobRunner := JobRunner{
+				app:          "appname",
+				env:          "envname",
+				job:          "jobname",
+				cfn:          m.cfn,
+				stateMachine: m.stateMachine,
+				execution:    m.execution,
+				newLogWriter: func(tasks []*task.Task) LogWriter {
+					for _, t := range tasks {
+						logged = append(logged, t.TaskARN)
+					}
+					return m.logWriter
+				},
+				exitCodes: m.exitCodes,
+				sleep:     func() {},
+			}
+
+			// WHEN
+			err := jobRunner.RunAndFollow()
+
+			// THEN
+			require.Equal(t, tc.wantedLogged, logged)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type jobRunnerMocks struct {
+	cfn          *mocks.MockCFNStackResourceLister
+	stateMachine *mocks.MockStateMachineExecutor
+	execution    *mocks.MockExecutionDescriber
+	logWriter    *mocks.MockLogWriter
+	exitCodes    *mocks.MockNonZeroExitCodeChecker
+}
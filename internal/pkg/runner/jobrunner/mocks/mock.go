@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,11 +36,12 @@ func (m *MockStateMachineExecutor) EXPECT() *MockStateMachineExecutorMockRecorde
 }
 
 // Execute mocks base method.
-func (m *MockStateMachineExecutor) Execute(stateMachineARN string) error {
+func (m *MockStateMachineExecutor) Execute(stateMachineARN string) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Execute", stateMachineARN)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
@@ -48,6 +50,59 @@ func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN)
 }
 
+// MockExecutionDescriber is a mock of ExecutionDescriber interface.
+type MockExecutionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecutionDescriberMockRecorder
+}
+
+// MockExecutionDescriberMockRecorder is the mock recorder for MockExecutionDescriber.
+type MockExecutionDescriberMockRecorder struct {
+	mock *MockExecutionDescriber
+}
+
+// NewMockExecutionDescriber creates a new mock instance.
+func NewMockExecutionDescriber(ctrl *gomock.Controller) *MockExecutionDescriber {
+	mock := &MockExecutionDescriber{ctrl: ctrl}
+	mock.recorder = &MockExecutionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecutionDescriber) EXPECT() *MockExecutionDescriberMockRecorder {
+	return m.recorder
+}
+
+// ExecutionHistory mocks base method.
+func (m *MockExecutionDescriber) ExecutionHistory(executionARN string) ([]stepfunctions.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutionHistory", executionARN)
+	ret0, _ := ret[0].([]stepfunctions.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecutionHistory indicates an expected call of ExecutionHistory.
+func (mr *MockExecutionDescriberMockRecorder) ExecutionHistory(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutionHistory", reflect.TypeOf((*MockExecutionDescriber)(nil).ExecutionHistory), executionARN)
+}
+
+// StateMachineDefinition mocks base method.
+func (m *MockExecutionDescriber) StateMachineDefinition(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateMachineDefinition", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateMachineDefinition indicates an expected call of StateMachineDefinition.
+func (mr *MockExecutionDescriberMockRecorder) StateMachineDefinition(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockExecutionDescriber)(nil).StateMachineDefinition), stateMachineARN)
+}
+
 // MockCFNStackResourceLister is a mock of CFNStackResourceLister interface.
 type MockCFNStackResourceLister struct {
 	ctrl     *gomock.Controller
@@ -85,3 +140,77 @@ func (mr *MockCFNStackResourceListerMockRecorder) StackResources(name interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockCFNStackResourceLister)(nil).StackResources), name)
 }
+
+// MockLogWriter is a mock of LogWriter interface.
+type MockLogWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogWriterMockRecorder
+}
+
+// MockLogWriterMockRecorder is the mock recorder for MockLogWriter.
+type MockLogWriterMockRecorder struct {
+	mock *MockLogWriter
+}
+
+// NewMockLogWriter creates a new mock instance.
+func NewMockLogWriter(ctrl *gomock.Controller) *MockLogWriter {
+	mock := &MockLogWriter{ctrl: ctrl}
+	mock.recorder = &MockLogWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogWriter) EXPECT() *MockLogWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteEventsUntilStopped mocks base method.
+func (m *MockLogWriter) WriteEventsUntilStopped() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEventsUntilStopped")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteEventsUntilStopped indicates an expected call of WriteEventsUntilStopped.
+func (mr *MockLogWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MockLogWriter)(nil).WriteEventsUntilStopped))
+}
+
+// MockNonZeroExitCodeChecker is a mock of NonZeroExitCodeChecker interface.
+type MockNonZeroExitCodeChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonZeroExitCodeCheckerMockRecorder
+}
+
+// MockNonZeroExitCodeCheckerMockRecorder is the mock recorder for MockNonZeroExitCodeChecker.
+type MockNonZeroExitCodeCheckerMockRecorder struct {
+	mock *MockNonZeroExitCodeChecker
+}
+
+// NewMockNonZeroExitCodeChecker creates a new mock instance.
+func NewMockNonZeroExitCodeChecker(ctrl *gomock.Controller) *MockNonZeroExitCodeChecker {
+	mock := &MockNonZeroExitCodeChecker{ctrl: ctrl}
+	mock.recorder = &MockNonZeroExitCodeCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonZeroExitCodeChecker) EXPECT() *MockNonZeroExitCodeCheckerMockRecorder {
+	return m.recorder
+}
+
+// HasNonZeroExitCode mocks base method.
+func (m *MockNonZeroExitCodeChecker) HasNonZeroExitCode(taskARNs []string, cluster string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNonZeroExitCode", taskARNs, cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HasNonZeroExitCode indicates an expected call of HasNonZeroExitCode.
+func (mr *MockNonZeroExitCodeCheckerMockRecorder) HasNonZeroExitCode(taskARNs, cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNonZeroExitCode", reflect.TypeOf((*MockNonZeroExitCodeChecker)(nil).HasNonZeroExitCode), taskARNs, cluster)
+}
@@ -23,6 +23,16 @@ const (
 	rollOutEmpty               = ""
 )
 
+// Phases of a deployment that shifts production traffic to the new revision.
+const (
+	trafficShiftPhaseStarting   = "Starting"
+	trafficShiftPhaseScalingUp  = "Scaling up the new revision"
+	trafficShiftPhaseShifting   = "Shifting traffic"
+	trafficShiftPhaseBaking     = "Baking"
+	trafficShiftPhaseCompleted  = "Completed"
+	trafficShiftPhaseRolledBack = "Rolled back"
+)
+
 var ecsEventFailureKeywords = []string{"fail", "unhealthy", "error", "throttle", "unable", "missing"}
 
 // ECSServiceDescriber is the interface to describe an ECS service.
@@ -30,6 +40,11 @@ type ECSServiceDescriber interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
 }
 
+// ListenerRuleDescriber is the interface to describe how a listener rule splits traffic across target groups.
+type ListenerRuleDescriber interface {
+	TargetGroupWeights(ruleARN string) (map[string]int, error)
+}
+
 // ECSDeployment represent an ECS rolling update deployment.
 type ECSDeployment struct {
 	Status          string
@@ -58,10 +73,18 @@ func (d ECSDeployment) done() bool {
 	}
 }
 
+// ECSTrafficShift represents the progress of a canary, linear or blue/green deployment.
+type ECSTrafficShift struct {
+	Phase           string
+	TaskDefRevision string // Revision that the traffic is shifted to.
+	Percent         int    // Percentage of the production traffic routed to the revision.
+}
+
 // ECSService is a description of an ECS service.
 type ECSService struct {
 	Deployments         []ECSDeployment
 	LatestFailureEvents []string
+	TrafficShift        *ECSTrafficShift // Nil unless the service shifts traffic between target groups during deployments.
 }
 
 // ECSDeploymentStreamer is a Streamer for ECSService descriptions until the deployment is completed.
@@ -82,12 +105,29 @@ type ECSDeploymentStreamer struct {
 	mu            sync.Mutex
 
 	retries int
+
+	// Optional fields to describe how traffic is shifted to the new revision.
+	rules           ListenerRuleDescriber
+	ruleARN         string
+	prevTargetGroup string
+}
+
+// ECSDeploymentStreamerOption is a functional option to configure an ECSDeploymentStreamer.
+type ECSDeploymentStreamerOption func(s *ECSDeploymentStreamer)
+
+// WithTrafficShift streams how the production traffic of the listener rule is shifted
+// to the new revision of the service.
+func WithTrafficShift(rules ListenerRuleDescriber, ruleARN string) ECSDeploymentStreamerOption {
+	return func(s *ECSDeploymentStreamer) {
+		s.rules = rules
+		s.ruleARN = ruleARN
+	}
 }
 
 // NewECSDeploymentStreamer creates a new ECSDeploymentStreamer that streams service descriptions
 // since the deployment creation time and until the primary deployment is completed.
-func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time) *ECSDeploymentStreamer {
-	return &ECSDeploymentStreamer{
+func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string, deploymentCreationTime time.Time, opts ...ECSDeploymentStreamerOption) *ECSDeploymentStreamer {
+	s := &ECSDeploymentStreamer{
 		client:                 ecs,
 		clock:                  realClock{},
 		rand:                   rand.Intn,
@@ -97,6 +137,10 @@ func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cluster, service string,
 		done:                   make(chan struct{}),
 		pastEventIDs:           make(map[string]bool),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Subscribe returns a read-only channel that will receive service descriptions from the ECSDeploymentStreamer.
@@ -166,13 +210,58 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		}
 		s.pastEventIDs[id] = true
 	}
+	var trafficShift *ECSTrafficShift
+	if s.rules != nil {
+		weights, err := s.rules.TargetGroupWeights(s.ruleARN)
+		if err != nil {
+			if request.IsErrorThrottle(err) {
+				s.retries += 1
+				return nextFetchDate(s.clock, s.rand, s.retries), nil
+			}
+			return next, fmt.Errorf("fetch traffic shift of listener rule: %w", err)
+		}
+		trafficShift = s.trafficShift(deployments, weights)
+	}
 	s.eventsToFlush = append(s.eventsToFlush, ECSService{
 		Deployments:         deployments,
 		LatestFailureEvents: failureMsgs,
+		TrafficShift:        trafficShift,
 	})
 	return nextFetchDate(s.clock, s.rand, 0), nil
 }
 
+// trafficShift returns the progress of the deployment given the weights of the target groups of the production listener rule.
+func (s *ECSDeploymentStreamer) trafficShift(deployments []ECSDeployment, weights map[string]int) *ECSTrafficShift {
+	var total int
+	for _, weight := range weights {
+		total += weight
+	}
+	if s.prevTargetGroup == "" {
+		// Before any traffic is shifted, the target group receiving all the traffic serves the previous revision.
+		for tg, weight := range weights {
+			if weight == total {
+				s.prevTargetGroup = tg
+			}
+		}
+	}
+	if s.prevTargetGroup == "" || len(weights) < 2 || total == 0 {
+		return nil
+	}
+	var primary ECSDeployment
+	for _, d := range deployments {
+		if d.isPrimary() {
+			primary = d
+			break
+		}
+	}
+	percent := 100 * (total - weights[s.prevTargetGroup]) / total
+	return &ECSTrafficShift{
+		Phase:           trafficShiftPhase(primary, percent, s.deploymentCreationTime),
+		TaskDefRevision: primary.TaskDefRevision,
+		Percent:         percent,
+	}
+}
+
 // Notify flushes all new events to the streamer's subscribers.
 func (s *ECSDeploymentStreamer) Notify() {
 	// Copy current list of subscribers over, so that we can we add more subscribers while
@@ -223,6 +312,23 @@ func isFailureServiceEvent(msg string) bool {
 	return false
 }
 
+func trafficShiftPhase(primary ECSDeployment, percent int, startTime time.Time) string {
+	switch {
+	case primary.UpdatedAt.Before(startTime):
+		return trafficShiftPhaseStarting
+	case primary.RolloutState == rollOutFailed:
+		return trafficShiftPhaseRolledBack
+	case primary.RolloutState == rollOutCompleted:
+		return trafficShiftPhaseCompleted
+	case percent == 0:
+		return trafficShiftPhaseScalingUp
+	case percent < 100:
+		return trafficShiftPhaseShifting
+	default:
+		return trafficShiftPhaseBaking
+	}
+}
+
 func isDeploymentDone(d ECSDeployment, startTime time.Time) bool {
 	if !d.isPrimary() {
 		return false
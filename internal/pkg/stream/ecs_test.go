@@ -23,6 +23,21 @@ func (m mockECS) Service(clusterName, serviceName string) (*ecs.Service, error)
 	return m.out, m.err
 }
 
+type mockListenerRules struct {
+	weights []map[string]int // Weights returned on each successive call.
+	calls   int
+	err     error
+}
+
+func (m *mockListenerRules) TargetGroupWeights(ruleARN string) (map[string]int, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	out := m.weights[m.calls]
+	m.calls++
+	return out, nil
+}
+
 func TestECSDeploymentStreamer_Subscribe(t *testing.T) {
 	t.Run("allow new subscriptions if stack streamer is still active", func(t *testing.T) {
 		// GIVEN
@@ -345,6 +360,84 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only event to flush")
 		require.Nil(t, streamer.eventsToFlush[0].LatestFailureEvents, "there should be no failed events emitted")
 	})
+	t.Run("returns a wrapped error on describe listener rule call failure", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{},
+		}
+		rules := &mockListenerRules{
+			err: errors.New("some error"),
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now(), WithTrafficShift(rules, "rule"))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch traffic shift of listener rule: some error")
+	})
+	t.Run("stores the traffic shift phases of the deployment", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		inProgress := &ecs.Service{
+			Deployments: []*awsecs.Deployment{
+				{
+					DesiredCount:   aws.Int64(10),
+					FailedTasks:    aws.Int64(0),
+					PendingCount:   aws.Int64(0),
+					RolloutState:   aws.String("IN_PROGRESS"),
+					RunningCount:   aws.Int64(10),
+					Status:         aws.String("PRIMARY"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2"),
+					UpdatedAt:      aws.Time(startDate.Add(time.Minute)),
+				},
+			},
+		}
+		rules := &mockListenerRules{
+			weights: []map[string]int{
+				{"blue": 100, "green": 0},
+				{"blue": 80, "green": 20},
+				{"blue": 0, "green": 100},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(mockECS{out: inProgress}, "my-cluster", "my-svc", startDate, WithTrafficShift(rules, "rule"))
+
+		// WHEN
+		for i := 0; i < len(rules.weights); i++ {
+			_, err := streamer.Fetch()
+			require.NoError(t, err)
+		}
+
+		// THEN
+		var got []ECSTrafficShift
+		for _, event := range streamer.eventsToFlush {
+			got = append(got, *event.TrafficShift)
+		}
+		require.Equal(t, []ECSTrafficShift{
+			{Phase: "Scaling up the new revision", TaskDefRevision: "2", Percent: 0},
+			{Phase: "Shifting traffic", TaskDefRevision: "2", Percent: 20},
+			{Phase: "Baking", TaskDefRevision: "2", Percent: 100},
+		}, got)
+	})
+	t.Run("ignores traffic shift if the listener rule forwards to a single target group", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{},
+		}
+		rules := &mockListenerRules{
+			weights: []map[string]int{
+				{"blue": 1},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now(), WithTrafficShift(rules, "rule"))
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Nil(t, streamer.eventsToFlush[0].TrafficShift)
+	})
 }
 
 func TestECSDeploymentStreamer_Notify(t *testing.T) {
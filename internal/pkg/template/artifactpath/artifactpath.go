@@ -8,11 +8,13 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"path"
+	"strconv"
 )
 
 const (
 	s3ArtifactDirName         = "manual"
 	s3TemplateDirName         = "templates"
+	s3RevisionsDirName        = "revisions"
 	s3ArtifactAddonsDirName   = "addons"
 	s3ArtifactEnvFilesDirName = "env-files"
 	s3ScriptsDirName          = "scripts"
@@ -36,6 +38,18 @@ func CFNTemplate(key string, content []byte) string {
 	return path.Join(s3ArtifactDirName, s3TemplateDirName, key, fmt.Sprintf("%x.yml", sha256.Sum256(content)))
 }
 
+// TaskDefRevisionTemplate returns the path to store the key of the cloudformation template that deployed a task definition revision.
+// Example: manual/templates/key/revisions/12.
+func TaskDefRevisionTemplate(key string, revision int64) string {
+	return path.Join(TaskDefRevisionTemplateDir(key), strconv.FormatInt(revision, 10))
+}
+
+// TaskDefRevisionTemplateDir returns the directory under which the template keys of the task definition revisions of a stack are stored.
+// Example: manual/templates/key/revisions/.
+func TaskDefRevisionTemplateDir(key string) string {
+	return path.Join(s3ArtifactDirName, s3TemplateDirName, key, s3RevisionsDirName) + "/"
+}
+
 // EnvFiles returns the path to store an env file artifact with sha256 of the content..
 // Example: manual/env-files/key/sha.env.
 func EnvFiles(key string, content []byte) string {
@@ -12,3 +12,8 @@ import (
 func TestCustomResource(t *testing.T) {
 	require.Equal(t, "manual/scripts/custom-resources/envcontrollerfunction/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zip", CustomResource("envcontrollerfunction", []byte("")))
 }
+
+func TestTaskDefRevisionTemplate(t *testing.T) {
+	require.Equal(t, "manual/templates/phonetool-test-api/revisions/12", TaskDefRevisionTemplate("phonetool-test-api", 12))
+	require.Equal(t, "manual/templates/phonetool-test-api/revisions/", TaskDefRevisionTemplateDir("phonetool-test-api"))
+}
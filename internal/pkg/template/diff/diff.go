@@ -0,0 +1,292 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff provides functionality to compare two CloudFormation templates in YAML.
+package diff
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Tree represents a difference tree between two YAML documents.
+type Tree struct {
+	root diffNode
+}
+
+// IsEmpty returns true if there are no differences between the two documents.
+func (t Tree) IsEmpty() bool {
+	return t.root == nil
+}
+
+// diffNode is the interface to represent the difference between two *yaml.Node.
+type diffNode interface {
+	key() string
+	oldYAML() *yaml.Node
+	newYAML() *yaml.Node
+	children() []diffNode
+}
+
+// keyNode is a diff node representing the value of a mapping key.
+// If the node has children, then the value is a collection that is modified partially.
+// Otherwise, the value is either added (old is nil), removed (new is nil), or replaced.
+type keyNode struct {
+	keyValue   string
+	childNodes []diffNode
+	oldV       *yaml.Node
+	newV       *yaml.Node
+}
+
+func (n *keyNode) key() string {
+	return n.keyValue
+}
+
+func (n *keyNode) oldYAML() *yaml.Node {
+	return n.oldV
+}
+
+func (n *keyNode) newYAML() *yaml.Node {
+	return n.newV
+}
+
+func (n *keyNode) children() []diffNode {
+	return n.childNodes
+}
+
+// seqItemNode is a diff node representing an item of a sequence.
+type seqItemNode struct {
+	keyNode
+}
+
+// unchangedNode represents a run of sequence items that are identical in both documents.
+type unchangedNode struct {
+	count int
+}
+
+func (n *unchangedNode) key() string {
+	return ""
+}
+
+func (n *unchangedNode) oldYAML() *yaml.Node {
+	return nil
+}
+
+func (n *unchangedNode) newYAML() *yaml.Node {
+	return nil
+}
+
+func (n *unchangedNode) children() []diffNode {
+	return nil
+}
+
+// From is the YAML document that another YAML document is compared against.
+type From []byte
+
+// Parse constructs a diff tree that represents the differences of a YAML document against the From document.
+// CloudFormation intrinsic functions in their short form, such as "!Ref" or "!Sub", are considered identical
+// to their full form, and mapping keys are compared regardless of their order.
+func (from From) Parse(to []byte) (Tree, error) {
+	var fromNode, toNode yaml.Node
+	if err := yaml.Unmarshal(from, &fromNode); err != nil {
+		return Tree{}, fmt.Errorf("unmarshal current template: %w", err)
+	}
+	if err := yaml.Unmarshal(to, &toNode); err != nil {
+		return Tree{}, fmt.Errorf("unmarshal new template: %w", err)
+	}
+	fromContent, toContent := documentContent(&fromNode), documentContent(&toNode)
+	// Compare against an empty mapping when one of the templates doesn't exist yet, so that
+	// the differences are reported per top-level section instead of as one opaque document.
+	if fromContent == nil && toContent != nil && toContent.Kind == yaml.MappingNode {
+		fromContent = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+	if toContent == nil && fromContent != nil && fromContent.Kind == yaml.MappingNode {
+		toContent = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+	root, err := parse(fromContent, toContent, "")
+	if err != nil {
+		return Tree{}, err
+	}
+	return Tree{
+		root: root,
+	}, nil
+}
+
+func documentContent(node *yaml.Node) *yaml.Node {
+	if node.Kind != yaml.DocumentNode || len(node.Content) == 0 {
+		// The node is empty if the document is empty.
+		return nil
+	}
+	return node.Content[0]
+}
+
+func parse(from, to *yaml.Node, key string) (diffNode, error) {
+	from, to = resolveAlias(from), resolveAlias(to)
+	if from == nil && to == nil {
+		return nil, nil
+	}
+	if from == nil || to == nil {
+		return &keyNode{
+			keyValue: key,
+			oldV:     from,
+			newV:     to,
+		}, nil
+	}
+	if equal(from, to) {
+		return nil, nil
+	}
+	if from.Kind != to.Kind || !sameTag(from, to) {
+		return &keyNode{
+			keyValue: key,
+			oldV:     from,
+			newV:     to,
+		}, nil
+	}
+	var children []diffNode
+	var err error
+	switch from.Kind {
+	case yaml.MappingNode:
+		children, err = parseMap(from, to)
+	case yaml.SequenceNode:
+		children, err = parseSeq(from, to)
+	default:
+		return &keyNode{
+			keyValue: key,
+			oldV:     from,
+			newV:     to,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &keyNode{
+		keyValue:   key,
+		childNodes: children,
+	}, nil
+}
+
+func parseMap(from, to *yaml.Node) ([]diffNode, error) {
+	fromPairs, toPairs := mappingPairs(from), mappingPairs(to)
+	var keys []string
+	seen := make(map[string]struct{})
+	// Keep the order of keys in the new document, followed by keys that only exist in the old one.
+	for _, n := range []*yaml.Node{to, from} {
+		for i := 0; i < len(n.Content); i += 2 {
+			k := n.Content[i].Value
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			keys = append(keys, k)
+		}
+	}
+	var children []diffNode
+	for _, k := range keys {
+		child, err := parse(fromPairs[k], toPairs[k], k)
+		if err != nil {
+			return nil, err
+		}
+		if child == nil {
+			continue
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
+
+func mappingPairs(node *yaml.Node) map[string]*yaml.Node {
+	pairs := make(map[string]*yaml.Node, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		pairs[node.Content[i].Value] = node.Content[i+1]
+	}
+	return pairs
+}
+
+// parseSeq compares two sequences item by item based on their longest common subsequence,
+// so that inserting or removing an item doesn't mark every following item as changed.
+func parseSeq(from, to *yaml.Node) ([]diffNode, error) {
+	oldItems, newItems := from.Content, to.Content
+	lcs := make([][]int, len(oldItems)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newItems)+1)
+	}
+	for i := len(oldItems) - 1; i >= 0; i-- {
+		for j := len(newItems) - 1; j >= 0; j-- {
+			switch {
+			case equal(oldItems[i], newItems[j]):
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var children []diffNode
+	var removed, added []*yaml.Node
+	unchanged := 0
+	flushUnchanged := func() {
+		if unchanged > 0 {
+			children = append(children, &unchangedNode{count: unchanged})
+			unchanged = 0
+		}
+	}
+	flushChanges := func() error {
+		// Pair up removed and added items as modifications to surface the fields that changed.
+		for len(removed) > 0 && len(added) > 0 {
+			child, err := parse(removed[0], added[0], "")
+			if err != nil {
+				return err
+			}
+			children = append(children, toSeqItem(child))
+			removed, added = removed[1:], added[1:]
+		}
+		for _, item := range removed {
+			children = append(children, &seqItemNode{keyNode{oldV: item}})
+		}
+		for _, item := range added {
+			children = append(children, &seqItemNode{keyNode{newV: item}})
+		}
+		removed, added = nil, nil
+		return nil
+	}
+	for i, j := 0, 0; i < len(oldItems) || j < len(newItems); {
+		switch {
+		case i < len(oldItems) && j < len(newItems) && equal(oldItems[i], newItems[j]):
+			if err := flushChanges(); err != nil {
+				return nil, err
+			}
+			unchanged++
+			i, j = i+1, j+1
+		case i < len(oldItems) && (j == len(newItems) || lcs[i+1][j] >= lcs[i][j+1]):
+			flushUnchanged()
+			removed = append(removed, oldItems[i])
+			i++
+		default:
+			flushUnchanged()
+			added = append(added, newItems[j])
+			j++
+		}
+	}
+	flushUnchanged()
+	if err := flushChanges(); err != nil {
+		return nil, err
+	}
+	return children, nil
+}
+
+func toSeqItem(node diffNode) diffNode {
+	kn, ok := node.(*keyNode)
+	if !ok {
+		return node
+	}
+	return &seqItemNode{*kn}
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	return node
+}
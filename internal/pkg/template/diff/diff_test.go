@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrom_Parse(t *testing.T) {
+	testCases := map[string]struct {
+		old, new    string
+		wantedEmpty bool
+		wantedTree  string
+		wantedErr   string
+	}{
+		"identical documents": {
+			old: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			new: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service`,
+			wantedEmpty: true,
+		},
+		"reordered mapping keys and reformatted scalars are not differences": {
+			old: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      ServiceName: "api"`,
+			new: `
+Resources:
+  Service:
+    Properties:
+      ServiceName: api
+      DesiredCount: "1"
+    Type: 'AWS::ECS::Service'`,
+			wantedEmpty: true,
+		},
+		"short form intrinsic functions are identical to their full form": {
+			old: `
+Outputs:
+  Arn:
+    Value: !GetAtt Service.Name
+  Url:
+    Value: !Sub "https://${LB.DNSName}"
+  Cluster:
+    Value: !Ref Cluster`,
+			new: `
+Outputs:
+  Arn:
+    Value:
+      Fn::GetAtt: [Service, Name]
+  Url:
+    Value:
+      Fn::Sub: https://${LB.DNSName}
+  Cluster:
+    Value: { Ref: Cluster }`,
+			wantedEmpty: true,
+		},
+		"modified, added and removed keys": {
+			old: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 1
+      Cluster: !Ref Cluster
+      Removed: true`,
+			new: `
+Resources:
+  Service:
+    Properties:
+      DesiredCount: 2
+      Cluster: !Ref NewCluster
+      Added:
+        Key: value`,
+			wantedTree: `~ Resources:
+    ~ Service:
+        ~ Properties:
+            ~ DesiredCount: 1 -> 2
+            ~ Cluster: !Ref Cluster -> !Ref NewCluster
+            + Added:
+            +   Key: value
+            - Removed: true
+`,
+		},
+		"sequence items are matched even when an item is inserted": {
+			old: `
+Containers:
+  - Name: nginx
+  - Name: app
+    Image: app:1
+  - Name: firelens`,
+			new: `
+Containers:
+  - Name: envoy
+  - Name: nginx
+  - Name: app
+    Image: app:2
+  - Name: firelens`,
+			wantedTree: `~ Containers:
+    + - Name: envoy
+    (1 unchanged item)
+    ~ - (changed item)
+        ~ Image: app:1 -> app:2
+    (1 unchanged item)
+`,
+		},
+		"modified intrinsic function arguments": {
+			old: `
+Value: !Join ["-", [!Ref App, api]]`,
+			new: `
+Value: !Join ["-", [!Ref App, !Ref Env, api]]`,
+			wantedTree: `~ Value:
+    (1 unchanged item)
+    ~ - (changed item)
+        (1 unchanged item)
+        + - !Ref Env
+        (1 unchanged item)
+`,
+		},
+		"value changes its type": {
+			old: `
+Value: !Sub "${AWS::Region}"`,
+			new: `
+Value:
+  Fn::Sub: ${AWS::AccountId}`,
+			wantedTree: `- Value: !Sub "${AWS::Region}"
++ Value:
++   Fn::Sub: ${AWS::AccountId}
+`,
+		},
+		"every section is added when there is no deployed template": {
+			new: `
+Parameters:
+  Env:
+    Type: String
+Resources: {}`,
+			wantedTree: `+ Parameters:
++   Env:
++     Type: String
++ Resources: {}
+`,
+		},
+		"error if the new document is malformed": {
+			old:       `Resources: {}`,
+			new:       `Resources: [`,
+			wantedErr: "unmarshal new template: yaml: line 1: did not find expected node content",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).Parse([]byte(tc.new))
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEmpty, tree.IsEmpty())
+			if tc.wantedEmpty {
+				return
+			}
+			var out strings.Builder
+			require.NoError(t, NewTreeWriter(tree, &out).Write())
+			require.Equal(t, tc.wantedTree, out.String())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// intrinsicFuncFullNames maps the short form tag of a CloudFormation intrinsic function to its full form name.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/intrinsic-function-reference.html.
+var intrinsicFuncFullNames = map[string]string{
+	"!And":         "Fn::And",
+	"!Base64":      "Fn::Base64",
+	"!Cidr":        "Fn::Cidr",
+	"!Condition":   "Condition",
+	"!Equals":      "Fn::Equals",
+	"!FindInMap":   "Fn::FindInMap",
+	"!GetAtt":      "Fn::GetAtt",
+	"!GetAZs":      "Fn::GetAZs",
+	"!If":          "Fn::If",
+	"!ImportValue": "Fn::ImportValue",
+	"!Join":        "Fn::Join",
+	"!Not":         "Fn::Not",
+	"!Or":          "Fn::Or",
+	"!Ref":         "Ref",
+	"!Select":      "Fn::Select",
+	"!Split":       "Fn::Split",
+	"!Sub":         "Fn::Sub",
+	"!Transform":   "Fn::Transform",
+}
+
+// Default tags of untagged YAML nodes: https://yaml.org/spec/1.2.2/#103-core-schema.
+const (
+	tagStr  = "!!str"
+	tagSeq  = "!!seq"
+	tagMap  = "!!map"
+	tagNull = "!!null"
+)
+
+// fullForm converts an intrinsic function in its short form, such as "!Ref Foo", to its
+// full form "{Ref: Foo}". Any other node is returned as is.
+func fullForm(node *yaml.Node) *yaml.Node {
+	node = resolveAlias(node)
+	if node == nil {
+		return nil
+	}
+	name, ok := intrinsicFuncFullNames[node.Tag]
+	if !ok {
+		return node
+	}
+	value := *node
+	switch node.Kind {
+	case yaml.ScalarNode:
+		value.Tag = tagStr
+		if node.Tag == "!GetAtt" {
+			// The short form "!GetAtt LogicalID.Attribute" is equivalent to "Fn::GetAtt: [LogicalID, Attribute]".
+			// Attribute names can contain dots, such as "Endpoint.Address", so we only split on the first one.
+			var items []*yaml.Node
+			for _, part := range strings.SplitN(node.Value, ".", 2) {
+				items = append(items, &yaml.Node{Kind: yaml.ScalarNode, Tag: tagStr, Value: part})
+			}
+			value = yaml.Node{Kind: yaml.SequenceNode, Tag: tagSeq, Content: items}
+		}
+	case yaml.SequenceNode:
+		value.Tag = tagSeq
+	case yaml.MappingNode:
+		value.Tag = tagMap
+	}
+	return &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  tagMap,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: tagStr, Value: name},
+			&value,
+		},
+	}
+}
+
+// equal returns true if the two nodes are semantically identical CloudFormation values.
+// Mapping keys are compared regardless of their order, scalars regardless of their style, and
+// intrinsic functions regardless of whether they are in their short or full form.
+func equal(a, b *yaml.Node) bool {
+	a, b = fullForm(a), fullForm(b)
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Kind != b.Kind {
+		return false
+	}
+	switch a.Kind {
+	case yaml.ScalarNode:
+		if a.ShortTag() == tagNull || b.ShortTag() == tagNull {
+			return a.ShortTag() == b.ShortTag()
+		}
+		// "80" and 80 are equivalent in a CloudFormation template, so only the value is compared.
+		return a.Value == b.Value
+	case yaml.SequenceNode:
+		if len(a.Content) != len(b.Content) {
+			return false
+		}
+		for i := range a.Content {
+			if !equal(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return true
+	case yaml.MappingNode:
+		aPairs, bPairs := mappingPairs(a), mappingPairs(b)
+		if len(aPairs) != len(bPairs) {
+			return false
+		}
+		for k, v := range aPairs {
+			other, ok := bPairs[k]
+			if !ok || !equal(v, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// sameTag returns true if both nodes are of the same type, for example both are "!Sub" functions.
+func sameTag(a, b *yaml.Node) bool {
+	return a.ShortTag() == b.ShortTag()
+}
@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/dustin/go-humanize/english"
+	fcolor "github.com/fatih/color"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	indentInc = 4
+
+	prefixAdd    = "+"
+	prefixDel    = "-"
+	prefixMod    = "~"
+	seqItemLabel = "- (changed item)"
+)
+
+// TreeWriter writes the string representation of a diff tree.
+type TreeWriter struct {
+	tree   Tree
+	writer io.Writer
+}
+
+// NewTreeWriter constructs a new TreeWriter.
+func NewTreeWriter(tree Tree, writer io.Writer) *TreeWriter {
+	return &TreeWriter{
+		tree:   tree,
+		writer: writer,
+	}
+}
+
+// Write writes the string representation of the diff tree to the writer.
+func (w *TreeWriter) Write() error {
+	if w.tree.IsEmpty() {
+		return nil
+	}
+	root := w.tree.root
+	if len(root.children()) == 0 {
+		// The documents are not mappings, so they are compared as a whole.
+		return w.writeLeaf(root, 0)
+	}
+	for _, child := range root.children() {
+		if err := w.write(child, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *TreeWriter) write(node diffNode, indent int) error {
+	if node, ok := node.(*unchangedNode); ok {
+		return w.writeLines(color.Faint, indent, "", fmt.Sprintf("(%d unchanged %s)", node.count, english.PluralWord(node.count, "item", "")))
+	}
+	if len(node.children()) == 0 {
+		return w.writeLeaf(node, indent)
+	}
+	label := node.key() + ":"
+	if _, ok := node.(*seqItemNode); ok {
+		label = seqItemLabel
+	}
+	if err := w.writeLines(color.Yellow, indent, prefixMod, label); err != nil {
+		return err
+	}
+	for _, child := range node.children() {
+		if err := w.write(child, indent+indentInc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *TreeWriter) writeLeaf(node diffNode, indent int) error {
+	_, isSeqItem := node.(*seqItemNode)
+	oldV, newV := node.oldYAML(), node.newYAML()
+	if oldV != nil && newV != nil && isInlineScalar(oldV) && isInlineScalar(newV) {
+		line := fmt.Sprintf("%s -> %s", inlineScalar(oldV), inlineScalar(newV))
+		switch {
+		case isSeqItem:
+			line = "- " + line
+		case node.key() != "":
+			line = fmt.Sprintf("%s: %s", node.key(), line)
+		}
+		return w.writeLines(color.Yellow, indent, prefixMod, line)
+	}
+	if oldV != nil {
+		out, err := marshalEntry(node.key(), isSeqItem, oldV)
+		if err != nil {
+			return err
+		}
+		if err := w.writeLines(color.Red, indent, prefixDel, out); err != nil {
+			return err
+		}
+	}
+	if newV != nil {
+		out, err := marshalEntry(node.key(), isSeqItem, newV)
+		if err != nil {
+			return err
+		}
+		if err := w.writeLines(color.Green, indent, prefixAdd, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *TreeWriter) writeLines(c *fcolor.Color, indent int, prefix, content string) error {
+	for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		if prefix != "" {
+			line = prefix + " " + line
+		}
+		if _, err := c.Fprintln(w.writer, strings.Repeat(" ", indent)+line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isInlineScalar(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && !strings.Contains(node.Value, "\n")
+}
+
+func inlineScalar(node *yaml.Node) string {
+	out, err := marshal(node)
+	if err != nil {
+		return node.Value
+	}
+	return strings.TrimSuffix(out, "\n")
+}
+
+// marshalEntry marshals a value as it would appear in its parent collection.
+func marshalEntry(key string, isSeqItem bool, value *yaml.Node) (string, error) {
+	switch {
+	case isSeqItem:
+		return marshal(&yaml.Node{
+			Kind:    yaml.SequenceNode,
+			Tag:     tagSeq,
+			Content: []*yaml.Node{value},
+		})
+	case key != "":
+		return marshal(&yaml.Node{
+			Kind: yaml.MappingNode,
+			Tag:  tagMap,
+			Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Tag: tagStr, Value: key},
+				value,
+			},
+		})
+	}
+	return marshal(value)
+}
+
+func marshal(node *yaml.Node) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return "", fmt.Errorf("marshal YAML node: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("marshal YAML node: %w", err)
+	}
+	return buf.String(), nil
+}
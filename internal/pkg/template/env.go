@@ -105,13 +105,51 @@ type EnvOpts struct {
 	Telemetry                *Telemetry
 
 	CDNConfig *CDNConfig // If nil, no cdn is to be used
+	Addons    *EnvAddons // If nil, the environment has no addons.
 
 	LatestVersion      string
 	SerializedManifest string // Serialized manifest used to render the environment template.
 }
 
+// EnvAddons holds the nested stack of the addons shared by the workloads of an environment.
+type EnvAddons struct {
+	URL         string   // S3 object URL of the merged addons template.
+	ExtraParams string   // Additional user defined Parameters for the addons stack.
+	Params      []string // Names of the parameters declared by the addons template.
+	Outputs     []string // Names of the addons stack outputs exported by the environment stack.
+}
+
+// HasParam returns true if the addons template declares the parameter, so that the environment stack can pass it a value.
+func (a EnvAddons) HasParam(name string) bool {
+	return contains(a.Params, name)
+}
+
 // CDNConfig represents a Content Delivery Network deployed by CloudFront.
-type CDNConfig struct{}
+type CDNConfig struct {
+	Certificate           string   // If not empty, the imported certificate used by the distribution to terminate TLS.
+	Aliases               []string // The domain names served by the distribution, set along with the certificate.
+	PriceClass            string
+	WebACL                string
+	OriginRequestPolicy   string // The origin request policy of the default cache behavior.
+	ResponseHeadersPolicy string // The response headers policy of the default cache behavior.
+	CacheBehaviors        []CDNCacheBehavior
+	StaticAssets          []CDNStaticAssets
+	StaticAssetsBuckets   []string // The unique names of the buckets of the static assets, whose policy lets the distribution read them.
+}
+
+// CDNCacheBehavior holds the policies applied to requests to the load balancer that match the path pattern.
+type CDNCacheBehavior struct {
+	PathPattern           string
+	CachePolicy           string
+	OriginRequestPolicy   string
+	ResponseHeadersPolicy string
+}
+
+// CDNStaticAssets holds the S3 bucket domain name that serves the requests matching the path pattern.
+type CDNStaticAssets struct {
+	Location    string
+	PathPattern string
+}
 
 type VPCConfig struct {
 	Imported *ImportVPC // If not-nil, use the imported VPC resources instead of the Managed VPC.
@@ -22,7 +22,7 @@ func CloudFormationTemplate(overrideRules []Rule, origTemp []byte) ([]byte, erro
 	if err != nil {
 		return nil, err
 	}
-	if err := applyRules(ruleNodes, content); err != nil {
+	if err := applyRules(overrideRules, ruleNodes, content); err != nil {
 		return nil, err
 	}
 	output, err := marshalYAML(content)
@@ -65,14 +65,14 @@ func marshalYAML(content *yaml.Node) ([]byte, error) {
 	return out.Bytes(), nil
 }
 
-func applyRules(rules []nodeUpserter, content *yaml.Node) error {
+func applyRules(rules []Rule, ruleNodes []nodeUpserter, content *yaml.Node) error {
 	contentNode, err := getTemplateDocument(content)
 	if err != nil {
 		return err
 	}
-	for _, rule := range rules {
-		if err := applyRule(rule, contentNode); err != nil {
-			return err
+	for i, ruleNode := range ruleNodes {
+		if err := applyRule(ruleNode, contentNode); err != nil {
+			return fmt.Errorf(`apply override rule with path "%s": %w`, rules[i].Path, err)
 		}
 	}
 	return nil
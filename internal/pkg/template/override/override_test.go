@@ -15,6 +15,7 @@ import (
 
 func newTaskDefPropertyRule(rule Rule) Rule {
 	return Rule{
+		Op:    rule.Op,
 		Path:  fmt.Sprintf("Resources.TaskDefinition.Properties.%s", rule.Path),
 		Value: rule.Value,
 	}
@@ -121,6 +122,54 @@ func referBadSeqIndexWithNoKeyRule() Rule {
 	})
 }
 
+func testLogDriverRule(driver string) Rule {
+	return newTaskDefPropertyRule(Rule{
+		Op:   OpTest,
+		Path: "ContainerDefinitions[0].LogConfiguration.LogDriver",
+		Value: yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: driver,
+		},
+	})
+}
+
+func removeLBDNSEnvVarRule() Rule {
+	return newTaskDefPropertyRule(Rule{
+		Op:   OpRemove,
+		Path: "ContainerDefinitions[0].Environment[Name=COPILOT_LB_DNS]",
+	})
+}
+
+func replaceServiceDiscoveryEndpointRule() Rule {
+	return newTaskDefPropertyRule(Rule{
+		Op:   OpReplace,
+		Path: "ContainerDefinitions[0].Environment[Name=COPILOT_SERVICE_DISCOVERY_ENDPOINT].Value",
+		Value: yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Value: "api.test.demo.local",
+		},
+	})
+}
+
+func insertRequiresCompatibilitiesRule() Rule {
+	return newTaskDefPropertyRule(Rule{
+		Op:   OpAdd,
+		Path: "RequiresCompatibilities[0]",
+		Value: yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   nodeTagStr,
+			Value: "EC2",
+		},
+	})
+}
+
+func removeStreamPrefixRule() Rule {
+	return newTaskDefPropertyRule(Rule{
+		Op:   OpRemove,
+		Path: "ContainerDefinitions[0].LogConfiguration.Options.awslogs-stream-prefix",
+	})
+}
+
 func Test_CloudFormationTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		inRules       []Rule
@@ -138,7 +187,7 @@ func Test_CloudFormationTemplate(t *testing.T) {
 			inRules: []Rule{
 				referBadSeqIndexRule(),
 			},
-			wantedError: fmt.Errorf("cannot specify PortMappings[1] because the current length is 1. Use [%s] to append to the sequence instead", seqAppendToLastSymbol),
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.ContainerDefinitions[0].PortMappings[1].ContainerPort": cannot specify PortMappings[1] because the current length is 1. Use [%s] to append to the sequence instead`, seqAppendToLastSymbol),
 		},
 		"error when referring to bad sequence index when sequence key doesn't exist": {
 			inTplFileName: "backend_svc.yml",
@@ -146,7 +195,67 @@ func Test_CloudFormationTemplate(t *testing.T) {
 				referBadSeqIndexWithNoKeyRule(),
 			},
 
-			wantedError: fmt.Errorf("cannot specify VolumesFrom[1] because VolumesFrom does not exist. Use VolumesFrom[%s] to append to the sequence instead", seqAppendToLastSymbol),
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.ContainerDefinitions[0].VolumesFrom[1].SourceContainer": cannot specify VolumesFrom[1] because VolumesFrom does not exist. Use VolumesFrom[%s] to append to the sequence instead`, seqAppendToLastSymbol),
+		},
+		"error when the key selector matches nothing": {
+			inTplFileName: "backend_svc.yml",
+			inRules: []Rule{
+				newTaskDefPropertyRule(Rule{
+					Path: "ContainerDefinitions[Name=nginx].Image",
+					Value: yaml.Node{
+						Kind:  yaml.ScalarNode,
+						Value: "nginx",
+					},
+				}),
+			},
+
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.ContainerDefinitions[Name=nginx].Image": cannot select ContainerDefinitions[Name=nginx] because no item in ContainerDefinitions has Name set to nginx`),
+		},
+		"error when removing a key that doesn't exist": {
+			inTplFileName: "backend_svc.yml",
+			inRules: []Rule{
+				newTaskDefPropertyRule(Rule{
+					Op:   OpRemove,
+					Path: "ContainerDefinitions[0].LinuxParameters.InitProcessEnabled",
+				}),
+			},
+
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.ContainerDefinitions[0].LinuxParameters.InitProcessEnabled": LinuxParameters does not exist`),
+		},
+		"error when replacing an index out of range": {
+			inTplFileName: "backend_svc.yml",
+			inRules: []Rule{
+				newTaskDefPropertyRule(Rule{
+					Op:   OpReplace,
+					Path: "RequiresCompatibilities[1]",
+					Value: yaml.Node{
+						Kind:  yaml.ScalarNode,
+						Value: "EC2",
+					},
+				}),
+			},
+
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.RequiresCompatibilities[1]": cannot replace RequiresCompatibilities[1] because the current length is 1`),
+		},
+		"error when the test operation fails": {
+			inTplFileName: "backend_svc.yml",
+			inRules: []Rule{
+				testLogDriverRule("awsfirelens"),
+				removeStreamPrefixRule(),
+			},
+
+			wantedError: fmt.Errorf(`apply override rule with path "Resources.TaskDefinition.Properties.ContainerDefinitions[0].LogConfiguration.LogDriver": test failed: the value of LogDriver does not match`),
+		},
+		"success with operations": {
+			inTplFileName: "backend_svc.yml",
+			inRules: []Rule{
+				testLogDriverRule("awslogs"),
+				removeLBDNSEnvVarRule(),
+				replaceServiceDiscoveryEndpointRule(),
+				insertRequiresCompatibilitiesRule(),
+				removeStreamPrefixRule(),
+			},
+			wantedTplFileName: "operations.yml",
 		},
 		"success with ulimits": {
 			inTplFileName: "backend_svc.yml",
@@ -17,8 +17,29 @@ const (
 	PathSegmentSeparator = "."
 	// seqAppendToLastSymbol is the symbol used to add a node to the tail of a list.
 	seqAppendToLastSymbol = "-"
+	// seqSelectorSeparator is the symbol separating the key and the value of a sequence selector, e.g. [Name=nginx].
+	seqSelectorSeparator = "="
+	// keyQuote is the symbol surrounding a key that contains special characters, e.g. Metadata."a.b".
+	keyQuote = '"'
+	// escapeSymbol is the symbol escaping the next character of a key, e.g. Metadata.a\.b.
+	escapeSymbol = '\\'
 )
 
+// Operations supported by an override rule. An empty operation upserts the value at the path.
+const (
+	// OpAdd inserts the value into a sequence at the path, or sets the value of a mapping key.
+	OpAdd = "add"
+	// OpRemove deletes the node at the path.
+	OpRemove = "remove"
+	// OpReplace substitutes the node at the path, which must already exist, with the value.
+	OpReplace = "replace"
+	// OpTest verifies that the node at the path is equal to the value.
+	OpTest = "test"
+)
+
+// ValidOperations are the operations that an override rule can perform.
+var ValidOperations = []string{OpAdd, OpRemove, OpReplace, OpTest}
+
 // Subset of YAML tag values: http://yaml.org/type/
 // These are the type of nodes that can be upserted.
 const (
@@ -30,10 +51,13 @@ const (
 )
 
 var (
-	// pathSegmentRegexp checks for map key or single sequence reference.
-	// For example: ContainerDefinitions[0], PortMapping[-], or Ulimits.
-	// There are three capture groups in this regex: ([a-zA-Z0-9_-]+), (\[(\d+|%s)\]), and (\d+|%s).
-	pathSegmentRegexp = regexp.MustCompile(fmt.Sprintf(`^([a-zA-Z0-9_-]+)(\[(\d+|%s)\])?$`, seqAppendToLastSymbol))
+	// pathSegmentRegexp checks for map key, single sequence reference, or a sequence item selected by key.
+	// For example: ContainerDefinitions[0], PortMapping[-], ContainerDefinitions[Name=nginx], aws:copilot:description,
+	// "a.b", a\.b, or Ulimits.
+	// There are three capture groups in this regex: ((?:[a-zA-Z0-9_:-]|\\.)+|"(?:[^"\\]|\\.)*"),
+	// (\[(\d+|%s|[a-zA-Z0-9_-]+%s[^\[\]]+)\]), and (\d+|%s|[a-zA-Z0-9_-]+%s[^\[\]]+).
+	pathSegmentRegexp = regexp.MustCompile(fmt.Sprintf(`^((?:[a-zA-Z0-9_:-]|\\.)+|"(?:[^"\\]|\\.)*")(\[(\d+|%s|[a-zA-Z0-9_-]+%s[^\[\]]+)\])?$`,
+		seqAppendToLastSymbol, seqSelectorSeparator))
 )
 
 // nodeUpserter is the interface to insert or update a series of nodes to a YAML file.
@@ -44,6 +68,7 @@ type nodeUpserter interface {
 
 // Rule is the override rule override package uses.
 type Rule struct {
+	Op    string // example: "remove". Defaults to upserting the value.
 	Path  string // example: "ContainerDefinitions[0].Ulimits[-].HardLimit"
 	Value yaml.Node
 }
@@ -52,24 +77,42 @@ func (r Rule) validate() error {
 	if r.Path == "" {
 		return fmt.Errorf("rule path is empty")
 	}
-	pathSegments := strings.Split(r.Path, PathSegmentSeparator)
+	if r.Op != "" && !contains(ValidOperations, r.Op) {
+		return fmt.Errorf(`invalid override operation "%s" for path "%s": operation must be one of %s`,
+			r.Op, r.Path, strings.Join(ValidOperations, ", "))
+	}
+	pathSegments, err := SplitPath(r.Path)
+	if err != nil {
+		return err
+	}
 	for _, pathSegment := range pathSegments {
 		if !pathSegmentRegexp.MatchString(pathSegment) {
-			return fmt.Errorf(`invalid override path segment "%s": segments must be of the form "array[0]", "array[%s]" or "key"`,
-				pathSegment, seqAppendToLastSymbol)
+			return fmt.Errorf(`invalid override path segment "%s": segments must be of the form "array[0]", "array[%s]", "array[key%svalue]", "key" or "\"key\""`,
+				pathSegment, seqAppendToLastSymbol, seqSelectorSeparator)
+		}
+		if r.Op == OpRemove || r.Op == OpReplace || r.Op == OpTest {
+			// These operations only apply to existing nodes, so there is no new item to append to.
+			if strings.HasSuffix(pathSegment, fmt.Sprintf("[%s]", seqAppendToLastSymbol)) {
+				return fmt.Errorf(`invalid override path "%s": "[%s]" cannot be used with the "%s" operation`,
+					r.Path, seqAppendToLastSymbol, r.Op)
+			}
 		}
 	}
 	return nil
 }
 
 func (r Rule) parse() (nodeUpserter, error) {
-	pathSegments := strings.SplitN(r.Path, PathSegmentSeparator, 2)
+	pathSegments, err := SplitPath(r.Path)
+	if err != nil {
+		return nil, err
+	}
 	segment, err := parsePathSegment(pathSegments[0])
 	if err != nil {
 		return nil, err
 	}
 	baseNode := upsertNode{
 		key: segment.key,
+		op:  r.Op,
 	}
 	if len(pathSegments) < 2 {
 		// This is the last segment.
@@ -78,7 +121,8 @@ func (r Rule) parse() (nodeUpserter, error) {
 	}
 
 	subRule := Rule{
-		Path:  pathSegments[1],
+		Op:    r.Op,
+		Path:  strings.Join(pathSegments[1:], PathSegmentSeparator),
 		Value: r.Value,
 	}
 	nextNode, err := subRule.parse()
@@ -90,6 +134,13 @@ func (r Rule) parse() (nodeUpserter, error) {
 }
 
 func newNodeUpserter(baseNode upsertNode, segment pathSegment) (nodeUpserter, error) {
+	if segment.selectorKey != "" {
+		return &seqSelectorUpsertNode{
+			selectorKey:   segment.selectorKey,
+			selectorValue: segment.selectorValue,
+			upsertNode:    baseNode,
+		}, nil
+	}
 	if segment.index == "" {
 		// The indexMatch capture group is empty string, meaning that the path segment doesn't contain "[<index>]".
 		return &mapUpsertNode{
@@ -114,10 +165,45 @@ func newNodeUpserter(baseNode upsertNode, segment pathSegment) (nodeUpserter, er
 	}, nil
 }
 
+// SplitPath splits the path of an override rule into its segments.
+// A separator that is escaped with a backslash, or that is within a quoted key or a sequence selector, doesn't split the path.
+// For example, `Metadata."a.b".Tags[Key=c.d]` and `Metadata.a\.b.Tags[Key=c.d]` are both split into three segments.
+// The segments are returned as they are written in the path, including quotes and escapes.
+func SplitPath(path string) ([]string, error) {
+	var segments []string
+	var inQuotes, inSelector, escaped bool
+	start := 0
+	for i, c := range path {
+		switch {
+		case escaped:
+			escaped = false
+		case c == escapeSymbol:
+			escaped = true
+		case c == keyQuote && !inSelector:
+			inQuotes = !inQuotes
+		case inQuotes:
+		case c == '[':
+			inSelector = true
+		case c == ']':
+			inSelector = false
+		case string(c) == PathSegmentSeparator && !inSelector:
+			segments = append(segments, path[start:i])
+			start = i + len(PathSegmentSeparator)
+		}
+	}
+	if inQuotes || escaped {
+		return nil, fmt.Errorf(`invalid override path "%s": quotes and escapes must be terminated`, path)
+	}
+	return append(segments, path[start:]), nil
+}
+
 type pathSegment struct {
 	raw   string // The raw path segment, e.g. ContainerDefinitions[0].
 	key   string // The key of the segment, e.g. ContainerDefinitions.
 	index string // The index, if any, of the segment, e.g. 0. It is an empty string if the path is not a slice segment.
+
+	selectorKey   string // The key of the selector, if any, of the segment, e.g. Name in ContainerDefinitions[Name=nginx].
+	selectorValue string // The value of the selector, if any, of the segment, e.g. nginx in ContainerDefinitions[Name=nginx].
 }
 
 func parsePathSegment(rawPathSegment string) (pathSegment, error) {
@@ -127,17 +213,42 @@ func parsePathSegment(rawPathSegment string) (pathSegment, error) {
 		return pathSegment{}, fmt.Errorf(`invalid path segment "%s"`, rawPathSegment)
 	}
 	// https://pkg.go.dev/regexp#Regexp.FindStringSubmatch
-	return pathSegment{
-		raw:   rawPathSegment,
-		key:   subMatches[1], // The first capture group - "([a-zA-Z0-9_-]+)". Example match: "ContainerDefinitions".
-		index: subMatches[3], // The third capture group -  "(\d+|%s)". Example matches: "1", "-".
-	}, nil
+	segment := pathSegment{
+		raw: rawPathSegment,
+		key: UnquoteKey(subMatches[1]), // The first capture group. Example matches: "ContainerDefinitions", "\"a.b\"".
+	}
+	// The third capture group - "(\d+|%s|[a-zA-Z0-9_-]+%s[^\[\]]+)". Example matches: "1", "-", "Name=nginx".
+	if selector := strings.SplitN(subMatches[3], seqSelectorSeparator, 2); len(selector) == 2 {
+		segment.selectorKey, segment.selectorValue = selector[0], selector[1]
+	} else {
+		segment.index = subMatches[3]
+	}
+	return segment, nil
+}
+
+// UnquoteKey returns the key of a path segment without its surrounding quotes and escape symbols.
+func UnquoteKey(key string) string {
+	if len(key) >= 2 && key[0] == keyQuote && key[len(key)-1] == keyQuote {
+		key = key[1 : len(key)-1]
+	}
+	var sb strings.Builder
+	escaped := false
+	for _, c := range key {
+		if c == escapeSymbol && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		sb.WriteRune(c)
+	}
+	return sb.String()
 }
 
 // upsertNode represents a node that needs to be upserted at the given key.
 // If multiple intermediary mapping nodes need to be created then `next` is not nil.
 type upsertNode struct {
 	key           string
+	op            string
 	valueToInsert *yaml.Node
 	next          nodeUpserter
 }
@@ -147,6 +258,11 @@ func (m *upsertNode) Next() nodeUpserter {
 	return m.next
 }
 
+// requiresExistingNode returns true if the operation can only be applied to nodes that already exist in the template.
+func (m *upsertNode) requiresExistingNode() bool {
+	return m.op == OpRemove || m.op == OpReplace || m.op == OpTest
+}
+
 // mapUpsertNode represents a map node that needs to be upserted at the given key.
 type mapUpsertNode struct {
 	upsertNode
@@ -156,10 +272,9 @@ type mapUpsertNode struct {
 // If the key already exists then return the node at the given key.
 // Otherwise, creates a new mapping node with the given key and returns it.
 func (m *mapUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error) {
-	// If it contains the value to insert, upsert the value to the yaml.
+	// If it contains the value to insert, apply the operation with the value to the yaml.
 	if m.valueToInsert != nil {
-		m.upsertValue(parentContent)
-		return nil, nil
+		return nil, m.applyValue(parentContent)
 	}
 	for i := 0; i < len(parentContent.Content); i += 2 {
 		// The content of a map always come in pairs. If the node pair exists, return the map node.
@@ -169,6 +284,9 @@ func (m *mapUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error) {
 			return parentContent.Content[i+1], nil
 		}
 	}
+	if m.requiresExistingNode() {
+		return nil, fmt.Errorf("%s does not exist", m.key)
+	}
 	// If the node pair doesn't exist, create the label node first and then a map node.
 	// Finally we return the created map node.
 	newLabelNode := &yaml.Node{
@@ -185,6 +303,28 @@ func (m *mapUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error) {
 	return newValNode, nil
 }
 
+func (m *mapUpsertNode) applyValue(content *yaml.Node) error {
+	if m.op == "" || m.op == OpAdd {
+		m.upsertValue(content)
+		return nil
+	}
+	i := mappingKeyIndex(content, m.key)
+	if i == -1 {
+		return fmt.Errorf("cannot %s %s because it does not exist", m.op, m.key)
+	}
+	switch m.op {
+	case OpRemove:
+		content.Content = append(content.Content[:i], content.Content[i+2:]...)
+	case OpReplace:
+		content.Content[i+1] = m.valueToInsert
+	case OpTest:
+		if !equalNodes(content.Content[i+1], m.valueToInsert) {
+			return fmt.Errorf("test failed: the value of %s does not match", m.key)
+		}
+	}
+	return nil
+}
+
 func (m *mapUpsertNode) upsertValue(content *yaml.Node) {
 	// If the node pair exists, substitute with the value node.
 	for i := 0; i < len(content.Content); i += 2 {
@@ -212,9 +352,9 @@ type seqIdxUpsertNode struct {
 
 // Upsert upserts a node into given yaml content.
 func (s *seqIdxUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error) {
-	// If it contains the value to insert, upsert the value to the yaml.
+	// If it contains the value to insert, apply the operation with the value to the yaml.
 	if s.valueToInsert != nil {
-		return nil, s.upsertValue(parentContent)
+		return nil, s.applyValue(parentContent)
 	}
 	// If the node pair exists, we check if we need to append the node to the end.
 	// If so, create a map node and return it since we want to go deeper to upsert the value.
@@ -270,6 +410,48 @@ func (s *seqIdxUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error)
 	return newMapNode, nil
 }
 
+func (s *seqIdxUpsertNode) applyValue(content *yaml.Node) error {
+	if s.op == "" {
+		return s.upsertValue(content)
+	}
+	i := mappingKeyIndex(content, s.key)
+	if i == -1 {
+		if s.op == OpAdd && (s.appendToLast || s.index == 0) {
+			// Adding the first item of a sequence creates the sequence.
+			return s.upsertValue(content)
+		}
+		return fmt.Errorf("cannot %s %s[%s] because %s does not exist", s.op, s.key, s.indexString(), s.key)
+	}
+	seqNode := content.Content[i+1]
+	if s.op == OpAdd {
+		if s.appendToLast {
+			seqNode.Content = append(seqNode.Content, s.valueToInsert)
+			return nil
+		}
+		if s.index > len(seqNode.Content) {
+			return fmt.Errorf("cannot add %s[%d] because the current length is %d. Use [%s] to append to the sequence instead",
+				s.key, s.index, len(seqNode.Content), seqAppendToLastSymbol)
+		}
+		seqNode.Content = insertNode(seqNode.Content, s.index, s.valueToInsert)
+		return nil
+	}
+	if s.index >= len(seqNode.Content) {
+		return fmt.Errorf("cannot %s %s[%d] because the current length is %d", s.op, s.key, s.index, len(seqNode.Content))
+	}
+	return applySeqItemOp(s.op, seqNode, s.index, s.valueToInsert, s.raw())
+}
+
+func (s *seqIdxUpsertNode) indexString() string {
+	if s.appendToLast {
+		return seqAppendToLastSymbol
+	}
+	return strconv.Itoa(s.index)
+}
+
+func (s *seqIdxUpsertNode) raw() string {
+	return fmt.Sprintf("%s[%s]", s.key, s.indexString())
+}
+
 func (s *seqIdxUpsertNode) upsertValue(content *yaml.Node) error {
 	for i := 0; i < len(content.Content); i += 2 {
 		if content.Content[i].Value == s.key {
@@ -300,3 +482,129 @@ func (s *seqIdxUpsertNode) upsertValue(content *yaml.Node) error {
 	content.Content = append(content.Content, newValNode)
 	return nil
 }
+
+// seqSelectorUpsertNode represents the first item of a sequence whose mapping has a key with the given value,
+// such as ContainerDefinitions[Name=nginx].
+type seqSelectorUpsertNode struct {
+	selectorKey   string
+	selectorValue string
+	upsertNode
+}
+
+// Upsert selects the matching item of the sequence in the given yaml content.
+// If the item is the last segment of the path, the operation is applied to the item.
+// Otherwise, returns the item since we want to go deeper to apply the value.
+func (s *seqSelectorUpsertNode) Upsert(parentContent *yaml.Node) (*yaml.Node, error) {
+	i := mappingKeyIndex(parentContent, s.key)
+	if i == -1 {
+		return nil, fmt.Errorf("cannot select %s because %s does not exist", s.raw(), s.key)
+	}
+	seqNode := parentContent.Content[i+1]
+	itemIdx := -1
+	for idx, item := range seqNode.Content {
+		if valIdx := mappingKeyIndex(item, s.selectorKey); valIdx != -1 && item.Content[valIdx+1].Value == s.selectorValue {
+			itemIdx = idx
+			break
+		}
+	}
+	if itemIdx == -1 {
+		return nil, fmt.Errorf("cannot select %s because no item in %s has %s set to %s", s.raw(), s.key, s.selectorKey, s.selectorValue)
+	}
+	if s.valueToInsert == nil {
+		return seqNode.Content[itemIdx], nil
+	}
+	switch s.op {
+	case "":
+		seqNode.Content[itemIdx] = s.valueToInsert
+		return nil, nil
+	case OpAdd:
+		// Similar to adding at an index, the value is inserted before the selected item.
+		seqNode.Content = insertNode(seqNode.Content, itemIdx, s.valueToInsert)
+		return nil, nil
+	}
+	return nil, applySeqItemOp(s.op, seqNode, itemIdx, s.valueToInsert, s.raw())
+}
+
+func (s *seqSelectorUpsertNode) raw() string {
+	return fmt.Sprintf("%s[%s%s%s]", s.key, s.selectorKey, seqSelectorSeparator, s.selectorValue)
+}
+
+// applySeqItemOp applies a remove, replace, or test operation to the existing item at index of the sequence node.
+func applySeqItemOp(op string, seqNode *yaml.Node, index int, value *yaml.Node, raw string) error {
+	switch op {
+	case OpRemove:
+		seqNode.Content = append(seqNode.Content[:index], seqNode.Content[index+1:]...)
+	case OpReplace:
+		seqNode.Content[index] = value
+	case OpTest:
+		if !equalNodes(seqNode.Content[index], value) {
+			return fmt.Errorf("test failed: the value of %s does not match", raw)
+		}
+	}
+	return nil
+}
+
+// mappingKeyIndex returns the index of the key node in the content of a mapping node, or -1 if the key doesn't exist.
+func mappingKeyIndex(node *yaml.Node, key string) int {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return -1
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func insertNode(nodes []*yaml.Node, index int, node *yaml.Node) []*yaml.Node {
+	nodes = append(nodes, nil)
+	copy(nodes[index+1:], nodes[index:])
+	nodes[index] = node
+	return nodes
+}
+
+// equalNodes returns true if the two nodes represent the same value, regardless of their style
+// or the order of mapping keys.
+func equalNodes(a, b *yaml.Node) bool {
+	for a != nil && a.Kind == yaml.AliasNode {
+		a = a.Alias
+	}
+	for b != nil && b.Kind == yaml.AliasNode {
+		b = b.Alias
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Kind != b.Kind || len(a.Content) != len(b.Content) {
+		return false
+	}
+	switch a.Kind {
+	case yaml.ScalarNode:
+		return a.ShortTag() == b.ShortTag() && a.Value == b.Value
+	case yaml.MappingNode:
+		for i := 0; i < len(a.Content); i += 2 {
+			j := mappingKeyIndex(b, a.Content[i].Value)
+			if j == -1 || !equalNodes(a.Content[i+1], b.Content[j+1]) {
+				return false
+			}
+		}
+		return a.ShortTag() == b.ShortTag()
+	default:
+		for i := range a.Content {
+			if !equalNodes(a.Content[i], b.Content[i]) {
+				return false
+			}
+		}
+		return a.ShortTag() == b.ShortTag()
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -35,7 +35,7 @@ func Test_parseRules(t *testing.T) {
 				},
 			},
 
-			wantedError: fmt.Errorf("invalid override path segment \"ContainerDefinition[0][0]\": segments must be of the form \"array[0]\", \"array[-]\" or \"key\""),
+			wantedError: fmt.Errorf("invalid override path segment \"ContainerDefinition[0][0]\": segments must be of the form \"array[0]\", \"array[-]\", \"array[key=value]\", \"key\" or \"\\\"key\\\"\""),
 		},
 		"error when invalid rule path with bad sequence index": {
 			inRules: []Rule{
@@ -44,7 +44,121 @@ func Test_parseRules(t *testing.T) {
 				},
 			},
 
-			wantedError: fmt.Errorf("invalid override path segment \"ContainerDefinition[0-]\": segments must be of the form \"array[0]\", \"array[-]\" or \"key\""),
+			wantedError: fmt.Errorf("invalid override path segment \"ContainerDefinition[0-]\": segments must be of the form \"array[0]\", \"array[-]\", \"array[key=value]\", \"key\" or \"\\\"key\\\"\""),
+		},
+		"error when invalid operation": {
+			inRules: []Rule{
+				{
+					Op:   "move",
+					Path: "ContainerDefinitions[0].Image",
+				},
+			},
+
+			wantedError: fmt.Errorf("invalid override operation \"move\" for path \"ContainerDefinitions[0].Image\": operation must be one of add, remove, replace, test"),
+		},
+		"error when appending to a sequence with remove operation": {
+			inRules: []Rule{
+				{
+					Op:   "remove",
+					Path: "ContainerDefinitions[0].Ulimits[-]",
+				},
+			},
+
+			wantedError: fmt.Errorf("invalid override path \"ContainerDefinitions[0].Ulimits[-]\": \"[-]\" cannot be used with the \"remove\" operation"),
+		},
+		"error when a quoted key is not terminated": {
+			inRules: []Rule{
+				{
+					Path: `Metadata."a.b`,
+				},
+			},
+
+			wantedError: fmt.Errorf(`invalid override path "Metadata."a.b": quotes and escapes must be terminated`),
+		},
+		"success with quoted and escaped keys": {
+			inRules: []Rule{
+				{
+					Path: `Metadata."a.b".Tags.aws:copilot:description`,
+					Value: yaml.Node{
+						Value: "testNode",
+					},
+				},
+				{
+					Op:   "remove",
+					Path: `Metadata.c\.d`,
+				},
+			},
+			wantedNodeUpserter: func() []nodeUpserter {
+				node4 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key: "aws:copilot:description",
+						valueToInsert: &yaml.Node{
+							Value: "testNode",
+						},
+					},
+				}
+				node3 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key:  "Tags",
+						next: node4,
+					},
+				}
+				node2 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key:  "a.b",
+						next: node3,
+					},
+				}
+				node1 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key:  "Metadata",
+						next: node2,
+					},
+				}
+				removeNode2 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key:           "c.d",
+						op:            "remove",
+						valueToInsert: &yaml.Node{},
+					},
+				}
+				removeNode1 := &mapUpsertNode{
+					upsertNode: upsertNode{
+						key:  "Metadata",
+						op:   "remove",
+						next: removeNode2,
+					},
+				}
+				return []nodeUpserter{node1, removeNode1}
+			},
+		},
+		"success with key selector and operation": {
+			inRules: []Rule{
+				{
+					Op:   "remove",
+					Path: "ContainerDefinitions[Name=nginx].Ulimits[1]",
+				},
+			},
+			wantedNodeUpserter: func() []nodeUpserter {
+				node2 := &seqIdxUpsertNode{
+					upsertNode: upsertNode{
+						key:           "Ulimits",
+						op:            "remove",
+						valueToInsert: &yaml.Node{},
+					},
+					index: 1,
+				}
+				node1 := &seqSelectorUpsertNode{
+					upsertNode: upsertNode{
+						key:  "ContainerDefinitions",
+						op:   "remove",
+						next: node2,
+					},
+					selectorKey:   "Name",
+					selectorValue: "nginx",
+				}
+				return []nodeUpserter{node1}
+			},
 		},
 		"success": {
 			inRules: []Rule{
@@ -96,3 +210,46 @@ func Test_parseRules(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitPath(t *testing.T) {
+	testCases := map[string]struct {
+		inPath string
+
+		wantedSegments []string
+		wantedError    error
+	}{
+		"split on every separator": {
+			inPath:         "Resources.TaskDefinition.Properties",
+			wantedSegments: []string{"Resources", "TaskDefinition", "Properties"},
+		},
+		"keep separators in quoted keys": {
+			inPath:         `Metadata."a.b"."c.\"d"`,
+			wantedSegments: []string{"Metadata", `"a.b"`, `"c.\"d"`},
+		},
+		"keep escaped separators": {
+			inPath:         `Metadata.a\.b.c`,
+			wantedSegments: []string{"Metadata", `a\.b`, "c"},
+		},
+		"keep separators in sequence selectors": {
+			inPath:         "Tags[Key=a.b].Value",
+			wantedSegments: []string{"Tags[Key=a.b]", "Value"},
+		},
+		"error on an unterminated escape": {
+			inPath:      `Metadata.a\`,
+			wantedError: fmt.Errorf(`invalid override path "Metadata.a\": quotes and escapes must be terminated`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := SplitPath(tc.inPath)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSegments, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -32,6 +33,7 @@ const (
 	rdWebSvcTplName     = "rd-web"
 	backendSvcTplName   = "backend"
 	workerSvcTplName    = "worker"
+	staticSiteTplName   = "static-site"
 	scheduledJobTplName = "scheduled-job"
 )
 
@@ -91,6 +93,7 @@ var (
 		"nlb",
 		"vpc-connector",
 		"alb",
+		"alb-target-group-properties",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -109,6 +112,13 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// WorkloadEnvAddonsOpts holds the names of the environment addons outputs imported by the workload stack.
+type WorkloadEnvAddonsOpts struct {
+	Variables []string
+	Secrets   []string
+	Policies  []string
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -349,6 +359,51 @@ type AutoscalingOpts struct {
 // AliasesForHostedZone maps hosted zone IDs to aliases that belong to it.
 type AliasesForHostedZone map[string][]string
 
+// AdditionalRoutingRuleOpts holds configuration that's needed for an additional listener rule of the
+// Application Load Balancer, and the target group it forwards traffic to.
+type AdditionalRoutingRuleOpts struct {
+	Index int // Position of the rule starting at 1, the main routing rule being at 0.
+
+	Path                string
+	TargetContainer     string
+	TargetPort          string
+	Aliases             []string // Falls back to the aliases of the main routing rule if empty.
+	HTTPHealthCheck     HTTPHealthCheckOpts
+	HTTPVersion         *string
+	Stickiness          bool
+	DeregistrationDelay *int64
+	AllowedSourceIps    []string
+}
+
+// PathPatterns returns the path patterns of the listener rule conditions.
+func (r AdditionalRoutingRuleOpts) PathPatterns() []string {
+	path := strings.Trim(r.Path, "/")
+	if path == "" {
+		return []string{"/*"}
+	}
+	return []string{"/" + path, "/" + path + "/*"}
+}
+
+// TargetGroup returns the configuration of the target group that the rule forwards traffic to.
+func (r AdditionalRoutingRuleOpts) TargetGroup() TargetGroupOpts {
+	return TargetGroupOpts{
+		HTTPHealthCheck:     r.HTTPHealthCheck,
+		HTTPVersion:         r.HTTPVersion,
+		DeregistrationDelay: r.DeregistrationDelay,
+		Port:                r.TargetPort,
+		Stickiness:          &r.Stickiness,
+	}
+}
+
+// TargetGroupOpts holds configuration for a target group of the Application Load Balancer.
+type TargetGroupOpts struct {
+	HTTPHealthCheck     HTTPHealthCheckOpts
+	HTTPVersion         *string
+	DeregistrationDelay *int64
+	Port                string // Falls back to the ContainerPort parameter if empty.
+	Stickiness          *bool  // Falls back to the Stickiness parameter if nil.
+}
+
 // AutoscalingQueueDelayOpts holds configuration to scale SQS queues.
 type AutoscalingQueueDelayOpts struct {
 	AcceptableBacklogPerTask int
@@ -365,6 +420,22 @@ type DeploymentConfigurationOpts struct {
 	MinHealthyPercent int
 	// The upper limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
 	MaxPercent int
+
+	// The traffic shifting strategy of the deployment, one of "CANARY", "LINEAR" or "BLUE_GREEN". Empty for rolling updates.
+	Strategy string
+	// The time to wait after all the traffic is shifted before terminating the tasks of the previous revision.
+	BakeTimeInMinutes *int
+	// The steps in which the traffic is shifted for canary and linear deployments.
+	TrafficShift *TrafficShiftOpts
+	// The CloudWatch alarms that roll back the deployment when they go into the ALARM state.
+	RollbackAlarms []string
+}
+
+// TrafficShiftOpts holds the percentage of production traffic shifted at each step of a deployment
+// and the time to wait between steps.
+type TrafficShiftOpts struct {
+	Percent           int
+	IntervalInMinutes int
 }
 
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
@@ -376,6 +447,12 @@ type StateMachineOpts struct {
 	Retries *int
 }
 
+// EventRuleOpts holds configuration needed for a rule that triggers a job when an event matches its pattern.
+type EventRuleOpts struct {
+	EventBus *string // Name or ARN of the event bus. Defaults to the default event bus of the account.
+	Pattern  string  // Event pattern in JSON.
+}
+
 // PublishOpts holds configuration needed if the service has publishers.
 type PublishOpts struct {
 	Topics []*Topic
@@ -493,6 +570,7 @@ type WorkloadOpts struct {
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
 	AddonsExtraParams        string                   // Additional user defined Parameters for the addons stack.
+	EnvAddons                *WorkloadEnvAddonsOpts   // Outputs of the environment addons stack referenced by the workload.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
 	Autoscaling              *AutoscalingOpts
@@ -513,6 +591,7 @@ type WorkloadOpts struct {
 	ALBEnabled               bool
 	HostedZoneAliases        AliasesForHostedZone
 	CredentialsParameter     string
+	AdditionalRoutingRules   []AdditionalRoutingRuleOpts
 
 	// Additional options for service templates.
 	WorkloadType            string
@@ -527,8 +606,9 @@ type WorkloadOpts struct {
 	CustomResources map[string]S3ObjectLocation
 
 	// Additional options for job templates.
-	ScheduleExpression string
-	StateMachine       *StateMachineOpts
+	ScheduleExpressions []string // The first expression is referenced through the "Schedule" parameter.
+	EventRules          []EventRuleOpts
+	StateMachine        *StateMachineOpts
 
 	// Additional options for request driven web service templates.
 	StartCommand      *string
@@ -543,6 +623,29 @@ type WorkloadOpts struct {
 
 	// Additional options for worker service templates.
 	Subscribe *SubscribeOpts
+
+	// Additional options for static site templates.
+	StaticSite *StaticSiteOpts
+}
+
+// StaticSiteOpts holds configuration needed to serve a static site from an S3 bucket through CloudFront.
+type StaticSiteOpts struct {
+	IndexDocument string
+	ErrorDocument string
+
+	// Optional custom domain of the site.
+	Alias          string
+	CertificateARN string
+	HostedZoneID   string
+}
+
+// TargetGroup returns the configuration of the target group of the main routing rule.
+func (o WorkloadOpts) TargetGroup() TargetGroupOpts {
+	return TargetGroupOpts{
+		HTTPHealthCheck:     o.HTTPHealthCheck,
+		HTTPVersion:         o.HTTPVersion,
+		DeregistrationDelay: o.DeregistrationDelay,
+	}
 }
 
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
@@ -567,6 +670,11 @@ func (t *Template) ParseWorkerService(data WorkloadOpts) (*Content, error) {
 	return t.parseSvc(workerSvcTplName, data, withSvcParsingFuncs())
 }
 
+// ParseStaticSite parses a static site's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseStaticSite(data WorkloadOpts) (*Content, error) {
+	return t.parseSvc(staticSiteTplName, data, withSvcParsingFuncs())
+}
+
 // ParseScheduledJob parses a scheduled job's Cloudformation Template
 func (t *Template) ParseScheduledJob(data WorkloadOpts) (*Content, error) {
 	return t.parseJob(scheduledJobTplName, data, withSvcParsingFuncs())
@@ -609,6 +717,7 @@ func withSvcParsingFuncs() ParseOption {
 		return t.Funcs(map[string]interface{}{
 			"toSnakeCase":          ToSnakeCaseFunc,
 			"hasSecrets":           hasSecrets,
+			"hasManagedPolicies":   hasManagedPolicies,
 			"fmtSlice":             FmtSliceFunc,
 			"quoteSlice":           QuoteSliceFunc,
 			"randomUUID":           randomUUIDFunc,
@@ -616,6 +725,7 @@ func withSvcParsingFuncs() ParseOption {
 			"jsonSNSTopics":        generateSNSJSON,
 			"jsonQueueURIs":        generateQueueURIJSON,
 			"envControllerParams":  envControllerParameters,
+			"envControllerAliases": envControllerAliases,
 			"logicalIDSafe":        StripNonAlphaNumFunc,
 			"wordSeries":           english.WordSeries,
 			"pluralWord":           english.PluralWord,
@@ -632,6 +742,19 @@ func hasSecrets(opts WorkloadOpts) bool {
 	if opts.NestedStack != nil && (len(opts.NestedStack.SecretOutputs) > 0) {
 		return true
 	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.Secrets) > 0) {
+		return true
+	}
+	return false
+}
+
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.Policies) > 0) {
+		return true
+	}
 	return false
 }
 
@@ -666,6 +789,19 @@ func envControllerParameters(o WorkloadOpts) []string {
 	return parameters
 }
 
+// envControllerAliases returns the aliases of all the routing rules of the workload, without duplicates.
+func envControllerAliases(o WorkloadOpts) []string {
+	aliases := append([]string{}, o.Aliases...)
+	for _, rule := range o.AdditionalRoutingRules {
+		for _, alias := range rule.Aliases {
+			if !contains(aliases, alias) {
+				aliases = append(aliases, alias)
+			}
+		}
+	}
+	return aliases
+}
+
 func requiresVPCConnector(o WorkloadOpts) bool {
 	if o.WorkloadType != "Request-Driven Web Service" {
 		return false
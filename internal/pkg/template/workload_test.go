@@ -59,6 +59,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
 					"templates/workloads/partials/cf/vpc-connector.yml":                   []byte("vpc-connector"),
 					"templates/workloads/partials/cf/alb.yml":                             []byte("alb"),
+					"templates/workloads/partials/cf/alb-target-group-properties.yml":     []byte("alb-target-group-properties"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -92,6 +93,7 @@ func TestTemplate_ParseSvc(t *testing.T) {
   nlb
   vpc-connector
   alb
+  alb-target-group-properties
 `,
 		},
 	}
@@ -147,6 +149,14 @@ func TestHasSecrets(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"environment addons have secrets": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					Secrets: []string{"ClusterSecret"},
+				},
+			},
+			wanted: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -156,6 +166,42 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{},
+			},
+			wanted: false,
+		},
+		"nested has policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					PolicyOutputs: []string{"MyTableAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"environment addons have policies": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					Policies: []string{"ClusterAccessPolicy"},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
+
 func TestRuntimePlatformOpts_Version(t *testing.T) {
 	testCases := map[string]struct {
 		in       RuntimePlatformOpts
@@ -217,6 +263,44 @@ func TestRuntimePlatformOpts_IsDefault(t *testing.T) {
 	}
 }
 
+func TestAdditionalRoutingRuleOpts_PathPatterns(t *testing.T) {
+	testCases := map[string]struct {
+		path   string
+		wanted []string
+	}{
+		"should match all requests for the root path": {
+			path:   "/",
+			wanted: []string{"/*"},
+		},
+		"should match the path and its sub-paths": {
+			path:   "admin",
+			wanted: []string{"/admin", "/admin/*"},
+		},
+		"should trim leading and trailing slashes": {
+			path:   "/admin/",
+			wanted: []string{"/admin", "/admin/*"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, AdditionalRoutingRuleOpts{Path: tc.path}.PathPatterns())
+		})
+	}
+}
+
+func TestEnvControllerAliases(t *testing.T) {
+	require.Equal(t, []string{"example.com", "admin.example.com"}, envControllerAliases(WorkloadOpts{
+		Aliases: []string{"example.com"},
+		AdditionalRoutingRules: []AdditionalRoutingRuleOpts{
+			{
+				Aliases: []string{"admin.example.com", "example.com"},
+			},
+			{},
+		},
+	}))
+}
+
 func TestSsmOrSecretARN_RequiresSub(t *testing.T) {
 	require.False(t, ssmOrSecretARN{}.RequiresSub(), "SSM Parameter Store or secret ARNs do not require !Sub")
 }
@@ -33,6 +33,10 @@ type ECSServiceRendererOpts struct {
 	Group      *errgroup.Group
 	Ctx        context.Context
 	RenderOpts RenderOptions
+
+	// Optional fields to render how production traffic is shifted to the new revision.
+	ListenerRules             stream.ListenerRuleDescriber
+	ProductionListenerRuleARN string
 }
 
 // ListeningChangeSetRenderer returns a component that listens for CloudFormation
@@ -74,9 +78,11 @@ func ListeningECSServiceResourceRenderer(streamer StackSubscriber, ecsDescriber
 		ecsDescriber: ecsDescriber,
 		logicalID:    logicalID,
 
-		group:      g,
-		ctx:        ctx,
-		renderOpts: opts.RenderOpts,
+		group:         g,
+		ctx:           ctx,
+		renderOpts:    opts.RenderOpts,
+		listenerRules: opts.ListenerRules,
+		ruleARN:       opts.ProductionListenerRuleARN,
 		resourceRenderer: ListeningResourceRenderer(streamer, logicalID, description, ResourceRendererOpts{
 			RenderOpts: opts.RenderOpts,
 		}),
@@ -241,9 +247,11 @@ type ecsServiceResourceComponent struct {
 	logicalID    string                     // LogicalID for the service.
 
 	// Optional inputs.
-	group      *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
-	ctx        context.Context // Context for the ECSDeploymentStreamer.
-	renderOpts RenderOptions
+	group         *errgroup.Group // Existing group to catch ECSDeploymentStreamer errors.
+	ctx           context.Context // Context for the ECSDeploymentStreamer.
+	renderOpts    RenderOptions
+	listenerRules stream.ListenerRuleDescriber // Client needed to stream traffic shifts, nil if the service doesn't shift traffic.
+	ruleARN       string                       // Production listener rule of the service.
 
 	// Sub-components.
 	resourceRenderer   DynamicRenderer
@@ -326,7 +334,11 @@ func (c *ecsServiceResourceComponent) Done() <-chan struct{} {
 
 func (c *ecsServiceResourceComponent) newListeningRollingUpdateRenderer(serviceARN string, startTime time.Time) DynamicRenderer {
 	cluster, service := parseServiceARN(serviceARN)
-	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime)
+	var opts []stream.ECSDeploymentStreamerOption
+	if c.listenerRules != nil && c.ruleARN != "" {
+		opts = append(opts, stream.WithTrafficShift(c.listenerRules, c.ruleARN))
+	}
+	streamer := stream.NewECSDeploymentStreamer(c.ecsDescriber, cluster, service, startTime, opts...)
 	renderer := ListeningRollingUpdateRenderer(streamer, NestedRenderOptions(c.renderOpts))
 	c.group.Go(func() error {
 		return stream.Stream(c.ctx, streamer)
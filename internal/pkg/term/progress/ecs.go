@@ -38,8 +38,9 @@ func ListeningRollingUpdateRenderer(streamer ECSServiceSubscriber, opts RenderOp
 
 type rollingUpdateComponent struct {
 	// Data to render.
-	deployments []stream.ECSDeployment
-	failureMsgs []string
+	deployments  []stream.ECSDeployment
+	trafficShift *stream.ECSTrafficShift
+	failureMsgs  []string
 
 	// Style configuration for the component.
 	padding           int
@@ -55,6 +56,7 @@ func (c *rollingUpdateComponent) Listen() {
 	for ev := range c.stream {
 		c.mu.Lock()
 		c.deployments = ev.Deployments
+		c.trafficShift = ev.TrafficShift
 		c.failureMsgs = append(c.failureMsgs, ev.LatestFailureEvents...)
 		if len(c.failureMsgs) > c.maxLenFailureMsgs {
 			c.failureMsgs = c.failureMsgs[len(c.failureMsgs)-c.maxLenFailureMsgs:]
@@ -64,7 +66,8 @@ func (c *rollingUpdateComponent) Listen() {
 	close(c.done)
 }
 
-// Render prints first the deployments as a tableComponent and then the failure messages as singleLineComponents.
+// Render prints first the deployments as a tableComponent, then the traffic shift progress if any,
+// and finally the failure messages as singleLineComponents.
 func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -76,6 +79,12 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderTrafficShift(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderFailureMsgs(buf)
 	if err != nil {
 		return 0, err
@@ -116,6 +125,21 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 	return nl, err
 }
 
+func (c *rollingUpdateComponent) renderTrafficShift(out io.Writer) (numLines int, err error) {
+	if c.trafficShift == nil {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the traffic shift.
+		&singleLineComponent{
+			Text: fmt.Sprintf("%s %s (%d%% of traffic to revision %s)", color.Faint.Sprintf("Traffic shift"),
+				c.trafficShift.Phase, c.trafficShift.Percent, c.trafficShift.TaskDefRevision),
+			Padding: c.padding,
+		},
+	}
+	return renderComponents(out, components)
+}
+
 func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int, err error) {
 	if len(c.failureMsgs) == 0 {
 		return 0, nil
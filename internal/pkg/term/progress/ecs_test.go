@@ -77,8 +77,9 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 
 func TestRollingUpdateComponent_Render(t *testing.T) {
 	testCases := map[string]struct {
-		inDeployments []stream.ECSDeployment
-		inFailureMsgs []string
+		inDeployments  []stream.ECSDeployment
+		inTrafficShift *stream.ECSTrafficShift
+		inFailureMsgs  []string
 
 		wantedNumLines int
 		wantedOut      string
@@ -98,6 +99,30 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			wantedOut: `Deployments
            Revision  Rollout      Desired  Running  Failed  Pending
   PRIMARY  2         [completed]  10       10       0       0
+`,
+		},
+		"should render the traffic shift after deployments": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "2",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "IN_PROGRESS",
+				},
+			},
+			inTrafficShift: &stream.ECSTrafficShift{
+				Phase:           "Shifting traffic",
+				TaskDefRevision: "2",
+				Percent:         20,
+			},
+
+			wantedNumLines: 5,
+			wantedOut: `Deployments
+           Revision  Rollout        Desired  Running  Failed  Pending
+  PRIMARY  2         [in progress]  10       10       0       0
+
+Traffic shift Shifting traffic (20% of traffic to revision 2)
 `,
 		},
 		"should render a single failure event": {
@@ -140,8 +165,9 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			// GIVEN
 			buf := new(strings.Builder)
 			c := &rollingUpdateComponent{
-				deployments: tc.inDeployments,
-				failureMsgs: tc.inFailureMsgs,
+				deployments:  tc.inDeployments,
+				trafficShift: tc.inTrafficShift,
+				failureMsgs:  tc.inFailureMsgs,
 			}
 
 			// WHEN
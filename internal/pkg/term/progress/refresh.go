@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// RefreshFunc returns the latest text to display.
+type RefreshFunc func() (string, error)
+
+// ListeningRefreshRenderer returns a component that calls refresh every interval and renders the latest text,
+// until ctx is canceled.
+func ListeningRefreshRenderer(ctx context.Context, refresh RefreshFunc, interval time.Duration) DynamicRenderer {
+	comp := &refreshComponent{
+		refresh:  refresh,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+	go comp.Listen(ctx)
+	return comp
+}
+
+// refreshComponent is a DynamicRenderer that periodically replaces the text it displays.
+type refreshComponent struct {
+	refresh  RefreshFunc
+	interval time.Duration
+
+	content string // The latest successfully refreshed text.
+	err     error  // The error from the latest refresh, if any.
+
+	done chan struct{}
+	mu   sync.Mutex
+}
+
+// Listen refreshes the text to display every interval until ctx is canceled.
+func (c *refreshComponent) Listen(ctx context.Context) {
+	defer close(c.done)
+	for {
+		content, err := c.refresh()
+		c.mu.Lock()
+		c.err = err
+		if err == nil {
+			c.content = content
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+// Render prints the latest refreshed text followed by the refresh error, if any,
+// and returns the number of lines written and the error if any.
+func (c *refreshComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	content := c.content
+	if c.err != nil {
+		content += color.Red.Sprintf("Failed to refresh: %v\n", c.err)
+	}
+	if _, err := io.WriteString(out, content); err != nil {
+		return 0, fmt.Errorf("write refreshed content: %w", err)
+	}
+	return strings.Count(content, "\n"), nil
+}
+
+// Done returns a channel that's closed when the component stops refreshing.
+func (c *refreshComponent) Done() <-chan struct{} {
+	return c.done
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshComponent_Listen(t *testing.T) {
+	t.Run("should keep the latest successful content and record refresh errors until canceled", func(t *testing.T) {
+		// GIVEN
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		c := &refreshComponent{
+			refresh: func() (string, error) {
+				calls++
+				if calls == 2 {
+					cancel()
+					return "", errors.New("some error")
+				}
+				return "first\n", nil
+			},
+			interval: time.Nanosecond,
+			done:     make(chan struct{}),
+		}
+
+		// WHEN
+		go c.Listen(ctx)
+
+		// THEN
+		<-c.Done() // Listen should have closed the channel.
+		require.Equal(t, 2, calls)
+		require.Equal(t, "first\n", c.content)
+		require.EqualError(t, c.err, "some error")
+	})
+}
+
+func TestRefreshComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inErr     error
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"renders the latest content": {
+			inContent: "Services\n\n  api\n",
+
+			wantedNumLines: 3,
+			wantedOut:      "Services\n\n  api\n",
+		},
+		"renders the refresh error after the content": {
+			inContent: "Services\n",
+			inErr:     errors.New("some error"),
+
+			wantedNumLines: 2,
+			wantedOut:      "Services\nFailed to refresh: some error\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			c := &refreshComponent{
+				content: tc.inContent,
+				err:     tc.inErr,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
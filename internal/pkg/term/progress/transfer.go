@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	transferBarWidth     = 30
+	transferBarCompleted = "█"
+	transferBarRemaining = "░"
+)
+
+// TransferBar is a DynamicRenderer that displays the progress of a transfer of bytes as a bar.
+type TransferBar struct {
+	label string
+
+	mu     sync.Mutex
+	copied int64
+	total  int64
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewTransferBar returns a TransferBar that displays the label in front of the bar.
+func NewTransferBar(label string) *TransferBar {
+	return &TransferBar{
+		label: label,
+		done:  make(chan struct{}),
+	}
+}
+
+// Update sets the number of bytes copied so far out of the total.
+// It is safe to call concurrently with Render.
+func (b *TransferBar) Update(copied, total int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.copied, b.total = copied, total
+}
+
+// Stop notifies that the transfer is over.
+func (b *TransferBar) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+// Done returns a channel that is closed when the transfer is over.
+func (b *TransferBar) Done() <-chan struct{} {
+	return b.done
+}
+
+// Render writes the bar with the percentage and the number of bytes copied, and returns 1 for the number of lines written.
+// Until the total is known, only the label is written.
+func (b *TransferBar) Render(out io.Writer) (numLines int, err error) {
+	b.mu.Lock()
+	copied, total := b.copied, b.total
+	b.mu.Unlock()
+
+	if total <= 0 {
+		if _, err := fmt.Fprintf(out, "%s\n", b.label); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+	if copied > total {
+		copied = total
+	}
+	completed := int(copied * transferBarWidth / total)
+	bar := strings.Repeat(transferBarCompleted, completed) + strings.Repeat(transferBarRemaining, transferBarWidth-completed)
+	if _, err := fmt.Fprintf(out, "%s  %s  %3d%%  %s/%s\n", b.label, bar, copied*100/total,
+		humanize.Bytes(uint64(copied)), humanize.Bytes(uint64(total))); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferBar_Render(t *testing.T) {
+	testCases := map[string]struct {
+		copied int64
+		total  int64
+
+		wantedOut string
+	}{
+		"renders only the label if the total is unknown": {
+			wantedOut: "Copying heap.hprof\n",
+		},
+		"renders the progress of the transfer": {
+			copied:    1500,
+			total:     6000,
+			wantedOut: "Copying heap.hprof  " + strings.Repeat("█", 7) + strings.Repeat("░", 23) + "   25%  1.5 kB/6.0 kB\n",
+		},
+		"caps the progress to the total": {
+			copied:    7000,
+			total:     6000,
+			wantedOut: "Copying heap.hprof  " + strings.Repeat("█", 30) + "  100%  6.0 kB/6.0 kB\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			bar := NewTransferBar("Copying heap.hprof")
+			bar.Update(tc.copied, tc.total)
+			buf := new(strings.Builder)
+
+			// WHEN
+			nl, err := bar.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, 1, nl)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
+
+func TestTransferBar_Done(t *testing.T) {
+	// GIVEN
+	bar := NewTransferBar("Copying heap.hprof")
+
+	// WHEN
+	bar.Stop()
+	bar.Stop()
+
+	// THEN
+	_, isOpen := <-bar.Done()
+	require.False(t, isOpen)
+}
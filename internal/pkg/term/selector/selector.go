@@ -39,7 +39,8 @@ const (
 
 	pipelineEscapeOpt = "[No additional environments]"
 
-	fmtCopilotTaskGroup = "copilot-%s"
+	fmtCopilotTaskGroup  = "copilot-%s"
+	fmtWorkloadTaskGroup = "%s-%s-%s"
 )
 
 const (
@@ -267,6 +268,7 @@ type TaskSelector struct {
 	defaultCluster bool
 	taskGroup      string
 	taskID         string
+	workload       string
 }
 
 // NewAppEnvSelector returns a selector that chooses applications or environments.
@@ -380,6 +382,14 @@ func WithTaskID(id string) TaskOpts {
 	}
 }
 
+// WithWorkload selects among the tasks of a deployed service instead of one-off tasks for TaskSelector.
+// It must be used along with WithAppEnv.
+func WithWorkload(name string) TaskOpts {
+	return func(in *TaskSelector) {
+		in.workload = name
+	}
+}
+
 // RunningTask has the user select a running task. Callers can provide either app and env names,
 // or use default cluster.
 func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.Task, error) {
@@ -393,6 +403,11 @@ func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.
 		TaskID:      s.taskID,
 		CopilotOnly: true,
 	}
+	if s.workload != "" {
+		// The tasks of a workload aren't tagged as Copilot tasks, and belong to the task definition family of the workload.
+		filter.TaskGroup = fmt.Sprintf(fmtWorkloadTaskGroup, s.app, s.env, s.workload)
+		filter.CopilotOnly = false
+	}
 	if s.defaultCluster {
 		tasks, err = s.lister.ListActiveDefaultClusterTasks(filter)
 		if err != nil {
@@ -2962,6 +2962,7 @@ func TestTaskSelect_Task(t *testing.T) {
 		setupMocks func(mocks taskSelectMocks)
 		app        string
 		env        string
+		workload   string
 		useDefault bool
 
 		wantErr  error
@@ -3034,6 +3035,21 @@ func TestTaskSelect_Task(t *testing.T) {
 			},
 			wantTask: mockTask1,
 		},
+		"success with the running tasks of a workload": {
+			app:      mockApp,
+			env:      mockEnv,
+			workload: "api",
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: mockApp,
+					Env: mockEnv,
+					ListTasksFilter: ecs.ListTasksFilter{
+						TaskGroup: "mockApp-mockEnv-api",
+					},
+				}).Return([]*awsecs.Task{mockTask1}, nil)
+			},
+			wantTask: mockTask1,
+		},
 		"success": {
 			app: mockApp,
 			env: mockEnv,
@@ -3078,7 +3094,7 @@ func TestTaskSelect_Task(t *testing.T) {
 					WithAppEnv(tc.app, tc.env), WithDefault())
 			} else {
 				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env))
+					WithAppEnv(tc.app, tc.env), WithWorkload(tc.workload))
 			}
 			if tc.wantErr != nil {
 				require.EqualError(t, err, tc.wantErr.Error())
@@ -372,6 +372,27 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
+// OverwriteWorkloadManifest replaces the manifest of an existing workload under the copilot/{name}/ directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) OverwriteWorkloadManifest(data []byte, name string) (string, error) {
+	copilotPath, err := ws.copilotDirPath()
+	if err != nil {
+		return "", err
+	}
+	filename := filepath.Join(copilotPath, name, manifestFileName)
+	exist, err := ws.fs.Exists(filename)
+	if err != nil {
+		return "", fmt.Errorf("check if manifest file %s exists: %w", filename, err)
+	}
+	if !exist {
+		return "", &ErrFileNotExists{FileName: filename}
+	}
+	if err := ws.fs.WriteFile(filename, data, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
 // WritePipelineBuildspec writes the pipeline buildspec under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
 func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
@@ -431,6 +452,17 @@ func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
 	return ws.read(svc, addonsDirName, fname)
 }
 
+// ReadEnvironmentAddonsDir returns a list of file names under the "environments/addons/" directory,
+// which holds the addons shared by the workloads of every environment.
+func (ws *Workspace) ReadEnvironmentAddonsDir() ([]string, error) {
+	return ws.ReadAddonsDir(environmentsDirName)
+}
+
+// ReadEnvironmentAddon returns the contents of a file under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvironmentAddon(fname string) ([]byte, error) {
+	return ws.read(environmentsDirName, addonsDirName, fname)
+}
+
 // WriteAddon writes the content of an addon file under "{svc}/addons/{name}.yml".
 // If successful returns the full path of the file, otherwise an empty string and an error.
 func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
@@ -885,6 +885,30 @@ func TestWorkspace_ReadAddonsDir(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadEnvironmentAddon(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	require.NoError(t, fs.MkdirAll("/copilot/environments/addons", 0755))
+	require.NoError(t, afero.WriteFile(fs, "/copilot/environments/addons/db.yml", []byte("Resources: {}"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/copilot/environments/addons/addons.parameters.yml", []byte("Parameters: {}"), 0644))
+	ws := &Workspace{
+		copilotDir: "/copilot",
+		fs: &afero.Afero{
+			Fs: fs,
+		},
+	}
+
+	// WHEN
+	fnames, err := ws.ReadEnvironmentAddonsDir()
+	require.NoError(t, err)
+	content, err := ws.ReadEnvironmentAddon("db.yml")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, []string{"addons.parameters.yml", "db.yml"}, fnames)
+	require.Equal(t, "Resources: {}", string(content))
+}
+
 func TestWorkspace_WriteAddon(t *testing.T) {
 	testCases := map[string]struct {
 		marshaler   mockBinaryMarshaler
@@ -944,6 +968,61 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_OverwriteWorkloadManifest(t *testing.T) {
+	testCases := map[string]struct {
+		mockFS func() afero.Fs
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"return error if the manifest does not exist": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				return fs
+			},
+			wantedErr: fmt.Errorf("file /copilot/webhook/manifest.yml does not exists"),
+		},
+		"overwrites the existing manifest": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/webhook", 0755)
+				afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte("name: webhook"), 0644)
+				return fs
+			},
+			wantedPath: "/copilot/webhook/manifest.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: tc.mockFS(),
+			}
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fs:         utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.OverwriteWorkloadManifest([]byte("name: webhook\ntype: Worker Service"), "webhook")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, tc.wantedPath, actualPath)
+			out, err := utils.ReadFile(tc.wantedPath)
+			require.NoError(t, err)
+			require.Equal(t, "name: webhook\ntype: Worker Service", string(out))
+		})
+	}
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"